@@ -85,7 +85,13 @@ func (h *handlers) modifyTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.svc.Modify(uuid, req.Modifications); err != nil {
+	var err error
+	if req.AllRecurrences {
+		err = h.svc.ModifyRecurring(uuid, req.Modifications, true)
+	} else {
+		err = h.svc.Modify(uuid, req.Modifications)
+	}
+	if err != nil {
 		slog.Error("Modify task failed", "uuid", uuid, "error", err)
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
@@ -167,6 +173,23 @@ func (h *handlers) startTask(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// duplicateTask handles POST /api/v1/tasks/{uuid}/duplicate
+func (h *handlers) duplicateTask(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+	if uuid == "" {
+		writeError(w, http.StatusBadRequest, "uuid is required")
+		return
+	}
+
+	newUUID, err := h.svc.Duplicate(uuid)
+	if err != nil {
+		slog.Error("Duplicate task failed", "uuid", uuid, "error", err)
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, AddTaskResponse{UUID: newUUID})
+}
+
 // stopTask handles POST /api/v1/tasks/{uuid}/stop
 func (h *handlers) stopTask(w http.ResponseWriter, r *http.Request) {
 	uuid := r.PathValue("uuid")
@@ -11,6 +11,7 @@
 //	POST   /api/v1/tasks/{uuid}/done         mark a task done
 //	POST   /api/v1/tasks/{uuid}/start        start a task
 //	POST   /api/v1/tasks/{uuid}/stop         stop a task
+//	POST   /api/v1/tasks/{uuid}/duplicate    duplicate a task
 //	POST   /api/v1/tasks/{uuid}/annotate     add an annotation
 //	DELETE /api/v1/tasks/{uuid}/annotate     remove an annotation (denotate)
 //	POST   /api/v1/undo                     undo last operation
@@ -85,6 +86,7 @@ func registerRoutes(mux *http.ServeMux, h *handlers) {
 	mux.HandleFunc("POST /api/v1/tasks/{uuid}/done", h.doneTask)
 	mux.HandleFunc("POST /api/v1/tasks/{uuid}/start", h.startTask)
 	mux.HandleFunc("POST /api/v1/tasks/{uuid}/stop", h.stopTask)
+	mux.HandleFunc("POST /api/v1/tasks/{uuid}/duplicate", h.duplicateTask)
 	mux.HandleFunc("POST /api/v1/tasks/{uuid}/annotate", h.annotateTask)
 	mux.HandleFunc("DELETE /api/v1/tasks/{uuid}/annotate", h.denotateTask)
 	mux.HandleFunc("POST /api/v1/undo", h.undoLast)
@@ -53,8 +53,11 @@ type AddTaskResponse struct {
 
 // ModifyTaskRequest is the body for PUT /api/v1/tasks/{uuid}.
 // Modifications use Taskwarrior syntax, e.g. "priority:H due:tomorrow +urgent".
+// AllRecurrences applies them to every pending instance of a recurring task and
+// to its template, instead of the given instance only.
 type ModifyTaskRequest struct {
-	Modifications string `json:"modifications"`
+	Modifications  string `json:"modifications"`
+	AllRecurrences bool   `json:"all_recurrences,omitempty"`
 }
 
 // AnnotateTaskRequest is the body for POST /api/v1/tasks/{uuid}/annotate.
@@ -0,0 +1,127 @@
+package calendar
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// icalLineLength is the longest content line allowed by RFC 5545, in octets.
+// Longer lines are folded onto continuation lines starting with a space.
+const icalLineLength = 75
+
+// icalPriority maps Taskwarrior priorities to the iCalendar PRIORITY scale,
+// where 1 is the highest
+var icalPriority = map[string]int{
+	"H": 1,
+	"M": 5,
+	"L": 9,
+}
+
+// TasksToICal writes tasks as an iCalendar (RFC 5545) file with one event per task,
+// for calendar apps that import .ics files instead of syncing with Google.
+//
+// Events follow the Google sync: they start at the due date, or the scheduled date
+// when there is none, and tasks with neither are left out. Tasks with the allDay
+// UDA become all-day events; the others last as long as their 'dur' UDA.
+func TasksToICal(tasks []core.Task) ([]byte, error) {
+	var buf bytes.Buffer
+	stamp := formatICalTime(core.Now())
+
+	writeICalLine(&buf, "BEGIN:VCALENDAR")
+	writeICalLine(&buf, "VERSION:2.0")
+	writeICalLine(&buf, "PRODID:-//wui//Taskwarrior export//EN")
+	writeICalLine(&buf, "CALSCALE:GREGORIAN")
+
+	for _, task := range tasks {
+		if !hasEventDate(task) {
+			continue
+		}
+		if task.UUID == "" {
+			return nil, fmt.Errorf("task %q has no UUID", task.Description)
+		}
+
+		start := task.Scheduled
+		if task.Due != nil && !task.Due.IsZero() {
+			start = task.Due
+		}
+
+		summary := task.Description
+		if task.Status == "completed" {
+			summary = "✓ " + summary
+		}
+
+		writeICalLine(&buf, "BEGIN:VEVENT")
+		writeICalLine(&buf, "UID:"+task.UUID)
+		writeICalLine(&buf, "DTSTAMP:"+stamp)
+		if isAllDay(task) {
+			// All-day events end on the next day, which is not included
+			day := start.Local()
+			writeICalLine(&buf, "DTSTART;VALUE=DATE:"+day.Format("20060102"))
+			writeICalLine(&buf, "DTEND;VALUE=DATE:"+day.AddDate(0, 0, 1).Format("20060102"))
+		} else {
+			writeICalLine(&buf, "DTSTART:"+formatICalTime(*start))
+			writeICalLine(&buf, "DTEND:"+formatICalTime(start.Add(taskEventDuration(task, 0))))
+		}
+		writeICalLine(&buf, "SUMMARY:"+escapeICalText(summary))
+		writeICalLine(&buf, "DESCRIPTION:"+escapeICalText(fmt.Sprintf("Taskwarrior UUID: %s\n\nProject: %s\nTags: %s\nStatus: %s",
+			task.UUID,
+			task.Project,
+			strings.Join(task.Tags, ", "),
+			task.Status,
+		)))
+		if priority, ok := icalPriority[task.Priority]; ok {
+			writeICalLine(&buf, fmt.Sprintf("PRIORITY:%d", priority))
+		}
+		if len(task.Tags) > 0 {
+			tags := make([]string, len(task.Tags))
+			for i, tag := range task.Tags {
+				tags[i] = escapeICalText(tag)
+			}
+			writeICalLine(&buf, "CATEGORIES:"+strings.Join(tags, ","))
+		}
+		writeICalLine(&buf, "END:VEVENT")
+	}
+
+	writeICalLine(&buf, "END:VCALENDAR")
+	return buf.Bytes(), nil
+}
+
+// formatICalTime formats a time as an iCalendar UTC date-time
+func formatICalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeICalText escapes the characters with a meaning in iCalendar TEXT values
+func escapeICalText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeICalLine writes a content line ending with CRLF, folded so that no line is
+// longer than icalLineLength octets. Lines are never split inside a UTF-8 character.
+func writeICalLine(buf *bytes.Buffer, line string) {
+	limit := icalLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts toward their length
+		limit = icalLineLength - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,105 @@
+package calendar
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// icalLines splits an iCalendar file into its folded content lines
+func icalLines(t *testing.T, data []byte) []string {
+	t.Helper()
+	s := string(data)
+	if !strings.HasSuffix(s, "\r\n") {
+		t.Fatalf("Expected lines to end with CRLF, got %q", s)
+	}
+	return strings.Split(strings.TrimSuffix(s, "\r\n"), "\r\n")
+}
+
+// unfoldICal joins folded content lines back into logical lines
+func unfoldICal(lines []string) []string {
+	var unfolded []string
+	for _, line := range lines {
+		if strings.HasPrefix(line, " ") && len(unfolded) > 0 {
+			unfolded[len(unfolded)-1] += line[1:]
+			continue
+		}
+		unfolded = append(unfolded, line)
+	}
+	return unfolded
+}
+
+func TestTasksToICal(t *testing.T) {
+	core.SetNowFunc(func() time.Time { return time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC) })
+	t.Cleanup(func() { core.SetNowFunc(nil) })
+
+	due := time.Date(2026, 7, 8, 14, 0, 0, 0, time.UTC)
+	scheduled := time.Date(2026, 7, 10, 12, 0, 0, 0, time.Local)
+	tasks := []core.Task{
+		{UUID: "uuid-timed", Description: "Call Bob; bring notes, agenda", Priority: "H", Due: &due, Status: "pending", UDAs: map[string]string{"dur": "PT1H"}},
+		{UUID: "uuid-allday", Description: "Holiday", Scheduled: &scheduled, Status: "pending", UDAs: map[string]string{"allDay": "true"}},
+		{UUID: "uuid-undated", Description: "No date", Status: "pending"},
+	}
+
+	data, err := TasksToICal(tasks)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	lines := unfoldICal(icalLines(t, data))
+
+	if lines[0] != "BEGIN:VCALENDAR" || lines[len(lines)-1] != "END:VCALENDAR" {
+		t.Errorf("Expected a VCALENDAR, got %q ... %q", lines[0], lines[len(lines)-1])
+	}
+	for _, want := range []string{
+		"VERSION:2.0",
+		"UID:uuid-timed",
+		"DTSTAMP:20261015T090000Z",
+		"DTSTART:20260708T140000Z",
+		"DTEND:20260708T150000Z",
+		`SUMMARY:Call Bob\; bring notes\, agenda`,
+		"PRIORITY:1",
+		"UID:uuid-allday",
+		"DTSTART;VALUE=DATE:20260710",
+		"DTEND;VALUE=DATE:20260711",
+		"SUMMARY:Holiday",
+	} {
+		if !slices.Contains(lines, want) {
+			t.Errorf("Expected line %q in:\n%s", want, strings.Join(lines, "\n"))
+		}
+	}
+
+	if got := strings.Count(string(data), "BEGIN:VEVENT"); got != 2 {
+		t.Errorf("Expected 2 events, tasks without dates left out, got %d", got)
+	}
+	if strings.Contains(string(data), "uuid-undated") {
+		t.Error("Expected the task without dates to be left out")
+	}
+}
+
+func TestTasksToICalFoldsLongLines(t *testing.T) {
+	due := time.Date(2026, 7, 8, 14, 0, 0, 0, time.UTC)
+	summary := strings.Repeat("Renovate the kitchen cabinets ", 4) + "avant l'été ☀"
+	tasks := []core.Task{{UUID: "uuid-long", Description: summary, Due: &due, Status: "pending"}}
+
+	data, err := TasksToICal(tasks)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	folded := icalLines(t, data)
+	for _, line := range folded {
+		if len(line) > icalLineLength {
+			t.Errorf("Expected lines of at most %d octets, got %d: %q", icalLineLength, len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("Expected folding to keep UTF-8 characters whole, got %q", line)
+		}
+	}
+	if !slices.Contains(unfoldICal(folded), "SUMMARY:"+summary) {
+		t.Errorf("Expected the unfolded summary to match the task description, got:\n%s", data)
+	}
+}
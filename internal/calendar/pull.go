@@ -0,0 +1,258 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+	"google.golang.org/api/calendar/v3"
+)
+
+// Sync directions accepted by the sync command
+const (
+	DirectionPush = "push" // Taskwarrior → Google Calendar (default)
+	DirectionPull = "pull" // Google Calendar → Taskwarrior
+	DirectionBoth = "both" // Pull, then push
+)
+
+// pullActionKind is the kind of change applied by a pull
+type pullActionKind int
+
+const (
+	// pullDeleteTask deletes a task whose event was deleted in Calendar
+	pullDeleteTask pullActionKind = iota
+	// pullRescheduleTask moves the due date of a task whose event was moved in Calendar
+	pullRescheduleTask
+	// pullDeleteEvent deletes the future event of a completed task, or (with prune)
+	// an event whose task no longer exists
+	pullDeleteEvent
+)
+
+// pullAction is a change a pull applies to Taskwarrior or to the calendar
+type pullAction struct {
+	kind    pullActionKind
+	uuid    string // Taskwarrior UUID from the event description
+	eventID string // Event to delete (pullDeleteEvent)
+	due     string // New due date in Taskwarrior format (pullRescheduleTask)
+}
+
+// SetPrune makes pulls delete wui events whose task no longer exists in Taskwarrior
+func (s *SyncClient) SetPrune(prune bool) {
+	s.prune = prune
+}
+
+// Pull applies the changes made in Google Calendar to Taskwarrior: a task is deleted
+// when its event was deleted, and its due date follows the event when the event was
+// moved after the task was last modified. Future events of completed tasks are removed.
+// Only tasks that still exist are touched; events without a task are left alone unless
+// prune is set.
+func (s *SyncClient) Pull(ctx context.Context) (*SyncResult, error) {
+	slog.Info("Starting pull", "calendar", s.calendarName)
+
+	calendarID, err := s.findCalendarByName(ctx, s.calendarName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find calendar: %w", err)
+	}
+
+	events, err := s.listEvents(ctx, calendarID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar events: %w", err)
+	}
+
+	// Look up the tasks of the events, whatever their status or the sync filter
+	var uuids []string
+	seen := make(map[string]bool)
+	for _, event := range events {
+		if uuid := extractUUIDFromEvent(event); uuid != "" && !seen[uuid] {
+			seen[uuid] = true
+			uuids = append(uuids, uuid)
+		}
+	}
+	tasks := make(map[string]core.Task)
+	if len(uuids) > 0 {
+		exported, err := s.taskClient.Export(strings.Join(uuids, " "))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tasks: %w", err)
+		}
+		for _, task := range exported {
+			tasks[task.UUID] = task
+		}
+	}
+
+	result := &SyncResult{Warnings: make([]string, 0)}
+	for _, action := range planPull(events, tasks, core.Now(), s.prune) {
+		switch action.kind {
+		case pullDeleteTask:
+			slog.Info("Deleting task whose event was deleted", "uuid", action.uuid)
+			if err := s.taskClient.Delete(action.uuid); err != nil {
+				slog.Error("Failed to delete task", "uuid", action.uuid, "error", err)
+				continue
+			}
+			result.TasksDeleted++
+		case pullRescheduleTask:
+			slog.Info("Moving task to the event date", "uuid", action.uuid, "due", action.due)
+			if err := s.taskClient.Modify(action.uuid, "due:"+action.due); err != nil {
+				slog.Error("Failed to modify task", "uuid", action.uuid, "error", err)
+				continue
+			}
+			result.TasksRescheduled++
+		case pullDeleteEvent:
+			slog.Info("Deleting event", "uuid", action.uuid, "event_id", action.eventID)
+			if err := s.deleteEvent(ctx, calendarID, action.eventID); err != nil {
+				slog.Error("Failed to delete event", "uuid", action.uuid, "error", err)
+				continue
+			}
+			result.Deleted++
+		}
+	}
+
+	slog.Info("Pull completed", "tasks_deleted", result.TasksDeleted, "tasks_rescheduled", result.TasksRescheduled, "events_deleted", result.Deleted)
+	fmt.Fprintf(s.out(), "\nPull completed: %d tasks deleted, %d tasks rescheduled, %d events deleted\n",
+		result.TasksDeleted, result.TasksRescheduled, result.Deleted)
+
+	return result, nil
+}
+
+// SyncBidirectional pulls the changes made in Google Calendar, then pushes the tasks
+// to it, and returns the counts of both
+func (s *SyncClient) SyncBidirectional(ctx context.Context) (*SyncResult, error) {
+	pulled, err := s.Pull(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Deleted += pulled.Deleted
+	result.TasksDeleted = pulled.TasksDeleted
+	result.TasksRescheduled = pulled.TasksRescheduled
+	result.Warnings = append(pulled.Warnings, result.Warnings...)
+	return result, nil
+}
+
+// planPull decides the changes of a pull from the wui events of the calendar, including
+// the deleted ones, and their tasks keyed by UUID
+func planPull(events []*calendar.Event, tasks map[string]core.Task, now time.Time, prune bool) []pullAction {
+	var actions []pullAction
+	var order []string
+	live := make(map[string][]*calendar.Event)      // Events not deleted, by task UUID
+	cancelled := make(map[string][]*calendar.Event) // Deleted events, by task UUID
+	seen := make(map[string]bool)
+	for _, event := range events {
+		uuid := extractUUIDFromEvent(event)
+		if uuid == "" {
+			continue
+		}
+		if !seen[uuid] {
+			seen[uuid] = true
+			order = append(order, uuid)
+		}
+		if event.Status == "cancelled" {
+			cancelled[uuid] = append(cancelled[uuid], event)
+		} else {
+			live[uuid] = append(live[uuid], event)
+		}
+	}
+
+	for _, uuid := range order {
+		task, exists := tasks[uuid]
+		if exists && task.Status == "deleted" {
+			exists = false
+		}
+		active := exists && (task.Status == "pending" || task.Status == "waiting")
+
+		if len(live[uuid]) == 0 {
+			// Every event of the task was deleted in Calendar. Tasks that lost their
+			// dates had their event removed by the push, and tasks reopened or
+			// re-dated since the deletion are newer than it, so both are kept.
+			if active && hasEventDate(task) && deletedAfterModified(task, cancelled[uuid]) {
+				actions = append(actions, pullAction{kind: pullDeleteTask, uuid: uuid})
+			}
+			continue
+		}
+
+		for _, event := range live[uuid] {
+			switch {
+			case !exists:
+				if prune {
+					actions = append(actions, pullAction{kind: pullDeleteEvent, uuid: uuid, eventID: event.Id})
+				}
+			case task.Status == "completed":
+				if start, ok := eventStart(event); ok && start.After(now) {
+					actions = append(actions, pullAction{kind: pullDeleteEvent, uuid: uuid, eventID: event.Id})
+				}
+			case active:
+				if due, moved := movedDue(task, event); moved {
+					actions = append(actions, pullAction{kind: pullRescheduleTask, uuid: uuid, due: due})
+				}
+			}
+		}
+	}
+
+	return actions
+}
+
+// hasEventDate reports whether the push gives the task an event
+func hasEventDate(task core.Task) bool {
+	return (task.Due != nil && !task.Due.IsZero()) || (task.Scheduled != nil && !task.Scheduled.IsZero())
+}
+
+// deletedAfterModified reports whether the latest deletion among the cancelled
+// events of a task happened after the task was last modified
+func deletedAfterModified(task core.Task, events []*calendar.Event) bool {
+	var latest time.Time
+	for _, event := range events {
+		if updated, err := time.Parse(time.RFC3339, event.Updated); err == nil && updated.After(latest) {
+			latest = updated
+		}
+	}
+	if latest.IsZero() {
+		return false
+	}
+	return task.Modified == nil || latest.After(*task.Modified)
+}
+
+// eventStart returns the start of an event; all-day events start at local midnight
+func eventStart(event *calendar.Event) (time.Time, bool) {
+	if event.Start == nil {
+		return time.Time{}, false
+	}
+	if event.Start.DateTime != "" {
+		start, err := time.Parse(time.RFC3339, event.Start.DateTime)
+		return start, err == nil
+	}
+	if event.Start.Date != "" {
+		start, err := time.ParseInLocation("2006-01-02", event.Start.Date, time.Local)
+		return start, err == nil
+	}
+	return time.Time{}, false
+}
+
+// movedDue returns the due date that matches the event start when the event was moved
+// in Calendar after the task was last modified. Only tasks whose event follows their
+// due date are rescheduled.
+func movedDue(task core.Task, event *calendar.Event) (string, bool) {
+	if task.Due == nil || task.Due.IsZero() || event.Start == nil {
+		return "", false
+	}
+	if updated, err := time.Parse(time.RFC3339, event.Updated); err != nil ||
+		(task.Modified != nil && !updated.After(*task.Modified)) {
+		return "", false
+	}
+
+	if event.Start.Date != "" {
+		if event.Start.Date == task.Due.Local().Format("2006-01-02") {
+			return "", false
+		}
+		return event.Start.Date, true
+	}
+	start, ok := eventStart(event)
+	if !ok || start.Equal(*task.Due) {
+		return "", false
+	}
+	return start.Local().Format("2006-01-02T15:04:05"), true
+}
@@ -0,0 +1,153 @@
+package calendar
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+	"google.golang.org/api/calendar/v3"
+)
+
+// wuiEvent returns an event created by the push for the task uuid
+func wuiEvent(id, uuid, status string, start time.Time, updated time.Time) *calendar.Event {
+	return &calendar.Event{
+		Id:          id,
+		Description: "Taskwarrior UUID: " + uuid + "\n\nProject: \nTags: \nStatus: pending",
+		Status:      status,
+		Start:       &calendar.EventDateTime{DateTime: start.Format(time.RFC3339)},
+		Updated:     updated.Format(time.RFC3339),
+	}
+}
+
+func TestPlanPull(t *testing.T) {
+	now := time.Date(2026, 5, 4, 9, 0, 0, 0, time.Local)
+	due := now.AddDate(0, 0, 2)
+	modified := now.Add(-time.Hour)
+	task := func(uuid, status string) core.Task {
+		return core.Task{UUID: uuid, Status: status, Due: &due, Modified: &modified}
+	}
+
+	tests := []struct {
+		name     string
+		events   []*calendar.Event
+		tasks    []core.Task
+		prune    bool
+		expected []pullAction
+	}{
+		{
+			name:     "event deleted in Calendar deletes the task",
+			events:   []*calendar.Event{wuiEvent("e1", "a", "cancelled", due, now)},
+			tasks:    []core.Task{task("a", "pending")},
+			expected: []pullAction{{kind: pullDeleteTask, uuid: "a"}},
+		},
+		{
+			name: "deleted event replaced by a new one keeps the task",
+			events: []*calendar.Event{
+				wuiEvent("e1", "a", "cancelled", due, now),
+				wuiEvent("e2", "a", "confirmed", due, modified),
+			},
+			tasks: []core.Task{task("a", "pending")},
+		},
+		{
+			name:   "task reopened after its event was deleted is kept",
+			events: []*calendar.Event{wuiEvent("e1", "a", "cancelled", due, modified.Add(-time.Minute))},
+			tasks:  []core.Task{task("a", "pending")},
+		},
+		{
+			name: "task re-dated after its events were deleted is kept",
+			events: []*calendar.Event{
+				wuiEvent("e1", "a", "cancelled", due, modified.Add(-2*time.Hour)),
+				wuiEvent("e2", "a", "cancelled", due.AddDate(0, 0, 1), modified.Add(-time.Minute)),
+			},
+			tasks: []core.Task{task("a", "waiting")},
+		},
+		{
+			name: "latest deletion after the task changed deletes it",
+			events: []*calendar.Event{
+				wuiEvent("e1", "a", "cancelled", due, modified.Add(-2*time.Hour)),
+				wuiEvent("e2", "a", "cancelled", due, now),
+			},
+			tasks:    []core.Task{task("a", "pending")},
+			expected: []pullAction{{kind: pullDeleteTask, uuid: "a"}},
+		},
+		{
+			name:   "deleted event of a task without dates keeps the task",
+			events: []*calendar.Event{wuiEvent("e1", "a", "cancelled", due, now)},
+			tasks:  []core.Task{{UUID: "a", Status: "pending"}},
+		},
+		{
+			name:   "deleted event of a missing task changes nothing",
+			events: []*calendar.Event{wuiEvent("e1", "a", "cancelled", due, now)},
+			prune:  true,
+		},
+		{
+			name:     "event moved after the task changed reschedules it",
+			events:   []*calendar.Event{wuiEvent("e1", "a", "confirmed", due.Add(3*time.Hour), now)},
+			tasks:    []core.Task{task("a", "waiting")},
+			expected: []pullAction{{kind: pullRescheduleTask, uuid: "a", due: due.Add(3 * time.Hour).Format("2006-01-02T15:04:05")}},
+		},
+		{
+			name:   "task changed after the event keeps its due date",
+			events: []*calendar.Event{wuiEvent("e1", "a", "confirmed", due.Add(3*time.Hour), modified.Add(-time.Minute))},
+			tasks:  []core.Task{task("a", "pending")},
+		},
+		{
+			name:   "event at the due time changes nothing",
+			events: []*calendar.Event{wuiEvent("e1", "a", "confirmed", due, now)},
+			tasks:  []core.Task{task("a", "pending")},
+		},
+		{
+			name:     "completed task loses its future event",
+			events:   []*calendar.Event{wuiEvent("e1", "a", "confirmed", due, now)},
+			tasks:    []core.Task{task("a", "completed")},
+			expected: []pullAction{{kind: pullDeleteEvent, uuid: "a", eventID: "e1"}},
+		},
+		{
+			name:   "completed task keeps its past event",
+			events: []*calendar.Event{wuiEvent("e1", "a", "confirmed", now.AddDate(0, 0, -1), now)},
+			tasks:  []core.Task{task("a", "completed")},
+		},
+		{
+			name:   "event without task is left alone",
+			events: []*calendar.Event{wuiEvent("e1", "a", "confirmed", due, now)},
+			tasks:  []core.Task{task("a", "deleted")},
+		},
+		{
+			name:     "event without task is deleted with prune",
+			events:   []*calendar.Event{wuiEvent("e1", "a", "confirmed", due, now)},
+			prune:    true,
+			expected: []pullAction{{kind: pullDeleteEvent, uuid: "a", eventID: "e1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := make(map[string]core.Task)
+			for _, task := range tt.tasks {
+				tasks[task.UUID] = task
+			}
+			if got := planPull(tt.events, tasks, now, tt.prune); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("planPull() = %+v, expected %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMovedDueAllDayEvent(t *testing.T) {
+	due := time.Date(2026, 5, 6, 0, 0, 0, 0, time.Local)
+	task := core.Task{UUID: "a", Status: "pending", Due: &due}
+	event := &calendar.Event{
+		Start:   &calendar.EventDateTime{Date: "2026-05-08"},
+		Updated: time.Date(2026, 5, 4, 9, 0, 0, 0, time.Local).Format(time.RFC3339),
+	}
+
+	if got, moved := movedDue(task, event); !moved || got != "2026-05-08" {
+		t.Errorf("Expected the task to move to 2026-05-08, got %q (moved %v)", got, moved)
+	}
+
+	event.Start.Date = "2026-05-06"
+	if got, moved := movedDue(task, event); moved {
+		t.Errorf("Expected an all-day event on the due date to change nothing, got %q", got)
+	}
+}
@@ -3,7 +3,9 @@ package calendar
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
+	"os"
 	"strings"
 	"time"
 
@@ -18,6 +20,10 @@ type SyncClient struct {
 	taskClient      *taskwarrior.Client
 	calendarName    string
 	taskFilter      string
+	progressFunc    func(current, total int)
+	output          io.Writer     // Receives the printed summary and warnings (default os.Stdout)
+	prune           bool          // Pull deletes events whose task no longer exists
+	defaultDuration time.Duration // Length of timed events without a 'dur' UDA (0: defaultEventDuration)
 }
 
 // NewSyncClient creates a new sync client
@@ -36,14 +42,34 @@ func NewSyncClient(ctx context.Context, taskClient *taskwarrior.Client, credenti
 	}, nil
 }
 
+// SetProgressFunc registers a function called before each task is synced
+// with its position and the number of tasks to sync
+func (s *SyncClient) SetProgressFunc(f func(current, total int)) {
+	s.progressFunc = f
+}
+
+// SetOutput sets where the sync summary and warnings are printed.
+// Use io.Discard when the terminal is owned by another program (e.g. the TUI).
+func (s *SyncClient) SetOutput(w io.Writer) {
+	s.output = w
+}
+
+// SetDefaultDuration sets the length of timed events whose task has no valid 'dur' UDA.
+// A zero duration restores the built-in default.
+func (s *SyncClient) SetDefaultDuration(d time.Duration) {
+	s.defaultDuration = d
+}
+
 // SyncResult contains the results of a sync operation
 type SyncResult struct {
-	Total    int
-	Created  int
-	Updated  int
-	Deleted  int
-	Skipped  int
-	Warnings []string
+	Total            int
+	Created          int
+	Updated          int
+	Deleted          int
+	Skipped          int
+	TasksDeleted     int // Tasks deleted because their event was deleted in Calendar (pull)
+	TasksRescheduled int // Tasks whose due date followed their moved event (pull)
+	Warnings         []string
 }
 
 // Sync performs the synchronization from Taskwarrior to Google Calendar
@@ -108,7 +134,11 @@ func (s *SyncClient) Sync(ctx context.Context) (*SyncResult, error) {
 	deleted := 0
 	skipped := 0
 	warnings := 0
-	for _, task := range tasks {
+	for i, task := range tasks {
+		if s.progressFunc != nil {
+			s.progressFunc(i+1, len(tasks))
+		}
+
 		// Check if task has no due date and no scheduled date
 		hasNoDates := (task.Due == nil || task.Due.IsZero()) && (task.Scheduled == nil || task.Scheduled.IsZero())
 
@@ -142,7 +172,7 @@ func (s *SyncClient) Sync(ctx context.Context) (*SyncResult, error) {
 					"description", task.Description,
 					"scheduled", task.Scheduled.Format("2006-01-02 15:04:05"),
 					"due", task.Due.Format("2006-01-02 15:04:05"))
-				fmt.Printf("⚠️  WARNING: %s\n", warningMsg)
+				fmt.Fprintf(s.out(), "⚠️  WARNING: %s\n", warningMsg)
 				result.Warnings = append(result.Warnings, warningMsg)
 				warnings++
 			}
@@ -224,10 +254,10 @@ func (s *SyncClient) Sync(ctx context.Context) (*SyncResult, error) {
 	slog.Info("Sync completed", "total", result.Total, "created", result.Created, "updated", result.Updated, "deleted", result.Deleted, "skipped", result.Skipped, "warnings", len(result.Warnings))
 
 	// Print summary (for CLI mode and visibility)
-	fmt.Printf("\nSync completed: %d tasks, %d created, %d updated, %d deleted, %d skipped (no dates)\n",
+	fmt.Fprintf(s.out(), "\nSync completed: %d tasks, %d created, %d updated, %d deleted, %d skipped (no dates)\n",
 		result.Total, result.Created, result.Updated, result.Deleted, result.Skipped)
 	if len(result.Warnings) > 0 {
-		fmt.Printf("⚠️  %d WARNINGS: Tasks with scheduled > due\n", len(result.Warnings))
+		fmt.Fprintf(s.out(), "⚠️  %d WARNINGS: Tasks with scheduled > due\n", len(result.Warnings))
 	}
 
 	return result, nil
@@ -249,19 +279,35 @@ func (s *SyncClient) findCalendarByName(ctx context.Context, name string) (strin
 	return "", fmt.Errorf("calendar '%s' not found", name)
 }
 
+// out returns where the summary is printed, defaulting to os.Stdout
+func (s *SyncClient) out() io.Writer {
+	if s.output != nil {
+		return s.output
+	}
+	return os.Stdout
+}
+
 // getCalendarEvents retrieves events from the calendar that were created by this tool
 func (s *SyncClient) getCalendarEvents(ctx context.Context, calendarID string) ([]*calendar.Event, error) {
+	return s.listEvents(ctx, calendarID, false)
+}
+
+// listEvents retrieves the events created by this tool, including the ones deleted
+// in Calendar (with status "cancelled") when showDeleted is set
+func (s *SyncClient) listEvents(ctx context.Context, calendarID string, showDeleted bool) ([]*calendar.Event, error) {
 	// Get events from the past 30 days to the next 365 days
-	timeMin := time.Now().AddDate(0, 0, -30).Format(time.RFC3339)
-	timeMax := time.Now().AddDate(1, 0, 0).Format(time.RFC3339)
+	now := core.Now()
+	timeMin := now.AddDate(0, 0, -30).Format(time.RFC3339)
+	timeMax := now.AddDate(1, 0, 0).Format(time.RFC3339)
 
 	events, err := s.calendarService.Events.List(calendarID).
 		Context(ctx).
 		TimeMin(timeMin).
 		TimeMax(timeMax).
 		SingleEvents(true).
+		ShowDeleted(showDeleted).
 		OrderBy("startTime").
-		Fields("items(id,summary,description,start,end,colorId,reminders)").
+		Fields("items(id,summary,description,start,end,colorId,reminders,status,updated)").
 		Do()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list events: %w", err)
@@ -316,6 +362,12 @@ func (s *SyncClient) deleteEvent(ctx context.Context, calendarID string, eventID
 	return nil
 }
 
+// isAllDay reports whether the 'allDay' UDA asks for an all-day event
+func isAllDay(task core.Task) bool {
+	allDayUDA := task.GetUDA("allDay")
+	return allDayUDA != "" && (allDayUDA == "true" || allDayUDA == "True" || allDayUDA == "TRUE" || allDayUDA == "1" || allDayUDA == "yes" || allDayUDA == "Yes")
+}
+
 // taskToEvent converts a Taskwarrior task to a Google Calendar event
 func (s *SyncClient) taskToEvent(task core.Task) *calendar.Event {
 	// Add checkmark for completed tasks
@@ -344,8 +396,7 @@ func (s *SyncClient) taskToEvent(task core.Task) *calendar.Event {
 	}
 
 	// Check if user explicitly wants an all-day event via 'allDay' UDA
-	allDayUDA := task.GetUDA("allDay")
-	forceAllDay := allDayUDA != "" && (allDayUDA == "true" || allDayUDA == "True" || allDayUDA == "TRUE" || allDayUDA == "1" || allDayUDA == "yes" || allDayUDA == "Yes")
+	forceAllDay := isAllDay(task)
 
 	if forceAllDay {
 		// Create all-day event when allDay UDA is explicitly set to true
@@ -365,7 +416,7 @@ func (s *SyncClient) taskToEvent(task core.Task) *calendar.Event {
 		}
 		// Duration comes from the 'dur' UDA when set and valid, otherwise
 		// falls back to the default duration.
-		endTime := eventTime.Add(eventDuration(task))
+		endTime := eventTime.Add(s.eventDuration(task))
 		event.End = &calendar.EventDateTime{
 			DateTime: endTime.Format(time.RFC3339),
 		}
@@ -430,16 +481,23 @@ func (s *SyncClient) taskToEvent(task core.Task) *calendar.Event {
 	return event
 }
 
-// defaultEventDuration is used for timed events that have no valid 'dur' UDA.
+// defaultEventDuration is used for timed events that have no valid 'dur' UDA,
+// unless calendar_sync.default_event_duration is set.
 const defaultEventDuration = 15 * time.Minute
 
 // eventDuration returns how long a task's calendar event should last.
 //
 // It uses the Taskwarrior 'dur' UDA when present and parseable to a positive
-// duration, otherwise it falls back to defaultEventDuration. The result is
+// duration, otherwise it falls back to the configured default duration. The result is
 // rounded to whole seconds so it survives the RFC3339 (second-precision)
 // round-trip used for event start/end times, keeping update comparisons stable.
-func eventDuration(task core.Task) time.Duration {
+func (s *SyncClient) eventDuration(task core.Task) time.Duration {
+	return taskEventDuration(task, s.defaultDuration)
+}
+
+// taskEventDuration returns the 'dur' UDA of a task, or the fallback when it is
+// not set or invalid, or defaultEventDuration when neither is usable
+func taskEventDuration(task core.Task, fallback time.Duration) time.Duration {
 	if raw := task.GetUDA("dur"); raw != "" {
 		d, err := ParseTaskDuration(raw)
 		if err != nil {
@@ -448,6 +506,9 @@ func eventDuration(task core.Task) time.Duration {
 			return d.Round(time.Second)
 		}
 	}
+	if fallback > 0 {
+		return fallback.Round(time.Second)
+	}
 	return defaultEventDuration
 }
 
@@ -512,8 +573,7 @@ func (s *SyncClient) shouldUpdateEvent(task core.Task, event *calendar.Event) bo
 		"task_scheduled", task.Scheduled)
 
 	// Check if user explicitly wants an all-day event via 'allDay' UDA
-	allDayUDA := task.GetUDA("allDay")
-	forceAllDay := allDayUDA != "" && (allDayUDA == "true" || allDayUDA == "True" || allDayUDA == "TRUE" || allDayUDA == "1" || allDayUDA == "yes" || allDayUDA == "Yes")
+	forceAllDay := isAllDay(task)
 
 	if event.Start != nil {
 		if forceAllDay {
@@ -542,10 +602,10 @@ func (s *SyncClient) shouldUpdateEvent(task core.Task, event *calendar.Event) bo
 						eventEndTime, endErr := time.Parse(time.RFC3339, event.End.DateTime)
 						if endErr == nil {
 							actualDuration := eventEndTime.Sub(eventStartTime)
-							if actualDuration != eventDuration(task) {
+							if actualDuration != s.eventDuration(task) {
 								slog.Debug("Event duration changed",
 									"uuid", task.UUID,
-									"expected", eventDuration(task),
+									"expected", s.eventDuration(task),
 									"actual", actualDuration)
 								return true
 							}
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/clobrano/wui/internal/core"
-	"google.golang.org/api/calendar/v3"
 )
 
 func timedTask(dur string) core.Task {
@@ -37,7 +36,7 @@ func TestEventDuration(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := eventDuration(timedTask(tt.dur))
+			got := (&SyncClient{}).eventDuration(timedTask(tt.dur))
 			if got != tt.want {
 				t.Errorf("eventDuration(dur=%q) = %v, want %v", tt.dur, got, tt.want)
 			}
@@ -78,6 +77,31 @@ func TestTaskToEventDefaultDuration(t *testing.T) {
 	}
 }
 
+func TestTaskToEventConfiguredDefaultDuration(t *testing.T) {
+	s := &SyncClient{}
+	s.SetDefaultDuration(30 * time.Minute)
+
+	// The configured default replaces the built-in one, and dur still wins
+	event := s.taskToEvent(timedTask(""))
+	start, _ := time.Parse(time.RFC3339, event.Start.DateTime)
+	end, _ := time.Parse(time.RFC3339, event.End.DateTime)
+	if got := end.Sub(start); got != 30*time.Minute {
+		t.Errorf("event duration = %v, want %v", got, 30*time.Minute)
+	}
+	if got := s.eventDuration(timedTask("PT45M")); got != 45*time.Minute {
+		t.Errorf("eventDuration(dur=PT45M) = %v, want %v", got, 45*time.Minute)
+	}
+
+	// Events created with the configured default are not rewritten
+	if s.shouldUpdateEvent(timedTask(""), event) {
+		t.Error("expected no update for an event matching the configured default")
+	}
+	// Events created before the default changed are
+	if !(&SyncClient{}).shouldUpdateEvent(timedTask(""), event) {
+		t.Error("expected update when the default duration changes")
+	}
+}
+
 func TestShouldUpdateEventOnDurationChange(t *testing.T) {
 	s := &SyncClient{}
 
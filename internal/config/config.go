@@ -5,18 +5,35 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 // CalendarSync represents Google Calendar sync configuration
 type CalendarSync struct {
-	Enabled         bool   `yaml:"enabled"`
-	CalendarName    string `yaml:"calendar_name"`
-	TaskFilter      string `yaml:"task_filter"`
-	CredentialsPath string `yaml:"credentials_path"`
-	TokenPath       string `yaml:"token_path"`
-	AutoSyncOnQuit  bool   `yaml:"auto_sync_on_quit"`
+	Enabled              bool   `yaml:"enabled"`
+	CalendarName         string `yaml:"calendar_name"`
+	TaskFilter           string `yaml:"task_filter"`
+	CredentialsPath      string `yaml:"credentials_path"`
+	TokenPath            string `yaml:"token_path"`
+	AutoSyncOnQuit       bool   `yaml:"auto_sync_on_quit"`
+	DefaultEventDuration string `yaml:"default_event_duration,omitempty"` // Length of timed events without a 'dur' UDA, as a Go duration (e.g. "30m")
+}
+
+// EventDuration parses DefaultEventDuration. It returns 0 when it is not set.
+func (c *CalendarSync) EventDuration() (time.Duration, error) {
+	if c == nil || c.DefaultEventDuration == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(c.DefaultEventDuration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid default_event_duration %q: %w", c.DefaultEventDuration, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid default_event_duration %q: must be positive", c.DefaultEventDuration)
+	}
+	return d, nil
 }
 
 // ServeConfig holds configuration for the wui serve REST API server.
@@ -29,10 +46,12 @@ type Config struct {
 	TaskBin             string        `yaml:"task_bin"`
 	TaskrcPath          string        `yaml:"taskrc_path"`
 	LogLevel            string        `yaml:"log_level,omitempty"`
+	StrictJSON          bool          `yaml:"strict_json,omitempty"` // Fail loading tasks when one is malformed, instead of skipping it
 	TUI                 *TUIConfig    `yaml:"tui"`
 	CalendarSync        *CalendarSync `yaml:"calendar_sync,omitempty"`
 	Serve               *ServeConfig  `yaml:"serve,omitempty"`
 	InitialSearchFilter string        `yaml:"-"` // Not persisted to config file, set via CLI flag
+	StatePath           string        `yaml:"-"` // State file remembering tabs and filters between sessions; empty disables it
 }
 
 // LoadConfig loads configuration from a YAML file
@@ -65,6 +84,10 @@ func LoadConfig(path string) (*Config, error) {
 		return nil, fmt.Errorf("failed to parse config YAML: %w", err)
 	}
 
+	if err := validateTUI(loaded.TUI); err != nil {
+		return nil, err
+	}
+
 	// Merge with defaults
 	cfg = mergeWithDefaults(cfg, &loaded)
 
@@ -74,6 +97,18 @@ func LoadConfig(path string) (*Config, error) {
 	return cfg, nil
 }
 
+// validateTUI rejects the tui settings that have no sensible fallback
+func validateTUI(tui *TUIConfig) error {
+	if tui == nil {
+		return nil
+	}
+	switch tui.Clipboard {
+	case "", "library", "command", "osc52":
+		return nil
+	}
+	return fmt.Errorf("invalid tui.clipboard %q: must be library, command or osc52", tui.Clipboard)
+}
+
 // SaveConfig writes configuration to a YAML file
 func SaveConfig(cfg *Config, path string) error {
 	// Ensure directory exists
@@ -192,6 +227,7 @@ func mergeWithDefaults(defaults, loaded *Config) *Config {
 	if loaded.LogLevel != "" {
 		result.LogLevel = loaded.LogLevel
 	}
+	result.StrictJSON = loaded.StrictJSON
 
 	// Merge TUI config
 	if loaded.TUI != nil {
@@ -204,8 +240,29 @@ func mergeWithDefaults(defaults, loaded *Config) *Config {
 		if loaded.TUI.InputMode != "" {
 			result.TUI.InputMode = loaded.TUI.InputMode
 		}
+		if loaded.TUI.Clipboard != "" {
+			result.TUI.Clipboard = loaded.TUI.Clipboard
+		}
+		if loaded.TUI.ClipboardCommand != "" {
+			result.TUI.ClipboardCommand = loaded.TUI.ClipboardCommand
+		}
+		if loaded.TUI.ArchiveCommand != "" {
+			result.TUI.ArchiveCommand = loaded.TUI.ArchiveCommand
+		}
 		// Boolean fields - always copy from loaded config
 		result.TUI.RelativeDates = loaded.TUI.RelativeDates
+		result.TUI.DimFuture = loaded.TUI.DimFuture
+		result.TUI.Scrollbar = loaded.TUI.Scrollbar
+		result.TUI.JumpLabels = loaded.TUI.JumpLabels
+		result.TUI.NavAcceleration = loaded.TUI.NavAcceleration
+		result.TUI.NavWrap = loaded.TUI.NavWrap
+		result.TUI.SearchAnnotations = loaded.TUI.SearchAnnotations
+		result.TUI.NoConfirm = loaded.TUI.NoConfirm
+		result.TUI.WarnQuitWithSelection = loaded.TUI.WarnQuitWithSelection
+		result.TUI.AutoSidebarIfAnnotated = loaded.TUI.AutoSidebarIfAnnotated
+		result.TUI.CaptureEditOutput = loaded.TUI.CaptureEditOutput
+		result.TUI.ShowCommands = loaded.TUI.ShowCommands
+		result.TUI.SearchAutoFocus = loaded.TUI.SearchAutoFocus
 		result.TUI.SilenceShortcutOverrideWarnings = loaded.TUI.SilenceShortcutOverrideWarnings
 		if len(loaded.TUI.Tabs) > 0 {
 			result.TUI.Tabs = loaded.TUI.Tabs
@@ -225,6 +282,105 @@ func mergeWithDefaults(defaults, loaded *Config) *Config {
 		if len(loaded.TUI.CustomCommands) > 0 {
 			result.TUI.CustomCommands = loaded.TUI.CustomCommands
 		}
+		if len(loaded.TUI.ConfirmMessages) > 0 {
+			// Merge confirmation messages (loaded overrides defaults)
+			if result.TUI.ConfirmMessages == nil {
+				result.TUI.ConfirmMessages = make(map[string]string)
+			}
+			for action, message := range loaded.TUI.ConfirmMessages {
+				result.TUI.ConfirmMessages[action] = message
+			}
+		}
+		if loaded.TUI.Language != "" {
+			result.TUI.Language = loaded.TUI.Language
+		}
+		if len(loaded.TUI.AutoAnnotate) > 0 {
+			result.TUI.AutoAnnotate = loaded.TUI.AutoAnnotate
+		}
+		if len(loaded.TUI.EmptyInputAction) > 0 {
+			result.TUI.EmptyInputAction = loaded.TUI.EmptyInputAction
+		}
+		if len(loaded.TUI.Templates) > 0 {
+			result.TUI.Templates = loaded.TUI.Templates
+		}
+		if len(loaded.TUI.ProjectRules) > 0 {
+			result.TUI.ProjectRules = loaded.TUI.ProjectRules
+		}
+		if len(loaded.TUI.SidebarLabels) > 0 {
+			result.TUI.SidebarLabels = loaded.TUI.SidebarLabels
+		}
+		if loaded.TUI.MarkdownExport != nil {
+			result.TUI.MarkdownExport = loaded.TUI.MarkdownExport
+		}
+		if loaded.TUI.CompletedSort != "" {
+			result.TUI.CompletedSort = loaded.TUI.CompletedSort
+		}
+		if loaded.TUI.Ellipsis != "" {
+			result.TUI.Ellipsis = loaded.TUI.Ellipsis
+		}
+		if loaded.TUI.TagsDisplay != "" {
+			result.TUI.TagsDisplay = loaded.TUI.TagsDisplay
+		}
+		if len(loaded.TUI.PriorityTags) > 0 {
+			result.TUI.PriorityTags = loaded.TUI.PriorityTags
+		}
+		if len(loaded.TUI.ColumnAlign) > 0 {
+			result.TUI.ColumnAlign = loaded.TUI.ColumnAlign
+		}
+		if loaded.TUI.ColumnSeparator != "" {
+			result.TUI.ColumnSeparator = loaded.TUI.ColumnSeparator
+		}
+		if loaded.TUI.ProjectDisplay != "" {
+			result.TUI.ProjectDisplay = loaded.TUI.ProjectDisplay
+		}
+		if loaded.TUI.SubtaskDirection != "" {
+			result.TUI.SubtaskDirection = loaded.TUI.SubtaskDirection
+		}
+		if loaded.TUI.AnnotateOnDone != "" {
+			result.TUI.AnnotateOnDone = loaded.TUI.AnnotateOnDone
+		}
+		if loaded.TUI.FilterEditMode != "" {
+			result.TUI.FilterEditMode = loaded.TUI.FilterEditMode
+		}
+		if loaded.TUI.SearchHelp != "" {
+			result.TUI.SearchHelp = loaded.TUI.SearchHelp
+		}
+		if loaded.TUI.TaskShell != "" {
+			result.TUI.TaskShell = loaded.TUI.TaskShell
+		}
+		if loaded.TUI.DueDisplay != "" {
+			result.TUI.DueDisplay = loaded.TUI.DueDisplay
+		}
+		if loaded.TUI.RelativePrecision != "" {
+			result.TUI.RelativePrecision = loaded.TUI.RelativePrecision
+		}
+		if loaded.TUI.StartupAction != "" {
+			result.TUI.StartupAction = loaded.TUI.StartupAction
+		}
+		if len(loaded.TUI.ViewCycle) > 0 {
+			result.TUI.ViewCycle = loaded.TUI.ViewCycle
+		}
+		if len(loaded.TUI.EscBehavior) > 0 {
+			result.TUI.EscBehavior = loaded.TUI.EscBehavior
+		}
+		if len(loaded.TUI.HomeWidgets) > 0 {
+			result.TUI.HomeWidgets = loaded.TUI.HomeWidgets
+		}
+		if loaded.TUI.CounterUDA != "" {
+			result.TUI.CounterUDA = loaded.TUI.CounterUDA
+		}
+		if loaded.TUI.CounterStep > 0 {
+			result.TUI.CounterStep = loaded.TUI.CounterStep
+		}
+		if loaded.TUI.UUIDLength > 0 {
+			result.TUI.UUIDLength = loaded.TUI.UUIDLength
+		}
+		if loaded.TUI.MaxVisibleTabs > 0 {
+			result.TUI.MaxVisibleTabs = loaded.TUI.MaxVisibleTabs
+		}
+		if loaded.TUI.CacheTTLSeconds > 0 {
+			result.TUI.CacheTTLSeconds = loaded.TUI.CacheTTLSeconds
+		}
 		if loaded.TUI.Theme != nil {
 			result.TUI.Theme = mergeThem(result.TUI.Theme, loaded.TUI.Theme)
 		}
@@ -3,7 +3,9 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -140,6 +142,25 @@ invalid yaml content {{{
 	}
 }
 
+func TestLoadConfig_InvalidClipboard(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte("tui:\n  clipboard: osc-52\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	_, err := LoadConfig(configPath)
+	if err == nil || !strings.Contains(err.Error(), `invalid tui.clipboard "osc-52"`) {
+		t.Errorf("Expected an invalid clipboard error, got %v", err)
+	}
+
+	if err := os.WriteFile(configPath, []byte("tui:\n  clipboard: osc52\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+	if cfg, err := LoadConfig(configPath); err != nil || cfg.TUI.Clipboard != "osc52" {
+		t.Errorf("Expected osc52 to be accepted, got %v", err)
+	}
+}
+
 func TestSaveConfig(t *testing.T) {
 	tmpDir := t.TempDir()
 	configPath := filepath.Join(tmpDir, "config.yaml")
@@ -424,3 +445,317 @@ func TestConfigMergeNarrowViewFields(t *testing.T) {
 		t.Errorf("Expected second field to be 'priority', got %s", cfg.TUI.NarrowViewFields[1].Name)
 	}
 }
+
+func TestConfigMergeConfirmMessages(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	customYAML := `tui:
+  confirm_messages:
+    delete: "Really destroy '{{.description}}' forever? (y/N)"
+    custom: "Are you sure?"
+`
+
+	err := os.WriteFile(configPath, []byte(customYAML), 0644)
+	if err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if got := cfg.TUI.ConfirmMessages["delete"]; got != "Really destroy '{{.description}}' forever? (y/N)" {
+		t.Errorf("Expected custom delete message, got %q", got)
+	}
+	if got := cfg.TUI.ConfirmMessages["custom"]; got != "Are you sure?" {
+		t.Errorf("Expected extra confirm message to be kept, got %q", got)
+	}
+}
+
+func TestDefaultConfirmMessages(t *testing.T) {
+	cfg := DefaultConfig()
+	if cfg.TUI.ConfirmMessages["delete"] == "" {
+		t.Error("Expected a default delete confirmation message")
+	}
+}
+
+func TestConfigLanguage(t *testing.T) {
+	if got := DefaultConfig().TUI.Language; got != "en" {
+		t.Errorf("Expected default language en, got %q", got)
+	}
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("tui:\n  language: it\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg.TUI.Language != "it" {
+		t.Errorf("Expected language it, got %q", cfg.TUI.Language)
+	}
+}
+
+func TestConfigCounterUDA(t *testing.T) {
+	if got := DefaultConfig().TUI.CounterStep; got != 1 {
+		t.Errorf("Expected default counter step 1, got %v", got)
+	}
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("tui:\n  counter_uda: estimate\n  counter_step: 0.5\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg.TUI.CounterUDA != "estimate" {
+		t.Errorf("Expected counter UDA estimate, got %q", cfg.TUI.CounterUDA)
+	}
+	if cfg.TUI.CounterStep != 0.5 {
+		t.Errorf("Expected counter step 0.5, got %v", cfg.TUI.CounterStep)
+	}
+}
+
+func TestConfigProjectDisplay(t *testing.T) {
+	if got := DefaultConfig().TUI.ProjectDisplay; got != "full" {
+		t.Errorf("Expected default project display full, got %q", got)
+	}
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("tui:\n  project_display: leaf\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg.TUI.ProjectDisplay != "leaf" {
+		t.Errorf("Expected project display leaf, got %q", cfg.TUI.ProjectDisplay)
+	}
+}
+
+func TestConfigEllipsis(t *testing.T) {
+	if got := DefaultConfig().TUI.Ellipsis; got != "..." {
+		t.Errorf("Expected default ellipsis ..., got %q", got)
+	}
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("tui:\n  ellipsis: \"…\"\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg.TUI.Ellipsis != "…" {
+		t.Errorf("Expected ellipsis …, got %q", cfg.TUI.Ellipsis)
+	}
+}
+
+func TestConfigColumnSeparator(t *testing.T) {
+	if got := DefaultConfig().TUI.ColumnSeparator; got != " " {
+		t.Errorf("Expected default column separator to be a space, got %q", got)
+	}
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("tui:\n  column_separator: \" │ \"\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg.TUI.ColumnSeparator != " │ " {
+		t.Errorf("Expected column separator \" │ \", got %q", cfg.TUI.ColumnSeparator)
+	}
+}
+
+func TestConfigTemplates(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	customYAML := `tui:
+  templates:
+    - name: Code review
+      description: "Review PR #"
+      project: Work
+      tags: [review, code]
+      due: +3d
+`
+	if err := os.WriteFile(configPath, []byte(customYAML), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(cfg.TUI.Templates) != 1 {
+		t.Fatalf("Expected 1 template, got %d", len(cfg.TUI.Templates))
+	}
+	tmpl := cfg.TUI.Templates[0]
+	if tmpl.Name != "Code review" || tmpl.Project != "Work" || tmpl.Due != "+3d" || len(tmpl.Tags) != 2 {
+		t.Errorf("Unexpected template: %+v", tmpl)
+	}
+}
+
+func TestConfigCustomCommands(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	customYAML := `tui:
+  custom_commands:
+    E:
+      name: Notes
+      command: "vim ~/notes/{{.uuid}}.md"
+      interactive: true
+      refresh: true
+    "1":
+      name: Copy
+      command: "echo {{.description}}"
+      capture_output: true
+`
+	if err := os.WriteFile(configPath, []byte(customYAML), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(cfg.TUI.CustomCommands) != 2 {
+		t.Fatalf("Expected 2 custom commands, got %d", len(cfg.TUI.CustomCommands))
+	}
+	if cmd := cfg.TUI.CustomCommands["E"]; cmd.Name != "Notes" || !cmd.Interactive || !cmd.Refresh {
+		t.Errorf("Unexpected command for E: %+v", cmd)
+	}
+	if cmd := cfg.TUI.CustomCommands["1"]; cmd.Command != "echo {{.description}}" || cmd.Interactive || cmd.Refresh || !cmd.CaptureOutput {
+		t.Errorf("Unexpected command for 1: %+v", cmd)
+	}
+}
+
+func TestConfigMarkdownExport(t *testing.T) {
+	if DefaultConfig().TUI.MarkdownExport != nil {
+		t.Error("Expected no markdown export options by default")
+	}
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	customYAML := `tui:
+  markdown_export:
+    fields: [project, due]
+    annotations: true
+    annotation_style: quote
+    annotation_dates: true
+    max_line_length: 80
+`
+	if err := os.WriteFile(configPath, []byte(customYAML), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	export := cfg.TUI.MarkdownExport
+	if export == nil || len(export.Fields) != 2 || !export.Annotations || export.AnnotationStyle != "quote" ||
+		!export.AnnotationDates || export.MaxLineLength != 80 {
+		t.Errorf("Unexpected markdown export options: %+v", export)
+	}
+}
+
+func TestConfigRelativePrecision(t *testing.T) {
+	if got := DefaultConfig().TUI.RelativePrecision; got != "coarse" {
+		t.Errorf("Expected default relative precision coarse, got %q", got)
+	}
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("tui:\n  relative_precision: fine\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg.TUI.RelativePrecision != "fine" {
+		t.Errorf("Expected relative precision fine, got %q", cfg.TUI.RelativePrecision)
+	}
+}
+
+func TestConfigSidebarLabels(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	customYAML := `tui:
+  sidebar_labels:
+    urgency: Urg
+    dependencies: Blocked by
+`
+	if err := os.WriteFile(configPath, []byte(customYAML), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg.TUI.SidebarLabels["urgency"] != "Urg" || cfg.TUI.SidebarLabels["dependencies"] != "Blocked by" {
+		t.Errorf("Unexpected sidebar labels: %v", cfg.TUI.SidebarLabels)
+	}
+}
+
+func TestCalendarSyncEventDuration(t *testing.T) {
+	if d, err := DefaultCalendarSync().EventDuration(); d != 0 || err != nil {
+		t.Errorf("Expected no default event duration, got %v (err %v)", d, err)
+	}
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("calendar_sync:\n  default_event_duration: 1h30m\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if d, err := cfg.CalendarSync.EventDuration(); d != 90*time.Minute || err != nil {
+		t.Errorf("Expected 1h30m, got %v (err %v)", d, err)
+	}
+
+	for _, invalid := range []string{"30", "half an hour", "-5m", "0s"} {
+		if _, err := (&CalendarSync{DefaultEventDuration: invalid}).EventDuration(); err == nil {
+			t.Errorf("Expected error for default_event_duration %q", invalid)
+		}
+	}
+}
+
+func TestConfigStrictJSON(t *testing.T) {
+	if DefaultConfig().StrictJSON {
+		t.Error("Expected lenient task parsing by default")
+	}
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte("strict_json: true\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config: %v", err)
+	}
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !cfg.StrictJSON {
+		t.Error("Expected strict_json to be loaded")
+	}
+}
@@ -49,6 +49,33 @@ func DefaultTUIConfig() *TUIConfig {
 		NarrowViewFields:          DefaultNarrowViewFields(),
 		Keybindings:               DefaultKeybindings(),
 		Theme:                     DefaultTheme(),
+		ConfirmMessages:           DefaultConfirmMessages(),
+		Language:                  "en",
+		CounterStep:               1,
+		CompletedSort:             "end",
+		Ellipsis:                  "...",
+		ColumnSeparator:           " ",
+		TagsDisplay:               "list",
+		ProjectDisplay:            "full",
+		StartupAction:             "none",
+		RelativePrecision:         "coarse",
+		DueDisplay:                "absolute",
+		SubtaskDirection:          "blocks_parent",
+		FilterEditMode:            "extend",
+		AnnotateOnDone:            "off",
+		ViewCycle:                 []string{"list", "sidebar"},
+		EscBehavior:               []string{"clear_selection", "close_sidebar", "group_back"},
+		HomeWidgets:               []string{"tab_counts", "overdue", "agenda", "recent"},
+		UUIDLength:                13,
+	}
+}
+
+// DefaultConfirmMessages returns the default confirmation prompts keyed by action.
+// Prompts may reference fields of the selected task with {{.field}} placeholders.
+// They are the English prompts: other UI languages show their own translation.
+func DefaultConfirmMessages() map[string]string {
+	return map[string]string{
+		"delete": "Delete task '{{.description}}'? (y/N)",
 	}
 }
 
@@ -118,32 +145,76 @@ func DefaultKeybindings() map[string]string {
 		// Navigation
 		"quit":           "q",
 		"help":           "?",
+		"messages":       "E",
 		"up":             "k",
 		"down":           "j",
 		"page_up":        "ctrl+u",
 		"page_down":      "ctrl+d",
 		"first":          "g",
 		"last":           "G",
+		"jump":           "f",
 		"toggle_sidebar": "tab",
 
 		// Sections
 		"next_section": "L",
 		"prev_section": "H",
+		"more_tabs":    "0",
 
 		// Task operations
-		"done":     "d",
-		"delete":   "x",
-		"edit":     "e",
-		"modify":   "m",
-		"annotate": "a",
-		"todo":     "t",
-		"new":      "n",
-		"undo":     "u",
-		"open_url": "o",
+		"done":           "d",
+		"delete":         "x",
+		"edit":           "e",
+		"modify":         "m",
+		"annotate":       "a",
+		"todo":           "t",
+		"annotate_path":  "F",
+		"new":            "n",
+		"new_recurring":  "R",
+		"add_subtask":    "A",
+		"duplicate":      "c",
+		"undo":           "u",
+		"reopen":         "O",
+		"archive":        "ctrl+a",
+		"open_url":       "o",
+		"due_presets":    "w",
+		"set_due":        "D",
+		"clear_due":      "W",
+		"assign_project": "P",
+		"priority_up":    ">",
+		"priority_down":  "<",
+		"counter_up":     "]",
+		"counter_down":   "[",
+
+		// Clipboard
+		"yank_description": "Y",
+		"export_checklist": "C",
 
 		// Filtering
-		"filter":  "/",
-		"refresh": "r",
+		"filter":         "/",
+		"filter_project": "=",
+		"filter_tag":     "+",
+		"global_search":  "ctrl+g",
+		"fuzzy_find":     "ctrl+k",
+		"refresh":        "r",
+		"task_sync":      "S",
+		"calendar_sync":  "ctrl+s",
+		"task_shell":     "T",
+		"copy_filter":    "y",
+		"copy_status":    "ctrl+y",
+		"context":        "ctrl+x",
+
+		// Confirmations
+		"toggle_confirm": "!",
+
+		// Views
+		"project_panes":         "p",
+		"toggle_uuids":          "U",
+		"toggle_completed_last": "B",
+		"sort_column":           "I",
+		"sort_reverse":          "ctrl+r",
+		"cycle_view":            "v",
+		"peek":                  "i",
+		"focus_task":            "z",
 	}
 }
 
@@ -166,6 +237,7 @@ func GetInternalShortcuts(keybindings map[string]string) map[string]string {
 	// Configurable keybindings
 	shortcuts[getKey("quit", "q")] = "quit"
 	shortcuts[getKey("help", "?")] = "toggle help"
+	shortcuts[getKey("messages", "E")] = "message history"
 	shortcuts[getKey("up", "k")] = "move up"
 	shortcuts[getKey("down", "j")] = "move down"
 	shortcuts[getKey("page_up", "ctrl+u")] = "page up"
@@ -175,17 +247,54 @@ func GetInternalShortcuts(keybindings map[string]string) map[string]string {
 	shortcuts[getKey("toggle_sidebar", "tab")] = "toggle sidebar"
 	shortcuts[getKey("next_section", "L")] = "next section"
 	shortcuts[getKey("prev_section", "H")] = "previous section"
+	shortcuts[getKey("more_tabs", "0")] = "list hidden tabs"
+	shortcuts[getKey("jump", "f")] = "jump to a labeled row"
 	shortcuts[getKey("done", "d")] = "mark done"
 	shortcuts[getKey("delete", "x")] = "delete"
 	shortcuts[getKey("edit", "e")] = "edit"
 	shortcuts[getKey("modify", "m")] = "modify"
 	shortcuts[getKey("annotate", "a")] = "annotate"
 	shortcuts[getKey("todo", "t")] = "add TODO annotation"
+	shortcuts[getKey("annotate_path", "F")] = "annotate with file path"
 	shortcuts[getKey("new", "n")] = "new task"
+	shortcuts[getKey("new_recurring", "R")] = "new recurring task"
+	shortcuts[getKey("add_subtask", "A")] = "add subtask"
+	shortcuts[getKey("duplicate", "c")] = "duplicate task"
 	shortcuts[getKey("undo", "u")] = "undo"
+	shortcuts[getKey("reopen", "O")] = "reopen completed task"
+	shortcuts[getKey("archive", "ctrl+a")] = "archive completed tasks shown"
 	shortcuts[getKey("open_url", "o")] = "open URL/file from annotation"
+	shortcuts[getKey("due_presets", "w")] = "due date presets"
+	shortcuts[getKey("set_due", "D")] = "set due date in calendar"
+	shortcuts[getKey("clear_due", "W")] = "clear due date"
+	shortcuts[getKey("assign_project", "P")] = "assign to project"
+	shortcuts[getKey("priority_up", ">")] = "raise priority"
+	shortcuts[getKey("priority_down", "<")] = "lower priority"
+	shortcuts[getKey("counter_up", "]")] = "increment counter UDA"
+	shortcuts[getKey("counter_down", "[")] = "decrement counter UDA"
+	shortcuts[getKey("yank_description", "Y")] = "copy task description"
+	shortcuts[getKey("export_checklist", "C")] = "export checklist"
 	shortcuts[getKey("filter", "/")] = "filter"
+	shortcuts[getKey("filter_project", "=")] = "filter to the task's project"
+	shortcuts[getKey("filter_tag", "+")] = "filter to the task's first tag"
+	shortcuts[getKey("global_search", "ctrl+g")] = "search all tasks"
+	shortcuts[getKey("fuzzy_find", "ctrl+k")] = "fuzzy find in the loaded tasks"
 	shortcuts[getKey("refresh", "r")] = "refresh"
+	shortcuts[getKey("task_sync", "S")] = "task sync"
+	shortcuts[getKey("calendar_sync", "ctrl+s")] = "sync Google Calendar"
+	shortcuts[getKey("task_shell", "T")] = "open task shell"
+	shortcuts[getKey("copy_filter", "y")] = "copy filter as task command"
+	shortcuts[getKey("copy_status", "ctrl+y")] = "copy tab, task count and filter"
+	shortcuts[getKey("context", "ctrl+x")] = "choose Taskwarrior context"
+	shortcuts[getKey("project_panes", "p")] = "toggle two-pane projects view"
+	shortcuts[getKey("toggle_uuids", "U")] = "toggle long UUIDs"
+	shortcuts[getKey("toggle_completed_last", "B")] = "toggle completed tasks at the bottom"
+	shortcuts[getKey("sort_column", "I")] = "sort by next column"
+	shortcuts[getKey("sort_reverse", "ctrl+r")] = "reverse column sort"
+	shortcuts[getKey("cycle_view", "v")] = "cycle view modes"
+	shortcuts[getKey("peek", "i")] = "peek at task"
+	shortcuts[getKey("focus_task", "z")] = "focus on task and its dependencies"
+	shortcuts[getKey("toggle_confirm", "!")] = "toggle confirmations"
 
 	// Hardcoded shortcuts (not configurable)
 	shortcuts["s"] = "start/stop task"
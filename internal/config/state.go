@@ -0,0 +1,60 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// State holds what wui remembers between sessions. Unlike Config, it is written
+// by wui itself, on quit.
+type State struct {
+	ActiveTab         int               `yaml:"active_tab"`                   // Index of the last active tab (0 is Search)
+	ActiveTabName     string            `yaml:"active_tab_name,omitempty"`    // Name of the last active tab, to detect renamed or moved tabs
+	TabFilters        map[string]string `yaml:"tab_filters,omitempty"`        // Last filter of each tab, by tab name
+	ConfiguredFilters map[string]string `yaml:"configured_filters,omitempty"` // Configured filter of each tab in TabFilters, to drop entries when it changes
+}
+
+// StatePath returns the path of the state file kept next to the config file
+func StatePath(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), "state.yaml")
+}
+
+// LoadState loads the state saved by a previous session.
+// A missing file is not an error: it returns an empty state.
+func LoadState(path string) (*State, error) {
+	state := &State{}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, fmt.Errorf("failed to read state file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse state YAML: %w", err)
+	}
+	return state, nil
+}
+
+// SaveState writes the state to a YAML file
+func SaveState(state *State, path string) error {
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+	return nil
+}
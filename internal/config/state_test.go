@@ -0,0 +1,66 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStatePath(t *testing.T) {
+	got := StatePath("/home/user/.config/wui/config.yaml")
+	if want := "/home/user/.config/wui/state.yaml"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestLoadState_FileNotExist(t *testing.T) {
+	state, err := LoadState(filepath.Join(t.TempDir(), "state.yaml"))
+	if err != nil {
+		t.Fatalf("Expected no error for a missing state file, got %v", err)
+	}
+	if state.ActiveTab != 0 || state.ActiveTabName != "" || len(state.TabFilters) != 0 {
+		t.Errorf("Expected an empty state, got %+v", state)
+	}
+}
+
+func TestSaveAndLoadState(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "wui", "state.yaml")
+
+	state := &State{
+		ActiveTab:     2,
+		ActiveTabName: "Waiting",
+		TabFilters: map[string]string{
+			"Next":   "status:pending +work",
+			"Search": "rent",
+		},
+		ConfiguredFilters: map[string]string{"Next": "status:pending"},
+	}
+	if err := SaveState(state, statePath); err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+
+	loaded, err := LoadState(statePath)
+	if err != nil {
+		t.Fatalf("LoadState failed: %v", err)
+	}
+	if loaded.ActiveTab != 2 || loaded.ActiveTabName != "Waiting" {
+		t.Errorf("Expected active tab 2 (Waiting), got %d (%s)", loaded.ActiveTab, loaded.ActiveTabName)
+	}
+	if len(loaded.TabFilters) != 2 || loaded.TabFilters["Next"] != "status:pending +work" || loaded.TabFilters["Search"] != "rent" {
+		t.Errorf("Expected the tab filters to round-trip, got %v", loaded.TabFilters)
+	}
+	if loaded.ConfiguredFilters["Next"] != "status:pending" {
+		t.Errorf("Expected the configured filters to round-trip, got %v", loaded.ConfiguredFilters)
+	}
+}
+
+func TestLoadState_InvalidYAML(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "state.yaml")
+	if err := os.WriteFile(statePath, []byte("tab_filters: {{{\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test state: %v", err)
+	}
+
+	if _, err := LoadState(statePath); err == nil {
+		t.Error("Expected error for invalid YAML")
+	}
+}
@@ -8,7 +8,40 @@ type TUIConfig struct {
 	ScrollBuffer                    int                      `yaml:"scroll_buffer"`
 	InputMode                       string                   `yaml:"input_mode"` // "floating" or "bottom" - controls how input prompts are displayed
 	RelativeDates                   bool                     `yaml:"relative_dates,omitempty"`                      // Show dates as relative (e.g., "2 weeks ago") instead of absolute (YYYY-MM-DD)
+	RelativePrecision               string                   `yaml:"relative_precision,omitempty"`                  // Units shown in the sidebar's relative dates: "coarse" ("2 days ago", default) or "fine" ("2 days 3 hours ago")
+	DueDisplay                      string                   `yaml:"due_display,omitempty"`                         // How the due column is shown: "absolute" (YYYY-MM-DD, default) or "relative" ("in 2d", "3d ago")
 	ForceSmallScreen                bool                     `yaml:"force_small_screen,omitempty"`                  // Force small screen mode regardless of terminal width
+	DimFuture                       bool                     `yaml:"dim_future,omitempty"`                          // Dim tasks with a future wait or scheduled date in the task list
+	Scrollbar                       bool                     `yaml:"scrollbar,omitempty"`                           // Show a vertical scrollbar on the right edge of the task list and sidebar
+	JumpLabels                      bool                     `yaml:"jump_labels,omitempty"`                         // Let the jump key label the visible rows, so that any of them is reached by typing its label
+	NavAcceleration                 bool                     `yaml:"nav_acceleration,omitempty"`                    // Move by more rows (1, then 3, then 5) while an up or down key is held
+	NavWrap                         bool                     `yaml:"nav_wrap,omitempty"`                            // Move from the last row to the first (and from the first to the last) instead of stopping
+	SearchAnnotations               bool                     `yaml:"search_annotations,omitempty"`                  // Match plain Search tab terms against annotation text as well as the description
+	NoConfirm                       bool                     `yaml:"no_confirm,omitempty"`                          // Skip confirmation prompts for destructive actions (e.g. delete); can be toggled at runtime
+	WarnQuitWithSelection           bool                     `yaml:"warn_quit_with_selection,omitempty"`            // Ask for a second quit press while tasks are multi-selected
+	AutoSidebarIfAnnotated          bool                     `yaml:"auto_sidebar_if_annotated,omitempty"`           // Open the sidebar when navigating onto a task with annotations and close it for tasks without
+	CaptureEditOutput               bool                     `yaml:"capture_edit_output,omitempty"`                 // Show the errors printed by task edit in the message history afterward
+	ShowCommands                    bool                     `yaml:"show_commands,omitempty"`                       // Show the task commands run by wui in the message history, to understand and reproduce them
+	Clipboard                       string                   `yaml:"clipboard,omitempty"`                           // Where the copy actions write: "library" (the system clipboard), "command" (clipboard_command, the default when set) or "osc52" (an escape sequence asking the terminal, which also works over SSH)
+	ClipboardCommand                string                   `yaml:"clipboard_command,omitempty"`                   // Command that reads copied text on its standard input, e.g. "wl-copy" (default: the system clipboard)
+	ArchiveCommand                  string                   `yaml:"archive_command,omitempty"`                     // Command archiving the completed tasks shown, run once with their UUIDs in WUI_TASK_UUIDS (default: none, archiving is disabled)
+	CompletedSort                   string                   `yaml:"completed_sort,omitempty"`                      // Ordering of completed tasks at the bottom of lists: "end" (most recently completed first), "none" (section sort) or a sort method
+	Ellipsis                        string                   `yaml:"ellipsis,omitempty"`                            // Marker appended to truncated text in the task list and sidebar (default: "...")
+	TagsDisplay                     string                   `yaml:"tags_display,omitempty"`                        // How the tags column is shown: "list" (default), "count" (tags that do not fit are counted, e.g. "+work, +2 tags") or "priority" (priority_tags first)
+	PriorityTags                    []string                 `yaml:"priority_tags,omitempty"`                       // Tags listed first in the tags column when tags_display is "priority"
+	ColumnAlign                     map[string]string        `yaml:"column_align,omitempty"`                        // Alignment of task list columns keyed by column name: "left" or "right" (default: right for id and urgency, left for the others)
+	ColumnSeparator                 string                   `yaml:"column_separator,omitempty"`                    // Text drawn between task list columns, e.g. " │ " (default: a space)
+	ProjectDisplay                  string                   `yaml:"project_display,omitempty"`                     // How nested project names are shown: "full" (Work.company1), "leaf" (company1) or "abbreviated" (W.company1)
+	StartupAction                   string                   `yaml:"startup_action,omitempty"`                      // Action run when wui starts: "none" (default), "new_task" or "filter:<filter>"; ignored with --search
+	SubtaskDirection                string                   `yaml:"subtask_direction,omitempty"`                   // How add_subtask links the new task: "blocks_parent" (the parent depends on it, default) or "depends_on_parent"
+	AnnotateOnDone                  string                   `yaml:"annotate_on_done,omitempty"`                    // Completion note asked when marking tasks done: "off" (default) or "prompt" (annotate first; an empty note just completes)
+	FilterEditMode                  string                   `yaml:"filter_edit_mode,omitempty"`                    // How the filter input opens: "extend" (active filter plus a trailing space, default), "edit" (active filter) or "replace" (empty)
+	SearchHelp                      string                   `yaml:"search_help,omitempty"`                         // Message shown by the Search tab before a search (default: how to search, with examples)
+	SearchAutoFocus                 bool                     `yaml:"search_auto_focus,omitempty"`                   // Open the filter input when switching to the Search tab
+	TaskShell                       string                   `yaml:"task_shell,omitempty"`                          // Command run by the task_shell key, with TASKRC set (default: $SHELL), e.g. "tasksh"
+	ViewCycle                       []string                 `yaml:"view_cycle,omitempty"`                          // View modes visited by the cycle_view key, in order: "list", "sidebar", "detail", "projects" (default: list, sidebar)
+	EscBehavior                     []string                 `yaml:"esc_behavior,omitempty"`                        // Actions tried in order by esc until one applies: "clear_selection", "close_sidebar", "group_back", "quit", "none" (default: clear_selection, close_sidebar, group_back)
+	HomeWidgets                     []string                 `yaml:"home_widgets,omitempty"`                        // Widgets shown by a tab named "Home", in order: "tab_counts", "overdue", "agenda", "recent" (default: all)
 	SilenceShortcutOverrideWarnings bool                     `yaml:"silence_shortcut_override_warnings,omitempty"`
 	ValidateTodosOnComplete         *bool                    `yaml:"validate_todos_on_complete,omitempty"`   // Prevent completing tasks with TODO: annotations (default: true)
 	ValidateBlockedOnComplete       *bool                    `yaml:"validate_blocked_on_complete,omitempty"` // Prevent completing tasks blocked by other tasks (default: true)
@@ -18,21 +51,66 @@ type TUIConfig struct {
 	Keybindings                     map[string]string        `yaml:"keybindings"`
 	Theme                           *Theme                   `yaml:"theme"`
 	CustomCommands                  map[string]CustomCommand `yaml:"custom_commands,omitempty"`
+	ConfirmMessages                 map[string]string        `yaml:"confirm_messages,omitempty"` // Confirmation prompts keyed by action (e.g. "delete"); supports {{.field}} placeholders
+	Language                        string                   `yaml:"language,omitempty"`         // UI language code (e.g. "en"); untranslated messages fall back to English
+	CounterUDA                      string                   `yaml:"counter_uda,omitempty"`      // Numeric UDA adjusted by the counter increment/decrement actions (e.g. "estimate")
+	CounterStep                     float64                  `yaml:"counter_step,omitempty"`     // Amount added or subtracted by the counter actions (default: 1)
+	UUIDLength                      int                      `yaml:"uuid_length,omitempty"`      // UUID prefix length shown when long UUIDs are toggled on (default: 13)
+	MaxVisibleTabs                  int                      `yaml:"max_visible_tabs,omitempty"` // Tabs shown in the tabs bar before a "More" entry that lists the rest (default: all)
+	CacheTTLSeconds                 int                      `yaml:"cache_ttl_seconds,omitempty"` // Reuse the tasks loaded for a filter for this many seconds (e.g. on quick tab switches); changes drop them (default: 0, no cache)
+	AutoAnnotate                    map[string]string        `yaml:"auto_annotate,omitempty"`    // Annotation templates added after an action succeeds, keyed by action ("start", "stop", "done"); supports {{.field}} placeholders
+	EmptyInputAction                map[string]string        `yaml:"empty_input_action,omitempty"` // What enter does on an empty input, keyed by input ("modify", "annotate"): "cancel" (close it, default) or "stay" (keep it open)
+	Templates                       []TaskTemplate           `yaml:"templates,omitempty"`        // Named scaffolds offered when creating a new task
+	ProjectRules                    []ProjectRule            `yaml:"project_rules,omitempty"`    // Tag changes applied when tasks are assigned to a project
+	MarkdownExport                  *MarkdownExport          `yaml:"markdown_export,omitempty"`  // Fields and annotation layout of the markdown export (default: checklist item only)
+	SidebarLabels                   map[string]string        `yaml:"sidebar_labels,omitempty"`   // Sidebar field and section labels keyed by field (e.g. "urgency", "dependencies"); unset labels stay in English
+}
+
+// MarkdownExport configures the markdown export of tasks
+type MarkdownExport struct {
+	Fields          []string `yaml:"fields,omitempty"`           // Task fields appended after the description (e.g. project, due, tags)
+	Annotations     bool     `yaml:"annotations,omitempty"`      // Include annotations below each task
+	AnnotationStyle string   `yaml:"annotation_style,omitempty"` // "bullet" (default) or "quote"
+	AnnotationDates bool     `yaml:"annotation_dates,omitempty"` // Prefix annotations with their entry date
+	MaxLineLength   int      `yaml:"max_line_length,omitempty"`  // Wrap annotation lines longer than this (default: no wrapping)
+}
+
+// TaskTemplate is a named scaffold that pre-fills the new task input
+type TaskTemplate struct {
+	Name        string   `yaml:"name"`                  // Name shown in the template picker
+	Description string   `yaml:"description,omitempty"` // Description (or its beginning) of the new task
+	Project     string   `yaml:"project,omitempty"`
+	Tags        []string `yaml:"tags,omitempty"`
+	Priority    string   `yaml:"priority,omitempty"`
+	Due         string   `yaml:"due,omitempty"` // Taskwarrior date expression; a leading "+" is relative to today (e.g. "+3d")
+}
+
+// ProjectRule adds and removes tags when tasks are assigned to a project
+type ProjectRule struct {
+	Project    string   `yaml:"project"`               // Project the rule applies to, including its subprojects
+	AddTags    []string `yaml:"add_tags,omitempty"`    // Tags added to the tasks
+	RemoveTags []string `yaml:"remove_tags,omitempty"` // Tags removed from the tasks
 }
 
 // CustomCommand represents a user-defined command that can be executed with task data
 type CustomCommand struct {
-	Name        string `yaml:"name"`        // Display name for the command
-	Command     string `yaml:"command"`     // Command template with {{.field}} placeholders
-	Description string `yaml:"description"` // Optional description for help text
+	Name          string `yaml:"name"`           // Display name for the command
+	Command       string `yaml:"command"`        // Command template with {{.field}} placeholders
+	Description   string `yaml:"description"`    // Optional description for help text
+	Refresh       bool   `yaml:"refresh"`        // Reload the task list after the command succeeds
+	Interactive   bool   `yaml:"interactive"`    // Suspend the TUI while the command runs (e.g. editors, pagers)
+	CaptureOutput bool   `yaml:"capture_output"` // Show the command's output in the message history (stderr only for interactive commands)
 }
 
 // Tab represents a section/tab in the UI
 type Tab struct {
-	Name    string `yaml:"name"`
-	Filter  string `yaml:"filter"`
-	Sort    string `yaml:"sort,omitempty"`    // Sorting method: "alphabetic", "due", "scheduled", "created", "modified" (default: none)
-	Reverse bool   `yaml:"reverse,omitempty"` // Reverse sort order
+	Name     string `yaml:"name"`
+	Filter   string `yaml:"filter"`
+	Sort     string `yaml:"sort,omitempty"`      // Sorting method: "alphabetic", "due", "scheduled", "created", "modified" (default: none)
+	Reverse  bool   `yaml:"reverse,omitempty"`   // Reverse sort order
+	OnEmpty  string `yaml:"on_empty,omitempty"`  // When the filter matches no tasks: "message" (default), "hint" (suggest adding a task) or "new" (open the new task input)
+	Icon     string `yaml:"icon,omitempty"`      // Icon or emoji shown before the name in the sections bar
+	ViewMode string `yaml:"view_mode,omitempty"` // View mode set when switching to the tab: "list" or "sidebar" (default: keep the global view mode)
 }
 
 // Column represents a table column configuration
@@ -0,0 +1,21 @@
+package core
+
+import "time"
+
+// nowFunc is the clock used for "now" in relative dates and due date checks.
+// It defaults to time.Now; tests and debugging can replace it with SetNowFunc.
+var nowFunc = time.Now
+
+// Now returns the current time according to the configured clock
+func Now() time.Time {
+	return nowFunc()
+}
+
+// SetNowFunc replaces the clock returned by Now.
+// Passing nil restores the default time.Now.
+func SetNowFunc(f func() time.Time) {
+	if f == nil {
+		f = time.Now
+	}
+	nowFunc = f
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultCSVColumns are the columns written by TasksToCSV when none are given
+var DefaultCSVColumns = []string{"id", "description", "project", "priority", "due", "tags", "status", "urgency"}
+
+// TasksToCSV writes tasks as CSV: a header row with the column names, then one row
+// per task. Dates are ISO 8601 and tags are joined with ";". Missing values are
+// left empty, and fields containing commas or quotes are quoted.
+func TasksToCSV(tasks []Task, columns []string) ([]byte, error) {
+	if len(columns) == 0 {
+		columns = DefaultCSVColumns
+	}
+	for _, col := range columns {
+		if _, ok := csvValue(Task{}, col); !ok {
+			return nil, fmt.Errorf("unknown CSV column %q (available: id, uuid, description, project, priority, status, tags, due, scheduled, wait, start, entry, modified, end, urgency)", col)
+		}
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(columns); err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			row[i], _ = csvValue(task, col)
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// csvValue returns the raw value of a task column for CSV export, without the
+// placeholders GetProperty uses for display. Reports false for unknown columns.
+func csvValue(t Task, column string) (string, bool) {
+	switch column {
+	case "id":
+		if t.ID == 0 {
+			return "", true
+		}
+		return strconv.Itoa(t.ID), true
+	case "uuid":
+		return t.UUID, true
+	case "description":
+		return t.Description, true
+	case "project":
+		return t.Project, true
+	case "priority":
+		return t.Priority, true
+	case "status":
+		return t.Status, true
+	case "tags":
+		return strings.Join(t.Tags, ";"), true
+	case "due":
+		return csvDate(t.Due), true
+	case "scheduled":
+		return csvDate(t.Scheduled), true
+	case "wait":
+		return csvDate(t.Wait), true
+	case "start":
+		return csvDate(t.Start), true
+	case "entry":
+		if t.Entry.IsZero() {
+			return "", true
+		}
+		return csvDate(&t.Entry), true
+	case "modified":
+		return csvDate(t.Modified), true
+	case "end":
+		return csvDate(t.End), true
+	case "urgency":
+		return strconv.FormatFloat(t.Urgency, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+// csvDate formats a date as ISO 8601, or returns an empty string when it is not set
+func csvDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(time.RFC3339)
+}
@@ -0,0 +1,46 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTasksToCSV(t *testing.T) {
+	due := time.Date(2026, 3, 10, 17, 0, 0, 0, time.UTC)
+	tasks := []Task{
+		{ID: 1, Description: "Write report, draft", Project: "Work", Priority: "H", Due: &due, Tags: []string{"docs", "q1"}, Status: "pending", Urgency: 9.8},
+		{ID: 0, Description: `Say "hi"`, Status: "completed"},
+	}
+
+	got, err := TasksToCSV(tasks, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"id,description,project,priority,due,tags,status,urgency",
+		`1,"Write report, draft",Work,H,2026-03-10T17:00:00Z,docs;q1,pending,9.8`,
+		`,"Say ""hi""",,,,,completed,0`,
+		"",
+	}, "\n")
+	if string(got) != expected {
+		t.Errorf("Unexpected CSV:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestTasksToCSVColumns(t *testing.T) {
+	tasks := []Task{{UUID: "abc-123", Description: "Task"}}
+
+	got, err := TasksToCSV(tasks, []string{"uuid", "description"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "uuid,description\nabc-123,Task\n"; string(got) != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	if _, err := TasksToCSV(tasks, []string{"description", "color"}); err == nil {
+		t.Error("Expected an error for an unknown column")
+	}
+}
@@ -13,14 +13,20 @@ type Section struct {
 	Description string
 	Sort        string // Sorting method: "alphabetic", "due", "scheduled", "created", "modified" (default: none)
 	Reverse     bool   // Reverse sort order
+	OnEmpty     string // Behavior when the filter matches no tasks: "message" (default), "hint" or "new"
+	Icon        string // Icon or emoji shown before the name in the sections bar
+	ViewMode    string // View mode set when switching to the section: "list" or "sidebar" (default: unchanged)
 }
 
 // Tab represents a tab/section configuration
 type Tab struct {
-	Name    string
-	Filter  string
-	Sort    string // Sorting method: "alphabetic", "due", "scheduled", "created", "modified" (default: none)
-	Reverse bool   // Reverse sort order
+	Name     string
+	Filter   string
+	Sort     string // Sorting method: "alphabetic", "due", "scheduled", "created", "modified" (default: none)
+	Reverse  bool   // Reverse sort order
+	OnEmpty  string // Behavior when the filter matches no tasks: "message" (default), "hint" or "new"
+	Icon     string // Icon or emoji shown before the name in the sections bar
+	ViewMode string // View mode set when switching to the tab: "list" or "sidebar" (default: unchanged)
 }
 
 // TabsToSections converts Tab configs to Section objects
@@ -34,6 +40,9 @@ func TabsToSections(tabs []Tab) []Section {
 			Description: tab.Name + " tasks",
 			Sort:        tab.Sort,
 			Reverse:     tab.Reverse,
+			OnEmpty:     tab.OnEmpty,
+			Icon:        tab.Icon,
+			ViewMode:    tab.ViewMode,
 		})
 	}
 
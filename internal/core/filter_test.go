@@ -87,7 +87,7 @@ func TestTabStruct(t *testing.T) {
 func TestTabsToSections(t *testing.T) {
 	tabs := []Tab{
 		{Name: "Work", Filter: "+work"},
-		{Name: "Home", Filter: "+home"},
+		{Name: "Home", Filter: "+home", Icon: "🏠"},
 	}
 
 	sections := TabsToSections(tabs)
@@ -100,7 +100,7 @@ func TestTabsToSections(t *testing.T) {
 	if sections[0].Name != "Work" || sections[0].Filter != "+work" {
 		t.Error("Expected first section to match 'Work' tab")
 	}
-	if sections[1].Name != "Home" || sections[1].Filter != "+home" {
+	if sections[1].Name != "Home" || sections[1].Filter != "+home" || sections[1].Icon != "🏠" {
 		t.Error("Expected second section to match 'Home' tab")
 	}
 
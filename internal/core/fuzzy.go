@@ -0,0 +1,110 @@
+package core
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Scores of FuzzyMatch: each matched character scores 1, plus bonuses that favor
+// matches a user would expect first
+const (
+	fuzzyConsecutiveBonus = 5 // Character right after the previous match
+	fuzzyWordStartBonus   = 8 // Character at the start of a word
+	fuzzyFirstCharBonus   = 4 // Match at the very start of the target
+	fuzzyGapPenalty       = 1 // Per skipped character between two matches
+)
+
+// FuzzyMatch reports whether the characters of query appear in target in order
+// (case-insensitive), and scores the match: higher scores for characters that are
+// consecutive or start words. An empty query matches everything with score 0.
+func FuzzyMatch(query, target string) (score int, matched bool) {
+	q := []rune(strings.ToLower(query))
+	if len(q) == 0 {
+		return 0, true
+	}
+	t := []rune(strings.ToLower(target))
+
+	// Try each occurrence of the first character, so that "rent" in "Call plumber
+	// +rental" scores the word, not the "r" of "plumber"
+	for start, r := range t {
+		if r != q[0] {
+			continue
+		}
+		if s, ok := fuzzyScoreFrom(q, t, start); ok && (!matched || s > score) {
+			score, matched = s, true
+		}
+	}
+	return score, matched
+}
+
+// fuzzyScoreFrom matches query greedily in target from start, where the first
+// query character is
+func fuzzyScoreFrom(q, t []rune, start int) (int, bool) {
+	score := 0
+	qi := 0
+	last := -1
+	for ti := start; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] != q[qi] {
+			continue
+		}
+		score++
+		switch {
+		case ti == 0:
+			score += fuzzyFirstCharBonus + fuzzyWordStartBonus
+		case !unicode.IsLetter(t[ti-1]) && !unicode.IsDigit(t[ti-1]):
+			score += fuzzyWordStartBonus
+		}
+		if last >= 0 {
+			if ti == last+1 {
+				score += fuzzyConsecutiveBonus
+			} else {
+				score -= (ti - last - 1) * fuzzyGapPenalty
+			}
+		}
+		last = ti
+		qi++
+	}
+	return score, qi == len(q)
+}
+
+// FuzzyTaskText returns the text of a task searched by the fuzzy finder:
+// description, project and tags
+func FuzzyTaskText(task Task) string {
+	parts := []string{task.Description}
+	if task.Project != "" {
+		parts = append(parts, task.Project)
+	}
+	for _, tag := range task.Tags {
+		parts = append(parts, "+"+tag)
+	}
+	return strings.Join(parts, " ")
+}
+
+// FuzzyFilterTasks returns the tasks that match query, best matches first.
+// Tasks with the same score keep their order; an empty query returns all tasks.
+func FuzzyFilterTasks(tasks []Task, query string) []Task {
+	if query == "" {
+		return tasks
+	}
+
+	type scoredTask struct {
+		task  Task
+		score int
+	}
+	var matches []scoredTask
+	for _, task := range tasks {
+		if score, ok := FuzzyMatch(query, FuzzyTaskText(task)); ok {
+			matches = append(matches, scoredTask{task: task, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	result := make([]Task, len(matches))
+	for i, match := range matches {
+		result[i] = match.task
+	}
+	return result
+}
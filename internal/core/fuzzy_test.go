@@ -0,0 +1,91 @@
+package core
+
+import "testing"
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		query   string
+		target  string
+		matched bool
+	}{
+		{"rent", "Pay rent", true},
+		{"prt", "Pay rent", true},
+		{"PAY", "pay rent", true},
+		{"tner", "Pay rent", false},
+		{"rentx", "Pay rent", false},
+		{"è", "Café crème", true},
+		{"x", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query+"/"+tt.target, func(t *testing.T) {
+			if _, matched := FuzzyMatch(tt.query, tt.target); matched != tt.matched {
+				t.Errorf("FuzzyMatch(%q, %q) matched = %v, want %v", tt.query, tt.target, matched, tt.matched)
+			}
+		})
+	}
+}
+
+func TestFuzzyMatchEmptyQuery(t *testing.T) {
+	for _, target := range []string{"", "Pay rent"} {
+		score, matched := FuzzyMatch("", target)
+		if !matched || score != 0 {
+			t.Errorf("FuzzyMatch(\"\", %q) = (%d, %v), want (0, true)", target, score, matched)
+		}
+	}
+}
+
+func TestFuzzyMatchScoreOrdering(t *testing.T) {
+	// Each pair: the first target is the better match
+	tests := []struct {
+		query  string
+		better string
+		worse  string
+	}{
+		{"rent", "Pay rent", "Read the documentation about tents"}, // Consecutive beats scattered
+		{"bug", "Fix bug in parser", "Rebuild gui"},                // Word start beats mid-word
+		{"pay", "Pay rent", "Repay loan"},                          // Start of target beats later
+		{"cr", "Call Rob", "Clear the garage roof"},                // Word starts beat gaps
+	}
+
+	for _, tt := range tests {
+		better, ok := FuzzyMatch(tt.query, tt.better)
+		if !ok {
+			t.Fatalf("Expected %q to match %q", tt.query, tt.better)
+		}
+		worse, ok := FuzzyMatch(tt.query, tt.worse)
+		if !ok {
+			t.Fatalf("Expected %q to match %q", tt.query, tt.worse)
+		}
+		if better <= worse {
+			t.Errorf("Expected %q to score higher than %q for %q, got %d <= %d", tt.better, tt.worse, tt.query, better, worse)
+		}
+	}
+}
+
+func TestFuzzyFilterTasks(t *testing.T) {
+	tasks := []Task{
+		{UUID: "1", Description: "Read the documentation about tents"},
+		{UUID: "2", Description: "Pay rent", Project: "Garden"},
+		{UUID: "3", Description: "Call plumber", Tags: []string{"rental"}},
+		{UUID: "4", Description: "Buy milk"},
+	}
+
+	got := FuzzyFilterTasks(tasks, "rent")
+	var uuids []string
+	for _, task := range got {
+		uuids = append(uuids, task.UUID)
+	}
+	if len(uuids) != 3 || uuids[0] != "2" || uuids[1] != "3" || uuids[2] != "1" {
+		t.Errorf("Expected the best matches first and no unmatched tasks, got %v", uuids)
+	}
+
+	// Project and tags are searched too
+	if got := FuzzyFilterTasks(tasks, "garden"); len(got) != 1 || got[0].UUID != "2" {
+		t.Errorf("Expected a match on the project, got %v", got)
+	}
+
+	if got := FuzzyFilterTasks(tasks, ""); len(got) != len(tasks) || got[0].UUID != "1" {
+		t.Errorf("Expected an empty query to keep all tasks in order, got %v", got)
+	}
+}
@@ -11,6 +11,9 @@ type ProjectSummary struct {
 	Percentage int    // Completion percentage (0-100)
 }
 
+// NoGroupName is the name of the synthesized group holding tasks without a project or tag
+const NoGroupName = "(none)"
+
 // TaskGroup represents a group of tasks (by project or tag)
 type TaskGroup struct {
 	Name       string
@@ -36,23 +39,54 @@ func ExtractMainProject(project string) string {
 	return parts[0]
 }
 
-// GroupProjectsByHierarchy creates a hierarchical project list from summary data
-// Shows all projects at all nesting levels with proper indentation
-func GroupProjectsByHierarchy(summaries []ProjectSummary, tasks []Task) []TaskGroup {
-	if len(summaries) == 0 {
-		return []TaskGroup{}
+// Display modes for nested project names
+const (
+	ProjectDisplayFull        = "full"        // Work.company1.backend
+	ProjectDisplayLeaf        = "leaf"        // backend
+	ProjectDisplayAbbreviated = "abbreviated" // W.c.backend
+)
+
+// FormatProjectName formats a nested project name for display.
+// "leaf" keeps only the last segment, "abbreviated" shortens every ancestor
+// to its first character; any other mode returns the name unchanged.
+func FormatProjectName(project, mode string) string {
+	if project == "" || !strings.Contains(project, ".") {
+		return project
 	}
 
+	parts := strings.Split(project, ".")
+	switch mode {
+	case ProjectDisplayLeaf:
+		return parts[len(parts)-1]
+	case ProjectDisplayAbbreviated:
+		for i, part := range parts[:len(parts)-1] {
+			if r := []rune(part); len(r) > 0 {
+				parts[i] = string(r[0])
+			}
+		}
+		return strings.Join(parts, ".")
+	default:
+		return project
+	}
+}
+
+// GroupProjectsByHierarchy creates a hierarchical project list from summary data
+// Shows all projects at all nesting levels with proper indentation.
+// Tasks without a project are collected in a trailing "(none)" group.
+func GroupProjectsByHierarchy(summaries []ProjectSummary, tasks []Task) []TaskGroup {
 	// Build a map of project name to tasks for counting
 	taskMap := make(map[string][]Task)
+	var projectless []Task
 	for _, task := range tasks {
 		if task.Project != "" {
 			taskMap[task.Project] = append(taskMap[task.Project], task)
+		} else {
+			projectless = append(projectless, task)
 		}
 	}
 
 	// Create groups with hierarchy information
-	var groups []TaskGroup
+	groups := []TaskGroup{}
 
 	for _, summary := range summaries {
 		// Calculate depth based on number of dots
@@ -73,6 +107,16 @@ func GroupProjectsByHierarchy(summaries []ProjectSummary, tasks []Task) []TaskGr
 		})
 	}
 
+	// Task summary skips projectless tasks, so synthesize their group
+	if len(projectless) > 0 {
+		groups = append(groups, TaskGroup{
+			Name:       NoGroupName,
+			Count:      len(projectless),
+			Tasks:      projectless,
+			Percentage: -1,
+		})
+	}
+
 	// Sort hierarchically: maintain parent-child relationships
 	// This is a stable sort that keeps the order from task summary output
 	// which already has the correct hierarchical structure
@@ -92,7 +136,7 @@ func GroupByProject(tasks []Task) []TaskGroup {
 	for _, task := range tasks {
 		mainProject := ExtractMainProject(task.Project)
 		if mainProject == "" {
-			mainProject = "(none)"
+			mainProject = NoGroupName
 		}
 		projectMap[mainProject] = append(projectMap[mainProject], task)
 	}
@@ -110,10 +154,10 @@ func GroupByProject(tasks []Task) []TaskGroup {
 
 	// Sort by name, but put "(none)" at the end
 	sort.Slice(groups, func(i, j int) bool {
-		if groups[i].Name == "(none)" {
+		if groups[i].Name == NoGroupName {
 			return false
 		}
-		if groups[j].Name == "(none)" {
+		if groups[j].Name == NoGroupName {
 			return true
 		}
 		return groups[i].Name < groups[j].Name
@@ -135,7 +179,7 @@ func GroupByTag(tasks []Task) []TaskGroup {
 	for _, task := range tasks {
 		if len(task.Tags) == 0 {
 			// Task has no tags
-			tagMap["(none)"] = append(tagMap["(none)"], task)
+			tagMap[NoGroupName] = append(tagMap[NoGroupName], task)
 		} else {
 			// Add task to each tag group
 			for _, tag := range task.Tags {
@@ -157,10 +201,10 @@ func GroupByTag(tasks []Task) []TaskGroup {
 
 	// Sort by name, but put "(none)" at the end
 	sort.Slice(groups, func(i, j int) bool {
-		if groups[i].Name == "(none)" {
+		if groups[i].Name == NoGroupName {
 			return false
 		}
-		if groups[j].Name == "(none)" {
+		if groups[j].Name == NoGroupName {
 			return true
 		}
 		return groups[i].Name < groups[j].Name
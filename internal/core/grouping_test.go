@@ -31,6 +31,32 @@ func TestExtractMainProject(t *testing.T) {
 	}
 }
 
+func TestFormatProjectName(t *testing.T) {
+	tests := []struct {
+		project  string
+		mode     string
+		expected string
+	}{
+		{"Work.company1.backend", ProjectDisplayFull, "Work.company1.backend"},
+		{"Work.company1.backend", ProjectDisplayLeaf, "backend"},
+		{"Work.company1.backend", ProjectDisplayAbbreviated, "W.c.backend"},
+		{"Work.company1", "", "Work.company1"},
+		{"Work.company1", "unknown", "Work.company1"},
+		{"Work", ProjectDisplayLeaf, "Work"},
+		{"Work", ProjectDisplayAbbreviated, "Work"},
+		{"", ProjectDisplayLeaf, ""},
+		{"Élan.sub", ProjectDisplayAbbreviated, "É.sub"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.project+"/"+tt.mode, func(t *testing.T) {
+			if got := FormatProjectName(tt.project, tt.mode); got != tt.expected {
+				t.Errorf("FormatProjectName(%q, %q) = %q, want %q", tt.project, tt.mode, got, tt.expected)
+			}
+		})
+	}
+}
+
 // Test grouping tasks by project
 func TestGroupByProject(t *testing.T) {
 	tasks := []Task{
@@ -294,3 +320,33 @@ func TestGroupProjectsByHierarchy(t *testing.T) {
 		}
 	}
 }
+
+func TestGroupProjectsByHierarchyProjectless(t *testing.T) {
+	summaries := []ProjectSummary{{Name: "Home", Percentage: 40}}
+	tasks := []Task{
+		{UUID: "1", Project: "Home"},
+		{UUID: "2"},
+		{UUID: "3"},
+	}
+
+	groups := GroupProjectsByHierarchy(summaries, tasks)
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(groups))
+	}
+
+	none := groups[1]
+	if none.Name != NoGroupName {
+		t.Fatalf("Expected (none) group last, got %q", none.Name)
+	}
+	if none.Count != 2 || len(none.Tasks) != 2 {
+		t.Errorf("Expected 2 projectless tasks, got count=%d tasks=%d", none.Count, len(none.Tasks))
+	}
+	if none.Percentage != -1 {
+		t.Errorf("Expected no percentage for (none), got %d", none.Percentage)
+	}
+
+	// Without projectless tasks no (none) group is added
+	if groups := GroupProjectsByHierarchy(summaries, tasks[:1]); len(groups) != 1 {
+		t.Errorf("Expected 1 group without projectless tasks, got %d", len(groups))
+	}
+}
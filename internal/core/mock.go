@@ -4,22 +4,29 @@ import "errors"
 
 // MockTaskService is a mock implementation of TaskService for testing
 type MockTaskService struct {
-	ExportFunc           func(filter string) ([]Task, error)
-	ModifyFunc           func(uuid, modifications string) error
-	AnnotateFunc         func(uuid, text string) error
-	DoneFunc             func(uuid string) error
-	DeleteFunc           func(uuid string) error
-	AddFunc              func(description string) (string, error)
-	UndoFunc             func() error
-	EditFunc             func(uuid string) error
-	StartFunc            func(uuid string) error
-	StopFunc             func(uuid string) error
+	ExportFunc            func(filter string) ([]Task, error)
+	ModifyFunc            func(uuid, modifications string) error
+	ModifyRecurringFunc   func(uuid, modifications string, all bool) error
+	AnnotateFunc          func(uuid, text string) error
+	DoneFunc              func(uuid string) error
+	DeleteFunc            func(uuid string) error
+	AddFunc               func(description string) (string, error)
+	DuplicateFunc         func(uuid string) (string, error)
+	UndoFunc              func() error
+	UndoPreviewFunc       func() (string, error)
+	EditFunc              func(uuid string) error
+	StartFunc             func(uuid string) error
+	StopFunc              func(uuid string) error
 	GetProjectSummaryFunc func() ([]ProjectSummary, error)
 	GetTagsFunc           func() ([]string, error)
 	GetUdasFunc           func() ([]string, error)
 	GetVersionFunc        func() (string, error)
 	DenotateFunc          func(uuid, description string) error
 	SyncFunc              func() error
+	TaskSyncFunc          func() error
+	ListContextsFunc      func() ([]string, error)
+	GetContextFunc        func() (string, error)
+	SetContextFunc        func(name string) error
 }
 
 func (m *MockTaskService) Export(filter string) ([]Task, error) {
@@ -36,6 +43,13 @@ func (m *MockTaskService) Modify(uuid, modifications string) error {
 	return errors.New("not implemented")
 }
 
+func (m *MockTaskService) ModifyRecurring(uuid, modifications string, all bool) error {
+	if m.ModifyRecurringFunc != nil {
+		return m.ModifyRecurringFunc(uuid, modifications, all)
+	}
+	return errors.New("not implemented")
+}
+
 func (m *MockTaskService) Annotate(uuid, text string) error {
 	if m.AnnotateFunc != nil {
 		return m.AnnotateFunc(uuid, text)
@@ -64,6 +78,13 @@ func (m *MockTaskService) Add(description string) (string, error) {
 	return "", errors.New("not implemented")
 }
 
+func (m *MockTaskService) Duplicate(uuid string) (string, error) {
+	if m.DuplicateFunc != nil {
+		return m.DuplicateFunc(uuid)
+	}
+	return "", errors.New("not implemented")
+}
+
 func (m *MockTaskService) Undo() error {
 	if m.UndoFunc != nil {
 		return m.UndoFunc()
@@ -71,6 +92,13 @@ func (m *MockTaskService) Undo() error {
 	return errors.New("not implemented")
 }
 
+func (m *MockTaskService) UndoPreview() (string, error) {
+	if m.UndoPreviewFunc != nil {
+		return m.UndoPreviewFunc()
+	}
+	return "", errors.New("not implemented")
+}
+
 func (m *MockTaskService) Edit(uuid string) error {
 	if m.EditFunc != nil {
 		return m.EditFunc(uuid)
@@ -133,3 +161,31 @@ func (m *MockTaskService) Sync() error {
 	}
 	return nil
 }
+
+func (m *MockTaskService) TaskSync() error {
+	if m.TaskSyncFunc != nil {
+		return m.TaskSyncFunc()
+	}
+	return nil
+}
+
+func (m *MockTaskService) ListContexts() ([]string, error) {
+	if m.ListContextsFunc != nil {
+		return m.ListContextsFunc()
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTaskService) GetContext() (string, error) {
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc()
+	}
+	return "", errors.New("not implemented")
+}
+
+func (m *MockTaskService) SetContext(name string) error {
+	if m.SetContextFunc != nil {
+		return m.SetContextFunc(name)
+	}
+	return errors.New("not implemented")
+}
@@ -0,0 +1,22 @@
+package core
+
+// priorityCycle lists the Taskwarrior priorities from the lowest, no priority first
+var priorityCycle = []string{"", "L", "M", "H"}
+
+// NextPriority returns the priority after current when cycling up (none, L, M, H)
+// or down, wrapping around at either end. Unknown priorities count as none.
+func NextPriority(current string, up bool) string {
+	index := 0
+	for i, priority := range priorityCycle {
+		if priority == current {
+			index = i
+			break
+		}
+	}
+
+	step := 1
+	if !up {
+		step = len(priorityCycle) - 1
+	}
+	return priorityCycle[(index+step)%len(priorityCycle)]
+}
@@ -0,0 +1,27 @@
+package core
+
+import "testing"
+
+func TestNextPriority(t *testing.T) {
+	tests := []struct {
+		current  string
+		up       bool
+		expected string
+	}{
+		{"", true, "L"},
+		{"L", true, "M"},
+		{"M", true, "H"},
+		{"H", true, ""},
+		{"", false, "H"},
+		{"H", false, "M"},
+		{"M", false, "L"},
+		{"L", false, ""},
+		{"X", true, "L"},
+	}
+
+	for _, tt := range tests {
+		if got := NextPriority(tt.current, tt.up); got != tt.expected {
+			t.Errorf("NextPriority(%q, %v) = %q, expected %q", tt.current, tt.up, got, tt.expected)
+		}
+	}
+}
@@ -14,6 +14,12 @@ type TaskService interface {
 	// Returns an error if the task is not found or the modification fails
 	Modify(uuid, modifications string) error
 
+	// ModifyRecurring updates an instance of a recurring task. With all set, the
+	// modifications also apply to the other pending instances and to the template,
+	// otherwise to this instance only.
+	// Returns an error if the task is not found or the modification fails
+	ModifyRecurring(uuid, modifications string, all bool) error
+
 	// Annotate adds an annotation (note) to a task
 	// Returns an error if the task is not found or the annotation fails
 	Annotate(uuid, text string) error
@@ -31,10 +37,18 @@ type TaskService interface {
 	// Returns the UUID of the newly created task or an error if creation fails
 	Add(description string) (string, error)
 
+	// Duplicate creates a copy of a task, pending and not started
+	// Returns the UUID of the new task or an error if the task is not found or the copy fails
+	Duplicate(uuid string) (string, error)
+
 	// Undo reverts the last task operation
 	// Returns an error if there is nothing to undo or the operation fails
 	Undo() error
 
+	// UndoPreview describes what Undo would revert (e.g. `modify "Pay rent": priority H→M`)
+	// Returns an error if there is nothing to undo or the change cannot be described
+	UndoPreview() (string, error)
+
 	// Edit opens the task in an external editor for manual editing
 	// This typically suspends the TUI and launches the configured editor
 	// Returns an error if the task is not found or the edit fails
@@ -67,4 +81,18 @@ type TaskService interface {
 	// Sync synchronises the local task database with the configured taskserver.
 	// It is a no-op (returns nil) when no taskserver is configured.
 	Sync() error
+
+	// TaskSync runs "task sync" to synchronise with the Taskwarrior sync server
+	TaskSync() error
+
+	// ListContexts returns the names of the defined Taskwarrior contexts, which
+	// apply an implicit filter to every command
+	ListContexts() ([]string, error)
+
+	// GetContext returns the name of the active context, or "" when none is active
+	GetContext() (string, error)
+
+	// SetContext activates the named context, or clears the active one when name is ""
+	// Returns an error if the context is not defined
+	SetContext(name string) error
 }
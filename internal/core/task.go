@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // Task represents a task in the domain model (UI-agnostic)
@@ -29,6 +30,10 @@ type Task struct {
 	// Dependencies
 	Depends []string // UUIDs of tasks this depends on
 
+	// Recurrence (also kept in UDAs, where Taskwarrior exports them)
+	Recur  string // Recurrence period (e.g. "weekly"), set on templates and their instances
+	Parent string // UUID of the recurrence template, set on instances only
+
 	// Annotations
 	Annotations []Annotation
 
@@ -45,6 +50,12 @@ type Annotation struct {
 	Description string
 }
 
+// IsRecurringInstance reports whether the task was generated by a recurring task.
+// Taskwarrior asks whether to change the other instances when it is modified.
+func (t *Task) IsRecurringInstance() bool {
+	return t.Parent != ""
+}
+
 // GetUDA returns the value of a User Defined Attribute by key
 // Returns empty string if the key doesn't exist or UDAs is nil
 func (t *Task) GetUDA(key string) string {
@@ -191,7 +202,7 @@ func (t *Task) GetDateValue(property string) *time.Time {
 // Past dates include "ago" suffix (e.g., "2 weeks ago").
 // Future dates use "+" prefix (e.g., "+3 days").
 func FormatRelativeDate(date *time.Time) string {
-	return formatRelativeDateFrom(date, time.Now())
+	return formatRelativeDateFrom(date, Now())
 }
 
 // formatRelativeDateFrom returns a relative date string computed against a given reference time.
@@ -311,7 +322,7 @@ func (t *Task) IsOverdue() bool {
 	if t.Status == "completed" || t.Status == "deleted" {
 		return false
 	}
-	return t.Due.Before(time.Now())
+	return t.Due.Before(Now())
 }
 
 // IsDueToday returns true if the task is due today
@@ -322,7 +333,7 @@ func (t *Task) IsDueToday() bool {
 	if t.Status == "completed" || t.Status == "deleted" {
 		return false
 	}
-	now := time.Now()
+	now := Now()
 	year, month, day := now.Date()
 	today := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
 	tomorrow := today.AddDate(0, 0, 1)
@@ -338,16 +349,48 @@ func (t *Task) IsDueSoon() bool {
 	if t.Status == "completed" || t.Status == "deleted" {
 		return false
 	}
-	now := time.Now()
+	now := Now()
 	sevenDaysFromNow := now.AddDate(0, 0, 7)
 
 	return t.Due.After(now) && t.Due.Before(sevenDaysFromNow)
 }
 
+// IsDeferred returns true if the task has a wait or scheduled date in the future,
+// meaning it is not actionable yet
+func (t *Task) IsDeferred() bool {
+	now := Now()
+	if t.Wait != nil && t.Wait.After(now) {
+		return true
+	}
+	return t.Scheduled != nil && t.Scheduled.After(now)
+}
+
+// Annotation styles for MarkdownOptions.AnnotationStyle
+const (
+	MarkdownBullet = "bullet" // Nested list items (default)
+	MarkdownQuote  = "quote"  // Block quote below the item
+)
+
+// MarkdownOptions controls what ToMarkdownWithOptions includes and how annotations
+// are laid out. The zero value produces the same item as ToMarkdown.
+type MarkdownOptions struct {
+	Fields          []string // Task properties appended after the description (e.g. "project", "due", "tags")
+	Annotations     bool     // Include annotations below the item
+	AnnotationStyle string   // MarkdownBullet or MarkdownQuote
+	AnnotationDates bool     // Prefix annotations with their entry date
+	MaxLineLength   int      // Wrap annotation lines longer than this; 0 disables wrapping
+}
+
 // ToMarkdown formats the task as a markdown checklist item
 // Format: * [ ] Description (short-uuid)
 // Status markers: [ ] pending, [x] completed, [S] started, [d] deleted
 func (t *Task) ToMarkdown() string {
+	return t.ToMarkdownWithOptions(MarkdownOptions{})
+}
+
+// ToMarkdownWithOptions formats the task as a markdown checklist item, followed
+// by the selected fields and, on the next lines, its annotations
+func (t *Task) ToMarkdownWithOptions(opts MarkdownOptions) string {
 	// Determine status marker
 	var statusMarker string
 	switch t.Status {
@@ -370,5 +413,142 @@ func (t *Task) ToMarkdown() string {
 		shortUUID = shortUUID[:8]
 	}
 
-	return fmt.Sprintf("* [%s] %s (%s)", statusMarker, t.Description, shortUUID)
+	item := fmt.Sprintf("* [%s] %s (%s)", statusMarker, t.Description, shortUUID)
+	if fields := t.markdownFields(opts.Fields); fields != "" {
+		item += " " + fields
+	}
+	if !opts.Annotations {
+		return item
+	}
+
+	lines := []string{item}
+	for _, annotation := range t.Annotations {
+		text := annotation.Description
+		if opts.AnnotationDates {
+			text = t.formatDate(&annotation.Entry) + ": " + text
+		}
+		lines = append(lines, markdownAnnotation(text, opts.AnnotationStyle, opts.MaxLineLength)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ToChecklist formats the task as a GitHub-flavored markdown checklist, with the
+// tasks it depends on nested below it as subtasks. Dependencies are looked up in
+// allTasks; completed ones are checked, deleted and unknown ones are left out.
+func (t *Task) ToChecklist(allTasks []Task) string {
+	byUUID := make(map[string]*Task, len(allTasks))
+	for i := range allTasks {
+		byUUID[allTasks[i].UUID] = &allTasks[i]
+	}
+
+	var lines []string
+	t.appendChecklist(&lines, byUUID, 0, map[string]bool{})
+	return strings.Join(lines, "\n")
+}
+
+// appendChecklist adds the checklist item of the task and of its dependencies to
+// lines, skipping tasks already on the current branch to stop dependency cycles
+func (t *Task) appendChecklist(lines *[]string, byUUID map[string]*Task, depth int, visiting map[string]bool) {
+	marker := " "
+	if t.Status == "completed" {
+		marker = "x"
+	}
+	*lines = append(*lines, fmt.Sprintf("%s- [%s] %s", strings.Repeat("  ", depth), marker, t.Description))
+
+	visiting[t.UUID] = true
+	defer delete(visiting, t.UUID)
+	for _, uuid := range t.Depends {
+		if dep, ok := byUUID[uuid]; ok && dep.Status != "deleted" && !visiting[uuid] {
+			dep.appendChecklist(lines, byUUID, depth+1, visiting)
+		}
+	}
+}
+
+// DependencyChain returns the task followed by every task it depends on, directly
+// or through other dependencies, in breadth-first order. Dependencies are looked
+// up in allTasks; unknown ones are left out and each task appears once, so
+// dependency cycles end.
+func (t *Task) DependencyChain(allTasks []Task) []Task {
+	byUUID := make(map[string]*Task, len(allTasks))
+	for i := range allTasks {
+		byUUID[allTasks[i].UUID] = &allTasks[i]
+	}
+
+	chain := []Task{*t}
+	seen := map[string]bool{t.UUID: true}
+	for i := 0; i < len(chain); i++ {
+		for _, uuid := range chain[i].Depends {
+			if dep, ok := byUUID[uuid]; ok && !seen[uuid] {
+				seen[uuid] = true
+				chain = append(chain, *dep)
+			}
+		}
+	}
+	return chain
+}
+
+// CountByStatus returns the number of tasks of each status
+func CountByStatus(tasks []Task) map[string]int {
+	counts := make(map[string]int)
+	for _, task := range tasks {
+		counts[task.Status]++
+	}
+	return counts
+}
+
+// markdownFields formats the given task properties as Taskwarrior attributes,
+// skipping the ones that are not set
+func (t *Task) markdownFields(fields []string) string {
+	var parts []string
+	for _, field := range fields {
+		if field == "tags" {
+			for _, tag := range t.Tags {
+				parts = append(parts, "+"+tag)
+			}
+			continue
+		}
+		if value, ok := t.GetProperty(field); ok && value != "" && value != "-" {
+			parts = append(parts, field+":"+value)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// markdownAnnotation formats an annotation as lines nested below a checklist item
+func markdownAnnotation(text, style string, maxLength int) []string {
+	first, next := "  * ", "    "
+	if style == MarkdownQuote {
+		first, next = "  > ", "  > "
+	}
+
+	var lines []string
+	for i, line := range wrapWords(text, maxLength-len(first)) {
+		if i == 0 {
+			lines = append(lines, first+line)
+		} else {
+			lines = append(lines, next+line)
+		}
+	}
+	return lines
+}
+
+// wrapWords splits text into lines of at most width characters, breaking at
+// spaces. Words longer than width are kept whole. A width below 1 disables wrapping.
+func wrapWords(text string, width int) []string {
+	words := strings.Fields(text)
+	if width < 1 || len(words) == 0 {
+		return []string{text}
+	}
+
+	var lines []string
+	line := words[0]
+	for _, word := range words[1:] {
+		if utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, line)
+			line = word
+			continue
+		}
+		line += " " + word
+	}
+	return append(lines, line)
 }
@@ -1,6 +1,9 @@
 package core
 
 import (
+	"maps"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -275,6 +278,83 @@ func TestIsDueSoon(t *testing.T) {
 	}
 }
 
+func TestDueChecksUseFixedClock(t *testing.T) {
+	// Late evening in a non-UTC zone, where "today" differs from UTC
+	zone := time.FixedZone("UTC-5", -5*60*60)
+	fixed := time.Date(2024, 3, 15, 22, 30, 0, 0, zone)
+	SetNowFunc(func() time.Time { return fixed })
+	defer SetNowFunc(nil)
+
+	if got := Now(); !got.Equal(fixed) {
+		t.Fatalf("Now() = %v, expected %v", got, fixed)
+	}
+
+	earlier := fixed.Add(-time.Hour)
+	later := fixed.Add(2 * time.Hour) // Already tomorrow in UTC-5
+	inSixDays := fixed.AddDate(0, 0, 6)
+
+	if !(&Task{Due: &earlier, Status: "pending"}).IsOverdue() {
+		t.Error("Expected task due an hour before the fixed clock to be overdue")
+	}
+	if (&Task{Due: &later, Status: "pending"}).IsOverdue() {
+		t.Error("Expected task due after the fixed clock not to be overdue")
+	}
+	if !(&Task{Due: &earlier, Status: "pending"}).IsDueToday() {
+		t.Error("Expected task due earlier on the fixed day to be due today")
+	}
+	if (&Task{Due: &later, Status: "pending"}).IsDueToday() {
+		t.Error("Expected task due after midnight not to be due today")
+	}
+	if !(&Task{Due: &inSixDays, Status: "pending"}).IsDueSoon() {
+		t.Error("Expected task due in six days to be due soon")
+	}
+
+	due := fixed.AddDate(0, 0, -14)
+	if got := FormatRelativeDate(&due); got != formatRelativeDateFrom(&due, fixed) {
+		t.Errorf("FormatRelativeDate() = %q, expected it to use the fixed clock", got)
+	}
+}
+
+func TestSetNowFuncNilRestoresTimeNow(t *testing.T) {
+	SetNowFunc(func() time.Time { return time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC) })
+	SetNowFunc(nil)
+
+	if time.Since(Now()) > time.Minute {
+		t.Errorf("Expected Now() to follow time.Now after reset, got %v", Now())
+	}
+}
+
+func TestIsDeferred(t *testing.T) {
+	fixed := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	SetNowFunc(func() time.Time { return fixed })
+	defer SetNowFunc(nil)
+
+	future := fixed.Add(time.Hour)
+	past := fixed.Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		wait      *time.Time
+		scheduled *time.Time
+		expected  bool
+	}{
+		{"no dates", nil, nil, false},
+		{"future wait", &future, nil, true},
+		{"future scheduled", nil, &future, true},
+		{"past scheduled", nil, &past, false},
+		{"past wait, future scheduled", &past, &future, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := Task{Wait: tt.wait, Scheduled: tt.scheduled, Status: "pending"}
+			if got := task.IsDeferred(); got != tt.expected {
+				t.Errorf("IsDeferred() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestToMarkdown(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -330,6 +410,173 @@ func TestToMarkdown(t *testing.T) {
 	}
 }
 
+func TestToMarkdownWithOptions(t *testing.T) {
+	entry := time.Date(2026, 3, 4, 0, 0, 0, 0, time.Local)
+	due := time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local)
+	task := Task{
+		Description: "Write report",
+		UUID:        "12345678-abcd",
+		Status:      "pending",
+		Project:     "Work",
+		Tags:        []string{"docs", "q1"},
+		Due:         &due,
+		Annotations: []Annotation{
+			{Entry: entry, Description: "first draft sent to the whole team for review"},
+			{Entry: entry, Description: "ok"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		opts     MarkdownOptions
+		expected string
+	}{
+		{
+			name:     "zero value matches ToMarkdown",
+			opts:     MarkdownOptions{},
+			expected: "* [ ] Write report (12345678)",
+		},
+		{
+			name:     "fields",
+			opts:     MarkdownOptions{Fields: []string{"project", "priority", "tags", "due"}},
+			expected: "* [ ] Write report (12345678) project:Work +docs +q1 due:2026-03-10",
+		},
+		{
+			name:     "bullet annotations",
+			opts:     MarkdownOptions{Annotations: true},
+			expected: "* [ ] Write report (12345678)\n  * first draft sent to the whole team for review\n  * ok",
+		},
+		{
+			name:     "quoted annotations with dates",
+			opts:     MarkdownOptions{Annotations: true, AnnotationStyle: MarkdownQuote, AnnotationDates: true},
+			expected: "* [ ] Write report (12345678)\n  > 2026-03-04: first draft sent to the whole team for review\n  > 2026-03-04: ok",
+		},
+		{
+			name:     "wrapped bullet annotations",
+			opts:     MarkdownOptions{Annotations: true, MaxLineLength: 24},
+			expected: "* [ ] Write report (12345678)\n  * first draft sent to\n    the whole team for\n    review\n  * ok",
+		},
+		{
+			name:     "wrapped quoted annotations",
+			opts:     MarkdownOptions{Annotations: true, AnnotationStyle: MarkdownQuote, MaxLineLength: 30},
+			expected: "* [ ] Write report (12345678)\n  > first draft sent to the\n  > whole team for review\n  > ok",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := task.ToMarkdownWithOptions(tt.opts); result != tt.expected {
+				t.Errorf("ToMarkdownWithOptions() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToChecklist(t *testing.T) {
+	allTasks := []Task{
+		{UUID: "release", Description: "Ship release", Status: "pending", Depends: []string{"tests", "notes", "gone", "dropped"}},
+		{UUID: "tests", Description: "Fix tests", Status: "completed", Depends: []string{"ci"}},
+		{UUID: "ci", Description: "Set up CI", Status: "completed"},
+		{UUID: "notes", Description: "Write notes", Status: "pending", Depends: []string{"release"}},
+		{UUID: "dropped", Description: "Old idea", Status: "deleted"},
+	}
+
+	tests := []struct {
+		name     string
+		task     Task
+		expected string
+	}{
+		{
+			name:     "without dependencies",
+			task:     allTasks[2],
+			expected: "- [x] Set up CI",
+		},
+		{
+			name:     "nested dependencies",
+			task:     allTasks[0],
+			expected: "- [ ] Ship release\n  - [x] Fix tests\n    - [x] Set up CI\n  - [ ] Write notes",
+		},
+		{
+			name:     "pending task",
+			task:     Task{UUID: "solo", Description: "Solo task", Status: "pending"},
+			expected: "- [ ] Solo task",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.task.ToChecklist(allTasks); result != tt.expected {
+				t.Errorf("ToChecklist() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDependencyChain(t *testing.T) {
+	allTasks := []Task{
+		{UUID: "release", Depends: []string{"tests", "notes", "gone"}},
+		{UUID: "tests", Depends: []string{"ci", "fixtures"}},
+		{UUID: "notes", Depends: []string{"release", "ci"}},
+		{UUID: "ci"},
+		{UUID: "fixtures"},
+		{UUID: "unrelated", Depends: []string{"release"}},
+	}
+
+	tests := []struct {
+		name     string
+		task     Task
+		expected []string
+	}{
+		{
+			name:     "without dependencies",
+			task:     allTasks[3],
+			expected: []string{"ci"},
+		},
+		{
+			name:     "transitive dependencies with a cycle and an unknown task",
+			task:     allTasks[0],
+			expected: []string{"release", "tests", "notes", "ci", "fixtures"},
+		},
+		{
+			name:     "starting inside the graph",
+			task:     allTasks[1],
+			expected: []string{"tests", "ci", "fixtures"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := tt.task.DependencyChain(allTasks)
+			uuids := make([]string, len(chain))
+			for i, task := range chain {
+				uuids[i] = task.UUID
+			}
+			if !slices.Equal(uuids, tt.expected) {
+				t.Errorf("DependencyChain() = %v, expected %v", uuids, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWrapWords(t *testing.T) {
+	tests := []struct {
+		text     string
+		width    int
+		expected []string
+	}{
+		{"short", 10, []string{"short"}},
+		{"one two three", 7, []string{"one two", "three"}},
+		{"averyveryverylongword fits", 5, []string{"averyveryverylongword", "fits"}},
+		{"no wrapping at all", 0, []string{"no wrapping at all"}},
+	}
+
+	for _, tt := range tests {
+		if got := wrapWords(tt.text, tt.width); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("wrapWords(%q, %d) = %q, expected %q", tt.text, tt.width, got, tt.expected)
+		}
+	}
+}
+
 func TestFormatRelativeDateFrom(t *testing.T) {
 	now := time.Date(2026, 2, 17, 12, 0, 0, 0, time.UTC)
 
@@ -457,3 +704,24 @@ func TestGetDateValue(t *testing.T) {
 func timePtr(t time.Time) *time.Time {
 	return &t
 }
+
+func TestCountByStatus(t *testing.T) {
+	tasks := []Task{
+		{UUID: "1", Status: "pending"},
+		{UUID: "2", Status: "completed"},
+		{UUID: "3", Status: "pending"},
+		{UUID: "4", Status: "waiting"},
+		{UUID: "5", Status: "deleted"},
+		{UUID: "6", Status: "pending"},
+	}
+
+	counts := CountByStatus(tasks)
+	expected := map[string]int{"pending": 3, "completed": 1, "waiting": 1, "deleted": 1}
+	if !maps.Equal(counts, expected) {
+		t.Errorf("Expected %v, got %v", expected, counts)
+	}
+
+	if counts := CountByStatus(nil); len(counts) != 0 {
+		t.Errorf("Expected no counts for an empty list, got %v", counts)
+	}
+}
@@ -189,11 +189,26 @@ func (c *APIClient) Add(description string) (string, error) {
 	return resp.UUID, err
 }
 
+// Duplicate implements core.TaskService.
+func (c *APIClient) Duplicate(uuid string) (string, error) {
+	var resp struct {
+		UUID string `json:"uuid"`
+	}
+	err := c.do(http.MethodPost, "/tasks/"+uuid+"/duplicate", nil, &resp)
+	return resp.UUID, err
+}
+
 // Modify implements core.TaskService.
 func (c *APIClient) Modify(uuid, modifications string) error {
 	return c.do(http.MethodPut, "/tasks/"+uuid, map[string]string{"modifications": modifications}, nil)
 }
 
+// ModifyRecurring implements core.TaskService.
+func (c *APIClient) ModifyRecurring(uuid, modifications string, all bool) error {
+	body := map[string]any{"modifications": modifications, "all_recurrences": all}
+	return c.do(http.MethodPut, "/tasks/"+uuid, body, nil)
+}
+
 // Done implements core.TaskService.
 func (c *APIClient) Done(uuid string) error {
 	return c.do(http.MethodPost, "/tasks/"+uuid+"/done", nil, nil)
@@ -229,6 +244,11 @@ func (c *APIClient) Undo() error {
 	return c.do(http.MethodPost, "/undo", nil, nil)
 }
 
+// UndoPreview is not supported via the HTTP API (TUI-only operation).
+func (c *APIClient) UndoPreview() (string, error) {
+	return "", fmt.Errorf("UndoPreview is not supported in the web GUI")
+}
+
 // Edit is not supported via the HTTP API (TUI-only operation).
 func (c *APIClient) Edit(_ string) error {
 	return fmt.Errorf("Edit is not supported in the web GUI")
@@ -239,6 +259,26 @@ func (c *APIClient) Sync() error {
 	return c.do(http.MethodPost, "/sync", nil, nil)
 }
 
+// TaskSync is not supported via the HTTP API (TUI-only operation).
+func (c *APIClient) TaskSync() error {
+	return fmt.Errorf("TaskSync is not supported in the web GUI")
+}
+
+// ListContexts is not supported via the HTTP API (TUI-only operation).
+func (c *APIClient) ListContexts() ([]string, error) {
+	return nil, fmt.Errorf("ListContexts is not supported in the web GUI")
+}
+
+// GetContext is not supported via the HTTP API (TUI-only operation).
+func (c *APIClient) GetContext() (string, error) {
+	return "", fmt.Errorf("GetContext is not supported in the web GUI")
+}
+
+// SetContext is not supported via the HTTP API (TUI-only operation).
+func (c *APIClient) SetContext(_ string) error {
+	return fmt.Errorf("SetContext is not supported in the web GUI")
+}
+
 // GetProjectSummary implements core.TaskService.
 func (c *APIClient) GetProjectSummary() ([]core.ProjectSummary, error) {
 	var dtos []struct {
@@ -75,6 +75,25 @@ func TestAPIClient_Add(t *testing.T) {
 	}
 }
 
+func TestAPIClient_Duplicate(t *testing.T) {
+	_, client := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/tasks/some-uuid/duplicate" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"uuid":"copy-uuid"}`))
+	}))
+
+	uuid, err := client.Duplicate("some-uuid")
+	if err != nil {
+		t.Fatalf("Duplicate: %v", err)
+	}
+	if uuid != "copy-uuid" {
+		t.Errorf("expected copy-uuid, got %s", uuid)
+	}
+}
+
 func TestAPIClient_Done(t *testing.T) {
 	_, client := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v1/tasks/some-uuid/done" {
@@ -3,13 +3,15 @@ package gui
 import (
 	"fmt"
 	"time"
+
+	"github.com/clobrano/wui/internal/core"
 )
 
 // ShortRelDate formats a date as a compact relative string for task list rows.
 // Examples: "today", "tmrw", "yest", "3d", "2w", "1m", "1y",
 // and past equivalents "3d ago", "2w ago", "1m ago", "1y ago".
 func ShortRelDate(t *time.Time) string {
-	return shortRelDateFrom(t, time.Now())
+	return shortRelDateFrom(t, core.Now())
 }
 
 // ShortRelDateFrom is the testable variant of ShortRelDate with an explicit reference time.
@@ -74,7 +76,7 @@ func compactPast(days int) string {
 // Examples: "today", "tomorrow", "yesterday", "in 3 days", "2 weeks ago".
 // Returns the relative part; callers combine it with the absolute date.
 func LongRelDate(t *time.Time) string {
-	return longRelDateFrom(t, time.Now())
+	return longRelDateFrom(t, core.Now())
 }
 
 // LongRelDateFrom is the testable variant of LongRelDate with an explicit reference time.
@@ -160,7 +162,7 @@ func FormatAbsDate(t *time.Time) string {
 // Classes: "due-overdue", "due-today", "due-soon" (< 7 days), or "".
 // Comparison is done at day granularity (midnight local time).
 func DueCSSClass(t *time.Time) string {
-	return dueCSSClassFrom(t, time.Now())
+	return dueCSSClassFrom(t, core.Now())
 }
 
 // DueCSSClassFrom is the testable variant with an explicit reference time.
@@ -8,16 +8,21 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/clobrano/wui/internal/core"
 )
 
 // Client implements core.TaskService for Taskwarrior
 type Client struct {
-	taskBin       string
-	taskrcPath    string
-	wuiConfigPath string // passed to "wui sync" subprocess; empty → default config
+	taskBin        string
+	taskrcPath     string
+	wuiConfigPath  string        // passed to "wui sync" subprocess; empty → default config
+	strictJSON     bool          // fail the whole export when one task is malformed
+	nonInteractive bool          // answer Taskwarrior prompts with rc overrides (see nonInteractiveOverrides)
+	commandLog     chan<- string // receives the command line of every task command run; nil when not shown
 }
 
 // NewClient creates a new Taskwarrior client
@@ -29,8 +34,9 @@ func NewClient(taskBin, taskrcPath string) (*Client, error) {
 	}
 
 	return &Client{
-		taskBin:    taskBin,
-		taskrcPath: taskrcPath,
+		taskBin:        taskBin,
+		taskrcPath:     taskrcPath,
+		nonInteractive: true,
 	}, nil
 }
 
@@ -38,11 +44,35 @@ func NewClient(taskBin, taskrcPath string) (*Client, error) {
 // the "wui sync" subprocess.
 func (c *Client) SetWuiConfigPath(p string) { c.wuiConfigPath = p }
 
+// SetStrictJSON makes Export fail when a task of the export cannot be decoded.
+// By default malformed tasks are logged and skipped.
+func (c *Client) SetStrictJSON(strict bool) { c.strictJSON = strict }
+
+// SetCommandLog sends the command line of every task command the client runs to
+// commands, to show them to the user. Command lines are dropped while commands is full.
+func (c *Client) SetCommandLog(commands chan<- string) { c.commandLog = commands }
+
 // Export retrieves tasks matching the given filter
 func (c *Client) Export(filter string) ([]core.Task, error) {
+	tasks := make([]core.Task, 0)
+	if _, err := c.ExportStream(filter, 0, func(t core.Task) bool {
+		tasks = append(tasks, t)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// ExportStream retrieves tasks matching the given filter like Export, but decodes
+// the output of Taskwarrior while it is produced and passes each task to fn instead
+// of collecting them. Taskwarrior is stopped once limit tasks were read (when limit
+// is positive) or fn returns false. Malformed tasks are logged and skipped unless
+// SetStrictJSON was set. It returns the number of tasks passed to fn.
+func (c *Client) ExportStream(filter string, limit int, fn func(core.Task) bool) (int, error) {
 	// Split filter into separate arguments for proper parsing
 	// Taskwarrior syntax: task [filter] [command]
-	filterArgs := strings.Fields(filter)
+	filterArgs := SplitFilter(filter)
 	args := append(filterArgs, "export")
 
 	// TODO: Add sorting support
@@ -55,43 +85,84 @@ func (c *Client) Export(filter string) ([]core.Task, error) {
 
 	args = c.buildArgs(args...)
 
-	slog.Debug("Exporting tasks", "filter", filter)
+	slog.Debug("Streaming task export", "filter", filter, "limit", limit)
 
-	output, err := c.runCommand(args...)
+	cmd := c.command(args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = time.Second // Don't hang on children of a killed process
+	stdout, err := cmd.StdoutPipe()
 	if err != nil {
+		return 0, fmt.Errorf("failed to export tasks: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
 		slog.Error("Failed to export tasks", "error", err, "filter", filter)
-		return nil, fmt.Errorf("failed to export tasks: %w", err)
+		return 0, fmt.Errorf("failed to export tasks: %w", err)
 	}
 
-	// Log raw output for debugging JSON parsing issues
-	slog.Debug("Raw taskwarrior output", "output_preview", string(output[:min(500, len(output))]))
-
-	// Parse JSON output
-	tasks, err := ParseTaskJSON(output)
-	if err != nil {
-		slog.Error("Failed to parse task JSON",
-			"error", err,
-			"output_preview", string(output[:min(500, len(output))]))
-		return nil, fmt.Errorf("failed to parse task data (corrupted JSON): %w\nPlease check your Taskwarrior database integrity with 'task diagnostics'", err)
+	stopped := false
+	emit := func(t TaskwarriorTask) bool {
+		if !fn(MapToCore(t)) {
+			stopped = true
+			return false
+		}
+		return true
 	}
+	var count int
+	var parseErr error
+	if c.strictJSON {
+		count, parseErr = StreamTaskJSON(stdout, limit, emit)
+	} else {
+		var entryErrs []error
+		count, entryErrs, parseErr = StreamTaskJSONLenient(stdout, limit, emit)
+		for _, entryErr := range entryErrs {
+			slog.Warn("Skipping malformed task", "error", entryErr, "filter", filter)
+		}
+	}
+	if parseErr == nil && limit > 0 && count >= limit {
+		stopped = true
+	}
+	if stopped || parseErr != nil {
+		// The rest of the export is not needed
+		_ = cmd.Process.Kill()
+		_ = stdout.Close()
+	}
+	waitErr := cmd.Wait()
 
-	slog.Info("Successfully exported tasks", "count", len(tasks))
-
-	// Map to core.Task
-	coreTasks := make([]core.Task, len(tasks))
-	for i, t := range tasks {
-		coreTasks[i] = MapToCore(t)
+	if parseErr != nil {
+		slog.Error("Failed to parse task JSON", "error", parseErr, "stderr", strings.TrimSpace(stderr.String()))
+		return count, fmt.Errorf("failed to parse task data (corrupted JSON): %w\nPlease check your Taskwarrior database integrity with 'task diagnostics'", parseErr)
+	}
+	if waitErr != nil && !stopped {
+		slog.Error("Failed to export tasks", "error", waitErr, "filter", filter)
+		return count, fmt.Errorf("failed to export tasks: %w: %s", waitErr, strings.TrimSpace(stderr.String()))
 	}
 
-	return coreTasks, nil
+	slog.Info("Successfully exported tasks", "count", count)
+	return count, nil
 }
 
 // Modify updates a task with the given modifications
 func (c *Client) Modify(uuid, modifications string) error {
+	return c.modify(uuid, modifications)
+}
+
+// ModifyRecurring updates an instance of a recurring task, answering the
+// recurrence prompt of Taskwarrior with rc.recurrence.confirmation
+func (c *Client) ModifyRecurring(uuid, modifications string, all bool) error {
+	confirmation := "rc.recurrence.confirmation=no"
+	if all {
+		confirmation = "rc.recurrence.confirmation=yes"
+	}
+	return c.modify(uuid, modifications, confirmation)
+}
+
+// modify runs task modify, with the given rc overrides before the command
+func (c *Client) modify(uuid, modifications string, overrides ...string) error {
 	// Split modifications into separate arguments so taskwarrior parses them correctly
-	// e.g., "project:home +duties" becomes ["project:home", "+duties"]
-	modArgs := strings.Fields(modifications)
-	args := append([]string{uuid, "modify"}, modArgs...)
+	modArgs := splitModifications(modifications)
+	args := append(overrides, uuid, "modify")
+	args = append(args, modArgs...)
 	args = c.buildArgs(args...)
 	_, err := c.runCommand(args...)
 	if err != nil {
@@ -134,7 +205,7 @@ func (c *Client) Delete(uuid string) error {
 var createdTaskIDRe = regexp.MustCompile(`Created task (\d+)`)
 
 func (c *Client) Add(description string) (string, error) {
-	descArgs := strings.Fields(description)
+	descArgs := splitModifications(description)
 	args := append([]string{"add"}, descArgs...)
 	args = c.buildArgs(args...)
 	output, err := c.runCommand(args...)
@@ -142,17 +213,32 @@ func (c *Client) Add(description string) (string, error) {
 		return "", fmt.Errorf("failed to add task: %w", err)
 	}
 
-	// Parse the numeric task ID from "Created task N." in stdout, then export
-	// that specific task to get its UUID — avoids the unreliable "most urgent" heuristic.
+	return c.createdTaskUUID("add", output)
+}
+
+// Duplicate copies a task with "task <uuid> duplicate" and returns the UUID of the copy
+func (c *Client) Duplicate(uuid string) (string, error) {
+	args := c.buildArgs(uuid, "duplicate")
+	output, err := c.runCommand(args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to duplicate task %s: %w", uuid, err)
+	}
+	return c.createdTaskUUID("duplicate", output)
+}
+
+// createdTaskUUID returns the UUID of the task a command created. It parses the
+// numeric task ID from "Created task N." in stdout, then exports that specific
+// task to get its UUID — avoids the unreliable "most urgent" heuristic.
+func (c *Client) createdTaskUUID(command string, output []byte) (string, error) {
 	if m := createdTaskIDRe.FindSubmatch(output); m != nil {
 		taskID := string(m[1])
 		tasks, err := c.Export(taskID)
 		if err == nil && len(tasks) > 0 {
 			return tasks[0].UUID, nil
 		}
-		slog.Warn("Could not export task by ID after add", "taskID", taskID, "err", err)
+		slog.Warn("Could not export task by ID after "+command, "taskID", taskID, "err", err)
 	} else {
-		slog.Warn("Could not parse task ID from 'task add' output", "output", string(output))
+		slog.Warn("Could not parse task ID from 'task "+command+"' output", "output", string(output))
 	}
 
 	return "", errors.New("could not determine UUID of the newly created task")
@@ -168,9 +254,33 @@ func (c *Client) Undo() error {
 	return nil
 }
 
+// UndoPreview describes what Undo would revert. It runs "task undo" with
+// confirmation on and no input, so Taskwarrior shows the last change and
+// declines to revert it.
+func (c *Client) UndoPreview() (string, error) {
+	args := c.buildArgs("rc.confirmation=on", "rc.color=off", "rc.undo.style=side", "undo")
+	cmd := c.command(args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// Declining the revert may exit non-zero: only the printed change matters
+	runErr := cmd.Run()
+
+	changes, err := parseUndoOutput(stdout.Bytes())
+	if err != nil {
+		if runErr != nil {
+			return "", fmt.Errorf("failed to preview undo: %w: %s", runErr, strings.TrimSpace(stderr.String()))
+		}
+		return "", fmt.Errorf("failed to preview undo: %w", err)
+	}
+	return summarizeUndo(changes)
+}
+
 // Edit opens the task in an external editor
 func (c *Client) Edit(uuid string) error {
 	args := c.buildArgs(uuid, "edit")
+	c.logCommand(args)
 	cmd := exec.Command(c.taskBin, args...)
 	if c.taskrcPath != "" {
 		cmd.Env = append(os.Environ(), fmt.Sprintf("TASKRC=%s", c.taskrcPath))
@@ -205,16 +315,39 @@ func (c *Client) Stop(uuid string) error {
 	return nil
 }
 
+// nonInteractiveOverrides answer the questions Taskwarrior would ask, since it runs
+// without a terminal and would otherwise read "no" from the empty input
+var nonInteractiveOverrides = []string{
+	"rc.confirmation=off",            // Don't ask before deleting or undoing
+	"rc.bulk=0",                      // Don't ask before changing several tasks
+	"rc.recurrence.confirmation=no",  // Only change the given instance of a recurring task
+	"rc.dependency.confirmation=off", // Don't offer to repair a dependency chain on done
+}
+
 // buildArgs constructs command-line arguments for taskwarrior
 // It handles the taskrc path configuration
 func (c *Client) buildArgs(args ...string) []string {
-	// Simply return the arguments as-is
 	// The taskrc path is handled via TASKRC environment variable in runCommand
-	return args
+	if !c.nonInteractive {
+		return args
+	}
+
+	built := make([]string, 0, len(nonInteractiveOverrides)+len(args))
+	for _, override := range nonInteractiveOverrides {
+		// Overrides given by the caller win (e.g. rc.confirmation=on for UndoPreview)
+		name, _, _ := strings.Cut(override, "=")
+		if !slices.ContainsFunc(args, func(arg string) bool {
+			return strings.HasPrefix(arg, name+"=") || strings.HasPrefix(arg, name+":")
+		}) {
+			built = append(built, override)
+		}
+	}
+	return append(built, args...)
 }
 
-// runCommand executes a taskwarrior command and returns the output
-func (c *Client) runCommand(args ...string) ([]byte, error) {
+// command prepares a taskwarrior command with the client's taskrc
+func (c *Client) command(args ...string) *exec.Cmd {
+	c.logCommand(args)
 	cmd := exec.Command(c.taskBin, args...)
 
 	// Set TASKRC environment variable if taskrcPath is specified
@@ -222,6 +355,46 @@ func (c *Client) runCommand(args ...string) ([]byte, error) {
 		// Preserve existing environment and add TASKRC
 		cmd.Env = append(os.Environ(), fmt.Sprintf("TASKRC=%s", c.taskrcPath))
 	}
+	return cmd
+}
+
+// logCommand sends the command line running args to the command log, if any
+func (c *Client) logCommand(args []string) {
+	if c.commandLog == nil {
+		return
+	}
+	line := CommandLine(c.taskBin, args)
+	if c.taskrcPath != "" {
+		line = "TASKRC=" + shellQuote(c.taskrcPath) + " " + line
+	}
+	select {
+	case c.commandLog <- line:
+	default:
+		slog.Debug("Command log full, dropping command", "command", line)
+	}
+}
+
+// CommandLine formats a command as it would be typed in a shell
+func CommandLine(bin string, args []string) string {
+	words := make([]string, 0, len(args)+1)
+	words = append(words, shellQuote(bin))
+	for _, arg := range args {
+		words = append(words, shellQuote(arg))
+	}
+	return strings.Join(words, " ")
+}
+
+// shellQuote single-quotes a word that contains spaces or shell characters
+func shellQuote(word string) string {
+	if word != "" && !strings.ContainsAny(word, " \t\n'\"\\$`!*?&|;<>(){}[]~#") {
+		return word
+	}
+	return "'" + strings.ReplaceAll(word, "'", `'\''`) + "'"
+}
+
+// runCommand executes a taskwarrior command and returns the output
+func (c *Client) runCommand(args ...string) ([]byte, error) {
+	cmd := c.command(args...)
 
 	// Capture stdout and stderr separately
 	var stdout, stderr bytes.Buffer
@@ -251,6 +424,12 @@ func (c *Client) runCommand(args ...string) ([]byte, error) {
 		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
 	}
 
+	if bytes.Contains(stdout.Bytes(), []byte("(yes/no)")) {
+		slog.Warn("Taskwarrior asked a question without a terminal; it was answered no",
+			"args", args,
+			"output", strings.TrimSpace(stdout.String()))
+	}
+
 	// Log successful execution
 	slog.Debug("Taskwarrior command succeeded",
 		"output_size", stdout.Len())
@@ -322,6 +501,58 @@ func (c *Client) Sync() error {
 	return nil
 }
 
+// TaskSync runs "task sync" to synchronise with the Taskwarrior sync server
+func (c *Client) TaskSync() error {
+	args := c.buildArgs("sync")
+	_, err := c.runCommand(args...)
+	if err != nil {
+		return fmt.Errorf("failed to sync tasks: %w", err)
+	}
+	return nil
+}
+
+// ListContexts returns the names of the contexts defined in the taskrc
+func (c *Client) ListContexts() ([]string, error) {
+	args := c.buildArgs("rc.color=off", "context", "list")
+	cmd := c.command(args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// Without contexts some versions exit non-zero: the message is not a failure
+	runErr := cmd.Run()
+	if strings.Contains(stdout.String()+stderr.String(), noContextsMessage) {
+		return nil, nil
+	}
+	if runErr != nil {
+		return nil, fmt.Errorf("failed to list contexts: %w: %s", runErr, strings.TrimSpace(stderr.String()))
+	}
+	return parseContextList(stdout.Bytes()), nil
+}
+
+// GetContext returns the name of the active context, or "" when none is active
+func (c *Client) GetContext() (string, error) {
+	args := c.buildArgs("_get", "rc.context")
+	output, err := c.runCommand(args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the active context: %w", err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// SetContext activates the named context, or clears the active one when name is ""
+func (c *Client) SetContext(name string) error {
+	if name == "" {
+		name = "none"
+	}
+	args := c.buildArgs("context", name)
+	_, err := c.runCommand(args...)
+	if err != nil {
+		return fmt.Errorf("failed to set context %s: %w", name, err)
+	}
+	return nil
+}
+
 // parseLines splits newline-delimited output into a trimmed, non-empty slice
 func parseLines(output []byte) []string {
 	var result []string
@@ -1,6 +1,12 @@
 package taskwarrior
 
 import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/clobrano/wui/internal/core"
@@ -78,6 +84,194 @@ func TestClientExport(t *testing.T) {
 	}
 }
 
+// fakeTaskBin writes a task binary that prints output, whatever its arguments
+func fakeTaskBin(t *testing.T, output string) string {
+	t.Helper()
+	dir := t.TempDir()
+	data := filepath.Join(dir, "export.json")
+	if err := os.WriteFile(data, []byte(output), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	bin := filepath.Join(dir, "task")
+	if err := os.WriteFile(bin, []byte("#!/bin/sh\ncat "+data+"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return bin
+}
+
+func TestClientExportStream(t *testing.T) {
+	client := &Client{taskBin: fakeTaskBin(t, syntheticExport(5000))}
+
+	all, err := client.Export("status:pending")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(all) != 5000 || all[4999].UUID != "uuid-4999" || all[0].Tags[0] != "bulk" {
+		t.Fatalf("Expected all tasks mapped, got %d", len(all))
+	}
+
+	var limited []core.Task
+	count, err := client.ExportStream("status:pending", 10, func(task core.Task) bool {
+		limited = append(limited, task)
+		return true
+	})
+	if err != nil || count != 10 {
+		t.Fatalf("Expected 10 tasks, got %d (err %v)", count, err)
+	}
+	if limited[9].UUID != "uuid-9" {
+		t.Errorf("Expected the first 10 tasks, got %q last", limited[9].UUID)
+	}
+
+	count, err = client.ExportStream("", 0, func(task core.Task) bool {
+		return task.UUID != "uuid-99"
+	})
+	if err != nil || count != 100 {
+		t.Errorf("Expected to stop after 100 tasks, got %d (err %v)", count, err)
+	}
+}
+
+func TestClientExportStreamErrors(t *testing.T) {
+	client := &Client{taskBin: fakeTaskBin(t, `[{"uuid":"a","status":"pending"},{broken`)}
+	if _, err := client.Export(""); err == nil || !strings.Contains(err.Error(), "corrupted JSON") {
+		t.Errorf("Expected a corrupted JSON error, got %v", err)
+	}
+
+	client = &Client{taskBin: filepath.Join(t.TempDir(), "missing")}
+	if _, err := client.Export(""); err == nil {
+		t.Error("Expected an error for a missing binary")
+	}
+}
+
+func TestClientExportSkipsMalformedTasks(t *testing.T) {
+	client := &Client{taskBin: fakeTaskBin(t, exportWithBrokenTask)}
+	tasks, err := client.Export("")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Errorf("Expected the 2 valid tasks, got %d", len(tasks))
+	}
+
+	client.SetStrictJSON(true)
+	if _, err := client.Export(""); err == nil || !strings.Contains(err.Error(), "corrupted JSON") {
+		t.Errorf("Expected strict parsing to fail, got %v", err)
+	}
+}
+
+// recordingTaskBin writes a task binary that records its arguments, one per line
+func recordingTaskBin(t *testing.T) (bin string, argsFile string) {
+	t.Helper()
+	dir := t.TempDir()
+	argsFile = filepath.Join(dir, "args")
+	bin = filepath.Join(dir, "task")
+	if err := os.WriteFile(bin, []byte("#!/bin/sh\nprintf '%s\\n' \"$@\" > "+argsFile+"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return bin, argsFile
+}
+
+func TestClientCommandsAreNonInteractive(t *testing.T) {
+	bin, argsFile := recordingTaskBin(t)
+	client, err := NewClient(bin, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete and undo always turn confirmations off themselves
+	overrides := strings.Join(nonInteractiveOverrides, "\n")
+	otherOverrides := strings.Join(nonInteractiveOverrides[1:], "\n")
+	tests := []struct {
+		name     string
+		run      func() error
+		expected string
+	}{
+		{"modify", func() error { return client.Modify("uuid-1", "due:tomorrow") }, overrides + "\nuuid-1\nmodify\ndue:tomorrow"},
+		{"done", func() error { return client.Done("uuid-1") }, overrides + "\nuuid-1\ndone"},
+		{"delete", func() error { return client.Delete("uuid-1") }, otherOverrides + "\nuuid-1\ndelete\nrc.confirmation=off"},
+		{"start", func() error { return client.Start("uuid-1") }, overrides + "\nuuid-1\nstart"},
+		{"stop", func() error { return client.Stop("uuid-1") }, overrides + "\nuuid-1\nstop"},
+		{"undo", client.Undo, otherOverrides + "\nundo\nrc.confirmation=off"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.run(); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			data, err := os.ReadFile(argsFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := strings.TrimSpace(string(data)), tt.expected; got != want {
+				t.Errorf("Expected args:\n%s\ngot:\n%s", want, got)
+			}
+		})
+	}
+}
+
+func TestClientCommandLog(t *testing.T) {
+	bin, _ := recordingTaskBin(t)
+	commands := make(chan string, 1)
+	client := &Client{taskBin: bin}
+	client.SetCommandLog(commands)
+
+	if err := client.Annotate("uuid-1", "call Bob's office"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	select {
+	case got := <-commands:
+		if expected := bin + ` uuid-1 annotate 'call Bob'\''s office'`; got != expected {
+			t.Errorf("Expected command %q, got %q", expected, got)
+		}
+	default:
+		t.Fatal("Expected the command to be sent to the command log")
+	}
+
+	// The taskrc is part of the command, and a full log does not block commands
+	client.taskrcPath = "/home/user/my taskrc"
+	if err := client.Done("uuid-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := client.Done("uuid-2"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got, expected := <-commands, "TASKRC='/home/user/my taskrc' "+bin+" uuid-1 done"; got != expected {
+		t.Errorf("Expected command %q, got %q", expected, got)
+	}
+}
+
+func TestClientModifyRecurring(t *testing.T) {
+	bin, argsFile := recordingTaskBin(t)
+	client, err := NewClient(bin, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The answer to the recurrence prompt replaces the default one
+	others := []string{"rc.confirmation=off", "rc.bulk=0", "rc.dependency.confirmation=off"}
+	tests := []struct {
+		all          bool
+		confirmation string
+	}{
+		{false, "rc.recurrence.confirmation=no"},
+		{true, "rc.recurrence.confirmation=yes"},
+	}
+
+	for _, tt := range tests {
+		if err := client.ModifyRecurring("uuid-1", "due:tomorrow", tt.all); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		data, err := os.ReadFile(argsFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := strings.Join(append(others, tt.confirmation, "uuid-1", "modify", "due:tomorrow"), "\n")
+		if got := strings.TrimSpace(string(data)); got != want {
+			t.Errorf("all=%v: expected args:\n%s\ngot:\n%s", tt.all, want, got)
+		}
+	}
+}
+
 func TestClientModify(t *testing.T) {
 	client := &Client{
 		taskBin:    "/usr/bin/task",
@@ -92,6 +286,43 @@ func TestClientModify(t *testing.T) {
 	}
 }
 
+func TestClientModifyClearsAttributes(t *testing.T) {
+	// A fake task binary records its arguments, one per line
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	taskBin := filepath.Join(dir, "task")
+	script := "#!/bin/sh\nprintf '%s\\n' \"$@\" > " + argsFile + "\n"
+	if err := os.WriteFile(taskBin, []byte(script), 0755); err != nil {
+		t.Fatalf("Failed to create fake task binary: %v", err)
+	}
+	client := &Client{taskBin: taskBin}
+
+	tests := []struct {
+		modifications string
+		expected      []string
+	}{
+		{"priority:", []string{"abc-123", "modify", "priority:"}},
+		{"due: +next", []string{"abc-123", "modify", "due:", "+next"}},
+		{"  priority:   project:home ", []string{"abc-123", "modify", "priority:", "project:home"}},
+		{`due:"" wait:''`, []string{"abc-123", "modify", "due:", "wait:"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modifications, func(t *testing.T) {
+			if err := client.Modify("abc-123", tt.modifications); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			args, err := os.ReadFile(argsFile)
+			if err != nil {
+				t.Fatalf("Expected the task binary to run: %v", err)
+			}
+			if got := strings.Split(strings.TrimSuffix(string(args), "\n"), "\n"); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected argv %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
 func TestClientAnnotate(t *testing.T) {
 	client := &Client{
 		taskBin:    "/usr/bin/task",
@@ -144,6 +375,51 @@ func TestClientAdd(t *testing.T) {
 	}
 }
 
+func TestClientDuplicate(t *testing.T) {
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	bin := filepath.Join(dir, "task")
+	script := `#!/bin/sh
+for arg in "$@"; do
+	case "$arg" in
+	duplicate)
+		printf '%s\n' "$@" > ` + argsFile + `
+		echo "Duplicated task 3 'Water plants'."
+		echo "Created task 7."
+		exit 0 ;;
+	export)
+		echo '[{"id":7,"uuid":"uuid-copy","description":"Water plants","status":"pending"}]'
+		exit 0 ;;
+	esac
+done
+exit 1
+`
+	if err := os.WriteFile(bin, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &Client{taskBin: bin}
+	uuid, err := client.Duplicate("uuid-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if uuid != "uuid-copy" {
+		t.Errorf("Expected the UUID of the copy, got %q", uuid)
+	}
+	data, err := os.ReadFile(argsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(data)); got != "uuid-1\nduplicate" {
+		t.Errorf("Expected task uuid-1 duplicate, got %q", got)
+	}
+
+	client = &Client{taskBin: filepath.Join(t.TempDir(), "missing")}
+	if _, err := client.Duplicate("uuid-1"); err == nil || !strings.Contains(err.Error(), "failed to duplicate task uuid-1") {
+		t.Errorf("Expected a duplicate error, got %v", err)
+	}
+}
+
 func TestClientUndo(t *testing.T) {
 	client := &Client{
 		taskBin:    "/usr/bin/task",
@@ -157,6 +433,109 @@ func TestClientUndo(t *testing.T) {
 	}
 }
 
+func TestClientTaskSync(t *testing.T) {
+	// A fake task binary records its arguments
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	taskBin := filepath.Join(dir, "task")
+	script := "#!/bin/sh\necho \"$@\" > " + argsFile + "\n"
+	if err := os.WriteFile(taskBin, []byte(script), 0755); err != nil {
+		t.Fatalf("Failed to create fake task binary: %v", err)
+	}
+
+	client := &Client{taskBin: taskBin}
+	if err := client.TaskSync(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	args, err := os.ReadFile(argsFile)
+	if err != nil {
+		t.Fatalf("Expected the task binary to run: %v", err)
+	}
+	if got := strings.TrimSpace(string(args)); got != "sync" {
+		t.Errorf("Expected task sync, got task %q", got)
+	}
+
+	// Failures are reported
+	client = &Client{taskBin: filepath.Join(dir, "missing")}
+	if err := client.TaskSync(); err == nil {
+		t.Error("Expected an error when task sync fails")
+	}
+}
+
+// contextTaskBin writes a task binary that prints list for "context list", reports
+// work as the active context and records the arguments of the other commands
+func contextTaskBin(t *testing.T, list string, listStatus int) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	bin := filepath.Join(dir, "task")
+	script := `#!/bin/sh
+case "$*" in
+*"context list")
+	printf '%s' '` + list + `'
+	exit ` + strconv.Itoa(listStatus) + ` ;;
+*"_get rc.context")
+	echo work
+	exit 0 ;;
+esac
+printf '%s\n' "$@" > ` + argsFile + `
+`
+	if err := os.WriteFile(bin, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return bin, argsFile
+}
+
+func TestClientContexts(t *testing.T) {
+	bin, argsFile := contextTaskBin(t, "Name Type  Definition   Active\n---- ----- ------------ ------\nhome read  project:home no\n     write project:home no\nwork read  +work        yes\n     write +work        yes\n", 0)
+	client := &Client{taskBin: bin}
+
+	contexts, err := client.ListContexts()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(contexts, []string{"home", "work"}) {
+		t.Errorf("Expected contexts home and work, got %v", contexts)
+	}
+
+	active, err := client.GetContext()
+	if err != nil || active != "work" {
+		t.Errorf("Expected the active context work, got %q (%v)", active, err)
+	}
+
+	for name, expected := range map[string]string{"home": "context\nhome", "": "context\nnone"} {
+		if err := client.SetContext(name); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		data, err := os.ReadFile(argsFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.TrimSpace(string(data)); got != expected {
+			t.Errorf("SetContext(%q): expected args %q, got %q", name, expected, got)
+		}
+	}
+}
+
+func TestClientListContextsNoneDefined(t *testing.T) {
+	// Taskwarrior exits non-zero when no context is defined
+	bin, _ := contextTaskBin(t, "No contexts defined.\n", 1)
+	client := &Client{taskBin: bin}
+
+	contexts, err := client.ListContexts()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(contexts) != 0 {
+		t.Errorf("Expected no contexts, got %v", contexts)
+	}
+
+	client = &Client{taskBin: filepath.Join(t.TempDir(), "missing")}
+	if _, err := client.ListContexts(); err == nil || !strings.Contains(err.Error(), "failed to list contexts") {
+		t.Errorf("Expected a list error, got %v", err)
+	}
+}
+
 func TestClientEdit(t *testing.T) {
 	client := &Client{
 		taskBin:    "/usr/bin/task",
@@ -204,6 +583,18 @@ func TestBuildArgs(t *testing.T) {
 			args:     []string{"export", "status:pending"},
 			expected: []string{"export", "status:pending"},
 		},
+		{
+			name:     "non-interactive",
+			client:   &Client{taskBin: "/usr/bin/task", nonInteractive: true},
+			args:     []string{"uuid-1", "modify", "priority:H"},
+			expected: []string{"rc.confirmation=off", "rc.bulk=0", "rc.recurrence.confirmation=no", "rc.dependency.confirmation=off", "uuid-1", "modify", "priority:H"},
+		},
+		{
+			name:     "non-interactive keeps given overrides",
+			client:   &Client{taskBin: "/usr/bin/task", nonInteractive: true},
+			args:     []string{"rc.confirmation=on", "rc.bulk:5", "undo"},
+			expected: []string{"rc.recurrence.confirmation=no", "rc.dependency.confirmation=off", "rc.confirmation=on", "rc.bulk:5", "undo"},
+		},
 	}
 
 	for _, tt := range tests {
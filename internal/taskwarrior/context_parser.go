@@ -0,0 +1,48 @@
+package taskwarrior
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+// noContextsMessage is printed by "task context list" when no context is defined
+const noContextsMessage = "No contexts defined"
+
+// parseContextList parses the output of "task context list" into context names.
+// Taskwarrior 2.6+ prints a read and a write definition per context, the second
+// on an indented line without the name; older versions print one line each:
+//
+//	Name Type  Definition    Active
+//	---- ----- ------------- ------
+//	home read  project:home  no
+//	     write project:home  no
+//	work read  +work         yes
+//	     write +work         yes
+func parseContextList(output []byte) []string {
+	var names []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.Contains(line, noContextsMessage) {
+			return nil
+		}
+
+		// Skip blank lines and the continuation lines of a context
+		if strings.TrimSpace(line) == "" || line[0] == ' ' || line[0] == '\t' {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		// Skip the header and its underline
+		if fields[0] == "Name" && len(fields) > 1 && (fields[1] == "Type" || fields[1] == "Definition") {
+			continue
+		}
+		if strings.Trim(line, "- ") == "" {
+			continue
+		}
+		names = append(names, fields[0])
+	}
+	return names
+}
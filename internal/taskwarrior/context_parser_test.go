@@ -0,0 +1,55 @@
+package taskwarrior
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseContextList(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		expected []string
+	}{
+		{
+			name: "read and write definitions",
+			output: `
+Name     Type  Definition                  Active
+-------- ----- --------------------------- ------
+home     read  project:home                no
+         write project:home                no
+work     read  +work or project:office     yes
+         write +work                       yes
+
+`,
+			expected: []string{"home", "work"},
+		},
+		{
+			name: "one definition per context",
+			output: `Name   Definition        Active
+------ ----------------- ------
+errand +errand           no
+home   project:home      yes
+`,
+			expected: []string{"errand", "home"},
+		},
+		{
+			name:     "no contexts defined",
+			output:   "No contexts defined.\n",
+			expected: nil,
+		},
+		{
+			name:     "empty output",
+			output:   "",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseContextList([]byte(tt.output)); !slices.Equal(got, tt.expected) {
+				t.Errorf("parseContextList() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
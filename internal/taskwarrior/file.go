@@ -0,0 +1,615 @@
+package taskwarrior
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// FileTaskService implements core.TaskService on top of a Taskwarrior export
+// JSON file, for demos and testing without a Taskwarrior installation.
+// Filters are evaluated in memory (see parseFilter for the supported syntax) and
+// changes are kept in memory until Save writes them back to the file.
+type FileTaskService struct {
+	mu      sync.Mutex
+	path    string
+	tasks   []core.Task
+	history [][]core.Task // Snapshots of tasks before each change, for Undo
+	skipped int           // Malformed tasks skipped when loading, which Save would drop
+}
+
+// NewFileTaskService loads tasks from a Taskwarrior export JSON file.
+// A path of "-" reads the export from standard input. Malformed tasks are skipped
+// with a warning, unless strictJSON is set, which fails loading instead.
+func NewFileTaskService(path string, strictJSON bool) (*FileTaskService, error) {
+	if path == "" {
+		return nil, errors.New("tasks file path cannot be empty")
+	}
+
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tasks file: %w", err)
+	}
+
+	service, err := newFileTaskServiceFromJSON(data, strictJSON)
+	if err != nil {
+		return nil, err
+	}
+	service.path = path
+	slog.Info("Loaded tasks from file", "path", path, "count", len(service.tasks))
+	return service, nil
+}
+
+// newFileTaskServiceFromJSON creates a FileTaskService from Taskwarrior export JSON
+func newFileTaskServiceFromJSON(data []byte, strictJSON bool) (*FileTaskService, error) {
+	var twTasks []TaskwarriorTask
+	var entryErrs []error
+	var err error
+	if strictJSON {
+		twTasks, err = ParseTaskJSON(data)
+	} else {
+		twTasks, entryErrs, err = ParseTaskJSONLenient(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tasks file: %w", err)
+	}
+	for _, entryErr := range entryErrs {
+		slog.Warn("Skipping malformed task", "error", entryErr)
+	}
+
+	tasks := make([]core.Task, len(twTasks))
+	for i, t := range twTasks {
+		tasks[i] = MapToCore(t)
+	}
+	return &FileTaskService{tasks: tasks, skipped: len(entryErrs)}, nil
+}
+
+// Export retrieves tasks matching the given filter
+func (s *FileTaskService) Export(filter string) ([]core.Task, error) {
+	matcher, err := parseFilter(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export tasks: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := []core.Task{}
+	for _, task := range s.tasks {
+		if matcher(task) {
+			result = append(result, cloneTask(task))
+		}
+	}
+	return result, nil
+}
+
+// Modify updates a task with the given modifications
+func (s *FileTaskService) Modify(uuid, modifications string) error {
+	return s.update(uuid, func(task *core.Task) error {
+		return parseModification(modifications).apply(task, s.resolveID)
+	})
+}
+
+// ModifyRecurring updates an instance of a recurring task. With all set, the other
+// pending instances and the template change too, in a single undo step.
+func (s *FileTaskService) ModifyRecurring(uuid, modifications string, all bool) error {
+	if !all {
+		return s.Modify(uuid, modifications)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parent := ""
+	for _, task := range s.tasks {
+		if task.UUID == uuid {
+			parent = task.Parent
+			break
+		}
+	}
+	if parent == "" {
+		return fmt.Errorf("task %s is not a recurring task instance", uuid)
+	}
+
+	mod := parseModification(modifications)
+	updated := make([]core.Task, len(s.tasks))
+	for i, task := range s.tasks {
+		updated[i] = task
+		sibling := task.Parent == parent && (task.Status == "pending" || task.Status == "waiting")
+		if task.UUID != uuid && task.UUID != parent && !sibling {
+			continue
+		}
+		task = cloneTask(task)
+		if err := mod.apply(&task, s.resolveID); err != nil {
+			return fmt.Errorf("failed to update task %s: %w", task.UUID, err)
+		}
+		now := core.Now().UTC()
+		task.Modified = &now
+		updated[i] = task
+	}
+
+	s.pushHistory()
+	s.tasks = updated
+	s.renumber()
+	return nil
+}
+
+// Annotate adds an annotation (note) to a task
+func (s *FileTaskService) Annotate(uuid, text string) error {
+	return s.update(uuid, func(task *core.Task) error {
+		task.Annotations = append(task.Annotations, core.Annotation{Entry: core.Now().UTC(), Description: text})
+		return nil
+	})
+}
+
+// Denotate removes an annotation from a task matching the given description
+func (s *FileTaskService) Denotate(uuid, description string) error {
+	return s.update(uuid, func(task *core.Task) error {
+		for i, a := range task.Annotations {
+			if a.Description == description {
+				task.Annotations = append(task.Annotations[:i:i], task.Annotations[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("annotation %q not found", description)
+	})
+}
+
+// Done marks a task as completed
+func (s *FileTaskService) Done(uuid string) error {
+	return s.update(uuid, func(task *core.Task) error {
+		if task.Status == "completed" || task.Status == "deleted" {
+			return fmt.Errorf("task is %s", task.Status)
+		}
+		now := core.Now().UTC()
+		task.Status = "completed"
+		task.Start = nil
+		task.End = &now
+		return nil
+	})
+}
+
+// Delete marks a task as deleted
+func (s *FileTaskService) Delete(uuid string) error {
+	return s.update(uuid, func(task *core.Task) error {
+		if task.Status == "deleted" {
+			return errors.New("task is already deleted")
+		}
+		now := core.Now().UTC()
+		task.Status = "deleted"
+		task.Start = nil
+		task.End = &now
+		return nil
+	})
+}
+
+// Start marks a task as started (active)
+func (s *FileTaskService) Start(uuid string) error {
+	return s.update(uuid, func(task *core.Task) error {
+		if task.Start != nil {
+			return errors.New("task is already started")
+		}
+		now := core.Now().UTC()
+		task.Start = &now
+		return nil
+	})
+}
+
+// Stop marks a task as stopped (pending)
+func (s *FileTaskService) Stop(uuid string) error {
+	return s.update(uuid, func(task *core.Task) error {
+		if task.Start == nil {
+			return errors.New("task is not started")
+		}
+		task.Start = nil
+		return nil
+	})
+}
+
+// Add creates a new task from a description with optional attributes
+// (e.g. "Buy milk project:home +shopping") and returns its UUID
+func (s *FileTaskService) Add(description string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := core.Now().UTC()
+	task := core.Task{
+		UUID:     newUUID(),
+		Status:   "pending",
+		Entry:    now,
+		Modified: &now,
+		Tags:     []string{},
+		Depends:  []string{},
+		UDAs:     make(map[string]string),
+	}
+	if err := parseModification(description).apply(&task, s.resolveID); err != nil {
+		return "", fmt.Errorf("failed to add task: %w", err)
+	}
+	if task.Description == "" {
+		return "", errors.New("failed to add task: description cannot be empty")
+	}
+
+	s.pushHistory()
+	s.tasks = append(s.tasks, task)
+	s.renumber()
+	return task.UUID, nil
+}
+
+// Duplicate copies a task like "task duplicate": the copy is pending and not
+// started, and the copy of a recurring instance does not recur
+func (s *FileTaskService) Duplicate(uuid string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, original := range s.tasks {
+		if original.UUID != uuid {
+			continue
+		}
+		now := core.Now().UTC()
+		task := cloneTask(original)
+		task.UUID = newUUID()
+		task.Entry = now
+		task.Modified = &now
+		task.Start = nil
+		task.End = nil
+		if task.Status == "completed" || task.Status == "deleted" {
+			task.Status = "pending"
+		}
+		if task.IsRecurringInstance() {
+			task.Recur = ""
+			task.Parent = ""
+			for _, key := range []string{"recur", "parent", "imask", "until"} {
+				delete(task.UDAs, key)
+			}
+		}
+
+		s.pushHistory()
+		s.tasks = append(s.tasks, task)
+		s.renumber()
+		return task.UUID, nil
+	}
+	return "", fmt.Errorf("failed to duplicate task %s: task not found", uuid)
+}
+
+// Undo reverts the last task operation
+func (s *FileTaskService) Undo() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.history) == 0 {
+		return errors.New("nothing to undo")
+	}
+	s.tasks = s.history[len(s.history)-1]
+	s.history = s.history[:len(s.history)-1]
+	return nil
+}
+
+// UndoPreview describes what Undo would revert
+func (s *FileTaskService) UndoPreview() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.history) == 0 {
+		return "", errors.New("nothing to undo")
+	}
+	prior := make(map[string]core.Task)
+	for _, task := range s.history[len(s.history)-1] {
+		prior[task.UUID] = task
+	}
+	for _, task := range s.tasks {
+		before, existed := prior[task.UUID]
+		if !existed {
+			return summarizeUndo(taskChanges(nil, &task))
+		}
+		if changes := taskChanges(&before, &task); changesDiffer(changes) {
+			return summarizeUndo(changes)
+		}
+	}
+	return "", errors.New("the last operation changed nothing")
+}
+
+// taskChanges lists the exported attributes of a task before and after a change;
+// a nil task has no attributes
+func taskChanges(before, after *core.Task) []undoChange {
+	prior, current := exportedFields(before), exportedFields(after)
+	names := make([]string, 0, len(current))
+	for name := range current {
+		names = append(names, name)
+	}
+	for name := range prior {
+		if _, ok := current[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := make([]undoChange, 0, len(names))
+	for _, name := range names {
+		if name == "id" || name == "urgency" {
+			continue
+		}
+		changes = append(changes, undoChange{name: name, prior: prior[name], current: current[name]})
+	}
+	return changes
+}
+
+// exportedFields returns the attributes of a task as Taskwarrior exports them
+func exportedFields(task *core.Task) map[string]string {
+	fields := make(map[string]string)
+	if task == nil {
+		return fields
+	}
+	data, err := json.Marshal(MapFromCore(*task))
+	if err != nil {
+		return fields
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fields
+	}
+	for name, value := range raw {
+		switch v := value.(type) {
+		case string:
+			fields[name] = v
+		case []interface{}:
+			values := make([]string, 0, len(v))
+			for _, item := range v {
+				if text, ok := item.(string); ok {
+					values = append(values, text)
+				} else if encoded, err := json.Marshal(item); err == nil {
+					values = append(values, string(encoded))
+				}
+			}
+			fields[name] = strings.Join(values, " ")
+		default:
+			fields[name] = fmt.Sprint(v)
+		}
+	}
+	return fields
+}
+
+// changesDiffer reports whether any attribute changed
+func changesDiffer(changes []undoChange) bool {
+	for _, change := range changes {
+		if change.prior != change.current {
+			return true
+		}
+	}
+	return false
+}
+
+// Edit is not supported: there is no task database to open in an editor
+func (s *FileTaskService) Edit(uuid string) error {
+	return errors.New("editing is not supported with a tasks file; use modify instead")
+}
+
+// Save writes the tasks back to the file they were loaded from, as Taskwarrior export JSON.
+// It refuses to when malformed tasks were skipped on loading, since they would be lost.
+func (s *FileTaskService) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.path == "" || s.path == "-" {
+		return errors.New("tasks read from stdin cannot be saved")
+	}
+	if s.skipped > 0 {
+		return fmt.Errorf("%d malformed tasks were skipped when loading %s; saving would drop them", s.skipped, s.path)
+	}
+
+	twTasks := make([]TaskwarriorTask, len(s.tasks))
+	for i, task := range s.tasks {
+		twTasks[i] = MapFromCore(task)
+	}
+	data, err := json.MarshalIndent(twTasks, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode tasks: %w", err)
+	}
+	if err := os.WriteFile(s.path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write tasks file: %w", err)
+	}
+	slog.Info("Saved tasks to file", "path", s.path, "count", len(s.tasks))
+	return nil
+}
+
+// update applies a change to the task with the given UUID, recording undo history
+// and refreshing the modification date and IDs
+func (s *FileTaskService) update(uuid string, change func(task *core.Task) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.tasks {
+		if s.tasks[i].UUID != uuid {
+			continue
+		}
+		task := cloneTask(s.tasks[i])
+		if err := change(&task); err != nil {
+			return fmt.Errorf("failed to update task %s: %w", uuid, err)
+		}
+		now := core.Now().UTC()
+		task.Modified = &now
+
+		s.pushHistory()
+		s.tasks[i] = task
+		s.renumber()
+		return nil
+	}
+	return fmt.Errorf("task %s not found", uuid)
+}
+
+// pushHistory saves a copy of the current tasks for Undo
+func (s *FileTaskService) pushHistory() {
+	snapshot := make([]core.Task, len(s.tasks))
+	for i, task := range s.tasks {
+		snapshot[i] = cloneTask(task)
+	}
+	s.history = append(s.history, snapshot)
+}
+
+// renumber regenerates task IDs the way Taskwarrior does: pending, waiting and
+// recurring tasks are numbered in order, completed and deleted tasks have no ID
+func (s *FileTaskService) renumber() {
+	id := 1
+	for i := range s.tasks {
+		switch s.tasks[i].Status {
+		case "completed", "deleted":
+			s.tasks[i].ID = 0
+		default:
+			s.tasks[i].ID = id
+			id++
+		}
+	}
+}
+
+// resolveID returns the UUID of the task with the given ID
+func (s *FileTaskService) resolveID(id int) (string, bool) {
+	for _, task := range s.tasks {
+		if task.ID == id {
+			return task.UUID, true
+		}
+	}
+	return "", false
+}
+
+// cloneTask returns a copy of a task that shares no slices or maps with the original
+func cloneTask(task core.Task) core.Task {
+	task.Tags = append([]string(nil), task.Tags...)
+	task.Depends = append([]string(nil), task.Depends...)
+	task.Annotations = append([]core.Annotation(nil), task.Annotations...)
+	udas := make(map[string]string, len(task.UDAs))
+	for key, value := range task.UDAs {
+		udas[key] = value
+	}
+	task.UDAs = udas
+	return task
+}
+
+// newUUID returns a random (version 4) UUID
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// GetProjectSummary computes project completion like "task summary": every
+// project with pending tasks, and its parent projects, with the percentage of
+// its pending and completed tasks that are completed
+func (s *FileTaskService) GetProjectSummary() ([]core.ProjectSummary, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pending := make(map[string]int)
+	completed := make(map[string]int)
+	for _, task := range s.tasks {
+		if task.Project == "" {
+			continue
+		}
+		parts := strings.Split(task.Project, ".")
+		for i := range parts {
+			name := strings.Join(parts[:i+1], ".")
+			switch {
+			case isPending(task):
+				pending[name]++
+			case task.Status == "completed":
+				completed[name]++
+			}
+		}
+	}
+
+	summaries := []core.ProjectSummary{}
+	for name, count := range pending {
+		summaries = append(summaries, core.ProjectSummary{
+			Name:       name,
+			Percentage: completed[name] * 100 / (count + completed[name]),
+		})
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].Name < summaries[j].Name })
+	return summaries, nil
+}
+
+// GetTags returns all tags currently in use across tasks
+func (s *FileTaskService) GetTags() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool)
+	tags := []string{}
+	for _, task := range s.tasks {
+		for _, tag := range task.Tags {
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+// GetUdas returns the names of the UDAs found in the loaded tasks
+func (s *FileTaskService) GetUdas() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool)
+	udas := []string{}
+	for _, task := range s.tasks {
+		for name := range task.UDAs {
+			if !seen[name] {
+				seen[name] = true
+				udas = append(udas, name)
+			}
+		}
+	}
+	sort.Strings(udas)
+	return udas, nil
+}
+
+// GetVersion describes the file backend in place of a taskwarrior version
+func (s *FileTaskService) GetVersion() (string, error) {
+	return "tasks file " + s.path, nil
+}
+
+// Sync is a no-op: there is no task server behind a tasks file
+func (s *FileTaskService) Sync() error {
+	return nil
+}
+
+// TaskSync is not supported: there is no task server behind a tasks file
+func (s *FileTaskService) TaskSync() error {
+	return errors.New("task sync is not available with a tasks file")
+}
+
+// ListContexts returns no contexts: they are defined in a taskrc, not in a tasks file
+func (s *FileTaskService) ListContexts() ([]string, error) {
+	return nil, nil
+}
+
+// GetContext returns "": a tasks file has no active context
+func (s *FileTaskService) GetContext() (string, error) {
+	return "", nil
+}
+
+// SetContext is not supported: contexts are defined in a taskrc, not in a tasks file
+func (s *FileTaskService) SetContext(name string) error {
+	if name == "" {
+		return nil
+	}
+	return errors.New("contexts are not available with a tasks file")
+}
@@ -0,0 +1,410 @@
+package taskwarrior
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+const sampleExport = `[
+	{"id": 1, "uuid": "a1b2c3d4-0000-0000-0000-000000000001", "description": "Fix login crash", "status": "pending", "project": "Work.web", "tags": ["bug"], "priority": "H", "entry": "20260101T120000Z", "urgency": 8.1},
+	{"id": 2, "uuid": "a1b2c3d4-0000-0000-0000-000000000002", "description": "Write report", "status": "pending", "project": "Work", "start": "20260102T090000Z", "entry": "20260101T120000Z", "urgency": 5.0,
+	 "annotations": [{"entry": "20260102T100000Z", "description": "TODO: add charts"}]},
+	{"id": 3, "uuid": "a1b2c3d4-0000-0000-0000-000000000003", "description": "Buy milk", "status": "waiting", "project": "Home", "wait": "20990101T000000Z", "entry": "20260101T120000Z", "urgency": 1.0},
+	{"id": 0, "uuid": "a1b2c3d4-0000-0000-0000-000000000004", "description": "Deploy web app", "status": "completed", "project": "Work.web", "end": "20260103T120000Z", "entry": "20260101T120000Z", "urgency": 0},
+	{"id": 0, "uuid": "a1b2c3d4-0000-0000-0000-000000000005", "description": "Old idea", "status": "deleted", "entry": "20260101T120000Z", "estimate": 3, "urgency": 0}
+]`
+
+func writeSampleFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "tasks.json")
+	if err := os.WriteFile(path, []byte(sampleExport), 0644); err != nil {
+		t.Fatalf("Failed to write sample file: %v", err)
+	}
+	return path
+}
+
+func TestNewFileTaskService(t *testing.T) {
+	service, err := NewFileTaskService(writeSampleFile(t), false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tasks, err := service.Export("")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(tasks) != 5 {
+		t.Fatalf("Expected 5 tasks, got %d", len(tasks))
+	}
+	if tasks[1].Start == nil || len(tasks[1].Annotations) != 1 {
+		t.Error("Expected dates and annotations to be mapped")
+	}
+	if got := tasks[4].GetUDA("estimate"); got != "3" {
+		t.Errorf("Expected UDA estimate=3, got %q", got)
+	}
+}
+
+func TestNewFileTaskService_Errors(t *testing.T) {
+	if _, err := NewFileTaskService("", false); err == nil {
+		t.Error("Expected error for empty path")
+	}
+	if _, err := NewFileTaskService(filepath.Join(t.TempDir(), "missing.json"), false); err == nil {
+		t.Error("Expected error for missing file")
+	}
+
+	path := filepath.Join(t.TempDir(), "bad.json")
+	if err := os.WriteFile(path, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileTaskService(path, false); err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestFileTaskService_ExportFilters(t *testing.T) {
+	service, err := NewFileTaskService(writeSampleFile(t), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		filter   string
+		expected []int // indexes into the sample export
+	}{
+		{"( status:pending or status:active ) -WAITING", []int{1, 2}},
+		{"status:waiting", []int{3}},
+		{"status:pending or status:waiting or status:active", []int{1, 2, 3}},
+		{"status.any: project:Work", []int{1, 2, 4}},
+		{"status.any: project:Work.web", []int{1, 4}},
+		{"status.any: project:", []int{5}},
+		{"status:completed", []int{4}},
+		{"+bug", []int{1}},
+		{"status.any: crash", []int{1}},
+		{"status.any: ( description ~ charts or annotations ~ charts )", []int{2}},
+		{"+ACTIVE", []int{2}},
+		{"1-2", []int{1, 2}},
+		{"a1b2c3d4-0000-0000-0000-000000000005", []int{5}},
+		{"priority:H", []int{1}},
+		{"status.any: estimate:3", []int{5}},
+		{"status.not:deleted not +bug", []int{2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			tasks, err := service.Export(tt.filter)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(tasks) != len(tt.expected) {
+				t.Fatalf("Expected %d tasks, got %d: %v", len(tt.expected), len(tasks), tasks)
+			}
+			for i, task := range tasks {
+				want := "a1b2c3d4-0000-0000-0000-00000000000" + string(rune('0'+tt.expected[i]))
+				if task.UUID != want {
+					t.Errorf("Expected task %s at %d, got %s", want, i, task.UUID)
+				}
+			}
+		})
+	}
+
+	if _, err := service.Export("( status:pending"); err == nil {
+		t.Error("Expected error for unbalanced parentheses")
+	}
+}
+
+func TestFileTaskService_Metadata(t *testing.T) {
+	service, err := NewFileTaskService(writeSampleFile(t), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summaries, err := service.GetProjectSummary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]int{"Home": 0, "Work": 33, "Work.web": 50}
+	if len(summaries) != len(expected) {
+		t.Fatalf("Expected %d project summaries, got %v", len(expected), summaries)
+	}
+	for _, s := range summaries {
+		if pct, ok := expected[s.Name]; !ok || pct != s.Percentage {
+			t.Errorf("Unexpected summary %s: %d%%", s.Name, s.Percentage)
+		}
+	}
+
+	tags, _ := service.GetTags()
+	if len(tags) != 1 || tags[0] != "bug" {
+		t.Errorf("Expected tags [bug], got %v", tags)
+	}
+	udas, _ := service.GetUdas()
+	if len(udas) != 1 || udas[0] != "estimate" {
+		t.Errorf("Expected UDAs [estimate], got %v", udas)
+	}
+
+	if contexts, err := service.ListContexts(); err != nil || len(contexts) != 0 {
+		t.Errorf("Expected no contexts, got %v (%v)", contexts, err)
+	}
+	if err := service.SetContext("work"); err == nil {
+		t.Error("Expected contexts to be unsupported with a tasks file")
+	}
+}
+
+func TestFileTaskService_AddListDone(t *testing.T) {
+	service, err := NewFileTaskService(writeSampleFile(t), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uuid, err := service.Add("Buy bread project:Home +shopping priority:h")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tasks, _ := service.Export("+shopping")
+	if len(tasks) != 1 {
+		t.Fatalf("Expected the new task to be listed, got %d tasks", len(tasks))
+	}
+	added := tasks[0]
+	if added.UUID != uuid || added.Description != "Buy bread" || added.Project != "Home" || added.Priority != "H" {
+		t.Errorf("Unexpected new task: %+v", added)
+	}
+	if added.ID != 4 {
+		t.Errorf("Expected the new task to get ID 4, got %d", added.ID)
+	}
+
+	// Completing a task renumbers the remaining pending tasks
+	if err := service.Done("a1b2c3d4-0000-0000-0000-000000000001"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	pending, _ := service.Export("status:pending or status:waiting")
+	if len(pending) != 3 {
+		t.Fatalf("Expected 3 pending tasks, got %d", len(pending))
+	}
+	for i, task := range pending {
+		if task.ID != i+1 {
+			t.Errorf("Expected %q to have ID %d, got %d", task.Description, i+1, task.ID)
+		}
+	}
+	completed, _ := service.Export("status:completed")
+	if len(completed) != 2 || completed[0].ID != 0 || completed[0].End == nil {
+		t.Errorf("Expected the done task to be completed without an ID, got %+v", completed)
+	}
+	if err := service.Done("a1b2c3d4-0000-0000-0000-000000000001"); err == nil {
+		t.Error("Expected error completing a completed task")
+	}
+
+	// Undo reverts the last change
+	if err := service.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if tasks, _ := service.Export("1"); len(tasks) != 1 || tasks[0].Description != "Fix login crash" {
+		t.Errorf("Expected undo to restore task 1, got %+v", tasks)
+	}
+}
+
+func TestFileTaskService_Mutations(t *testing.T) {
+	service, err := NewFileTaskService(writeSampleFile(t), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const uuid = "a1b2c3d4-0000-0000-0000-000000000001"
+	get := func() core.Task {
+		tasks, _ := service.Export(uuid)
+		if len(tasks) != 1 {
+			t.Fatalf("Expected one task for %s, got %d", uuid, len(tasks))
+		}
+		return tasks[0]
+	}
+
+	if err := service.Modify(uuid, "-bug +urgent project: due:2026-05-01 estimate:2 depends:2"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	task := get()
+	if len(task.Tags) != 1 || task.Tags[0] != "urgent" || task.Project != "" {
+		t.Errorf("Unexpected tags/project after modify: %v %q", task.Tags, task.Project)
+	}
+	if task.Due == nil || task.GetUDA("estimate") != "2" || task.Modified == nil {
+		t.Errorf("Expected due, UDA and modified to be set, got %+v", task)
+	}
+	if len(task.Depends) != 1 || task.Depends[0] != "a1b2c3d4-0000-0000-0000-000000000002" {
+		t.Errorf("Expected depends to resolve ID 2, got %v", task.Depends)
+	}
+
+	if err := service.Start(uuid); err != nil || get().Start == nil {
+		t.Errorf("Expected task to be started, err=%v", err)
+	}
+	if err := service.Stop(uuid); err != nil || get().Start != nil {
+		t.Errorf("Expected task to be stopped, err=%v", err)
+	}
+	if err := service.Annotate(uuid, "see PR"); err != nil || len(get().Annotations) != 1 {
+		t.Errorf("Expected an annotation, err=%v", err)
+	}
+	if err := service.Denotate(uuid, "see PR"); err != nil || len(get().Annotations) != 0 {
+		t.Errorf("Expected the annotation to be removed, err=%v", err)
+	}
+	if err := service.Modify(uuid, "wait:2099-01-01"); err != nil || get().Status != "waiting" {
+		t.Errorf("Expected a future wait date to make the task waiting, err=%v", err)
+	}
+	if err := service.Delete(uuid); err != nil || get().Status != "deleted" {
+		t.Errorf("Expected task to be deleted, err=%v", err)
+	}
+
+	if err := service.Modify("missing", "+x"); err == nil {
+		t.Error("Expected error modifying a missing task")
+	}
+	if err := service.Modify(uuid, "due:someday"); err == nil {
+		t.Error("Expected error for an unsupported date")
+	}
+	if err := service.Edit(uuid); err == nil {
+		t.Error("Expected edit to be unsupported")
+	}
+}
+
+func TestNewFileTaskService_MalformedTask(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tasks.json")
+	export := `[{"uuid": "good", "description": "Buy milk", "status": "pending"}, {"id": "two", "uuid": "bad", "description": "Broken", "status": "pending"}]`
+	if err := os.WriteFile(path, []byte(export), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	service, err := NewFileTaskService(path, false)
+	if err != nil {
+		t.Fatalf("Expected the malformed task to be skipped, got %v", err)
+	}
+	if tasks, _ := service.Export(""); len(tasks) != 1 || tasks[0].UUID != "good" {
+		t.Errorf("Expected only the good task, got %+v", tasks)
+	}
+	if err := service.Save(); err == nil {
+		t.Error("Expected save to refuse dropping the skipped task")
+	}
+
+	if _, err := NewFileTaskService(path, true); err == nil {
+		t.Error("Expected strict loading to fail on the malformed task")
+	}
+}
+
+func TestFileTaskService_Save(t *testing.T) {
+	path := writeSampleFile(t)
+	service, err := NewFileTaskService(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuid, err := service.Add("Saved task +demo estimate:5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.Save(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	reloaded, err := NewFileTaskService(path, false)
+	if err != nil {
+		t.Fatalf("Expected saved file to load, got %v", err)
+	}
+	tasks, _ := reloaded.Export(uuid)
+	if len(tasks) != 1 || tasks[0].Description != "Saved task" || tasks[0].GetUDA("estimate") != "5" {
+		t.Errorf("Expected the saved task after reload, got %+v", tasks)
+	}
+	all, _ := reloaded.Export("")
+	if len(all) != 6 {
+		t.Errorf("Expected 6 tasks after reload, got %d", len(all))
+	}
+}
+
+func TestFileTaskService_ModifyRecurring(t *testing.T) {
+	const recurringExport = `[
+	{"uuid": "template", "description": "Water plants", "status": "recurring", "recur": "weekly", "due": "20260105T090000Z", "entry": "20260101T120000Z"},
+	{"id": 1, "uuid": "instance-1", "description": "Water plants", "status": "pending", "recur": "weekly", "parent": "template", "due": "20260105T090000Z", "entry": "20260101T120000Z"},
+	{"id": 2, "uuid": "instance-2", "description": "Water plants", "status": "pending", "recur": "weekly", "parent": "template", "due": "20260112T090000Z", "entry": "20260101T120000Z"},
+	{"uuid": "instance-0", "description": "Water plants", "status": "completed", "recur": "weekly", "parent": "template", "due": "20251229T090000Z", "end": "20251229T100000Z", "entry": "20260101T120000Z"},
+	{"id": 3, "uuid": "other", "description": "Buy milk", "status": "pending", "entry": "20260101T120000Z"}
+]`
+	path := filepath.Join(t.TempDir(), "tasks.json")
+	if err := os.WriteFile(path, []byte(recurringExport), 0644); err != nil {
+		t.Fatal(err)
+	}
+	service, err := NewFileTaskService(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	priorities := func() map[string]string {
+		tasks, _ := service.Export("")
+		result := make(map[string]string)
+		for _, task := range tasks {
+			result[task.UUID] = task.Priority
+		}
+		return result
+	}
+
+	if err := service.ModifyRecurring("instance-1", "priority:L", false); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := priorities(); got["instance-1"] != "L" || got["instance-2"] != "" || got["template"] != "" {
+		t.Errorf("Expected only the instance to change, got %v", got)
+	}
+
+	if err := service.ModifyRecurring("instance-1", "priority:H", true); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	got := priorities()
+	for _, uuid := range []string{"template", "instance-1", "instance-2"} {
+		if got[uuid] != "H" {
+			t.Errorf("Expected %s to change with all instances, got %v", uuid, got)
+		}
+	}
+	if got["instance-0"] != "" || got["other"] != "" {
+		t.Errorf("Expected completed instances and other tasks to stay unchanged, got %v", got)
+	}
+
+	// A single undo step reverts all the instances
+	if err := service.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if got := priorities(); got["template"] != "" || got["instance-2"] != "" || got["instance-1"] != "L" {
+		t.Errorf("Expected undo to revert every instance, got %v", got)
+	}
+
+	if err := service.ModifyRecurring("other", "priority:H", true); err == nil {
+		t.Error("Expected error for a task that is not a recurring instance")
+	}
+}
+
+func TestFileTaskService_Duplicate(t *testing.T) {
+	service, err := NewFileTaskService(writeSampleFile(t), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Task 2 is started and annotated
+	uuid, err := service.Duplicate("a1b2c3d4-0000-0000-0000-000000000002")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	tasks, _ := service.Export("Write report")
+	if len(tasks) != 2 {
+		t.Fatalf("Expected the original and the copy, got %d tasks", len(tasks))
+	}
+	copied := tasks[1]
+	if copied.UUID != uuid || copied.UUID == tasks[0].UUID || copied.ID != 4 {
+		t.Errorf("Expected a new task with ID 4, got %+v", copied)
+	}
+	if copied.Start != nil || copied.Status != "pending" || copied.Project != "Work" || len(copied.Annotations) != 1 {
+		t.Errorf("Expected a pending, not started copy with the attributes, got %+v", copied)
+	}
+
+	// The copy of a completed task is pending
+	uuid, err = service.Duplicate("a1b2c3d4-0000-0000-0000-000000000004")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if tasks, _ := service.Export(uuid); len(tasks) != 1 || tasks[0].Status != "pending" || tasks[0].End != nil {
+		t.Errorf("Expected a pending copy of the completed task, got %+v", tasks)
+	}
+
+	if _, err := service.Duplicate("missing"); err == nil {
+		t.Error("Expected an error duplicating a missing task")
+	}
+}
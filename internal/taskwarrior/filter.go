@@ -0,0 +1,464 @@
+package taskwarrior
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// taskMatcher reports whether a task matches a compiled filter
+type taskMatcher func(task core.Task) bool
+
+// matchAll is the matcher for an empty filter
+func matchAll(core.Task) bool { return true }
+
+// uuidPattern matches full UUIDs and UUID prefixes of at least 8 characters
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}(-[0-9a-fA-F-]*)?$`)
+
+// filterParser compiles the subset of the Taskwarrior filter syntax that wui
+// uses (attributes with modifiers, tags, IDs, UUIDs, patterns, plain words and
+// and/or/xor/not with parentheses) into a matcher for in-memory tasks.
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+// SplitFilter splits a filter into the arguments passed to taskwarrior, on
+// whitespace outside quotes, so that project:"Home office" stays one argument.
+// The quotes are kept for taskwarrior to strip.
+func SplitFilter(filter string) []string {
+	var words []string
+	var word strings.Builder
+	var quote rune
+	inWord := false
+	for _, r := range filter {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case unicode.IsSpace(r):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+			continue
+		}
+		word.WriteRune(r)
+		inWord = true
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words
+}
+
+// parseFilter compiles a Taskwarrior filter. Adjacent terms are joined with "and".
+func parseFilter(filter string) (taskMatcher, error) {
+	filter = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(filter)
+	p := &filterParser{tokens: SplitFilter(filter)}
+	if len(p.tokens) == 0 {
+		return matchAll, nil
+	}
+
+	matcher, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in filter", p.tokens[p.pos])
+	}
+	return matcher, nil
+}
+
+// peek returns the next token in lower case, or "" at the end of the filter
+func (p *filterParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return strings.ToLower(p.tokens[p.pos])
+}
+
+func (p *filterParser) parseOr() (taskMatcher, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(); op == "or" || op == "xor"; op = p.peek() {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		if op == "or" {
+			left = func(t core.Task) bool { return l(t) || right(t) }
+		} else {
+			left = func(t core.Task) bool { return l(t) != right(t) }
+		}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (taskMatcher, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek() {
+		case "", "or", "xor", ")":
+			return left, nil
+		case "and":
+			p.pos++
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(t core.Task) bool { return l(t) && right(t) }
+	}
+}
+
+func (p *filterParser) parseUnary() (taskMatcher, error) {
+	switch p.peek() {
+	case "":
+		return nil, fmt.Errorf("unexpected end of filter")
+	case "not":
+		p.pos++
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(t core.Task) bool { return !inner(t) }, nil
+	case "(":
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing ) in filter")
+		}
+		p.pos++
+		return inner, nil
+	case ")", "and", "or", "xor":
+		return nil, fmt.Errorf("unexpected %q in filter", p.tokens[p.pos])
+	}
+	return p.parseTerm()
+}
+
+// parseTerm compiles a single filter term
+func (p *filterParser) parseTerm() (taskMatcher, error) {
+	token := p.tokens[p.pos]
+	p.pos++
+
+	// Infix pattern match: "description ~ bug", "annotations !~ wip"
+	if op := p.peek(); op == "~" || op == "!~" {
+		if p.pos+1 >= len(p.tokens) {
+			return nil, fmt.Errorf("missing value after %q in filter", op)
+		}
+		value := p.tokens[p.pos+1]
+		p.pos += 2
+		matcher := containsMatcher(strings.ToLower(token), value)
+		if op == "!~" {
+			return func(t core.Task) bool { return !matcher(t) }, nil
+		}
+		return matcher, nil
+	}
+
+	switch {
+	case len(token) > 1 && (token[0] == '+' || token[0] == '-'):
+		matcher := tagMatcher(token[1:])
+		if token[0] == '-' {
+			return func(t core.Task) bool { return !matcher(t) }, nil
+		}
+		return matcher, nil
+	case len(token) > 2 && token[0] == '/' && token[len(token)-1] == '/':
+		re, err := regexp.Compile(token[1 : len(token)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", token, err)
+		}
+		return func(t core.Task) bool { return re.MatchString(t.Description) }, nil
+	case strings.Trim(token, "0123456789,-") == "":
+		return idMatcher(token)
+	case uuidPattern.MatchString(token):
+		prefix := strings.ToLower(token)
+		return func(t core.Task) bool { return strings.HasPrefix(strings.ToLower(t.UUID), prefix) }, nil
+	}
+
+	if i := strings.IndexAny(token, ":="); i > 0 {
+		return attributeMatcher(strings.ToLower(token[:i]), unquote(token[i+1:]))
+	}
+
+	// Plain words match the description
+	return containsMatcher("description", token), nil
+}
+
+// unquote strips the quotes around an attribute value, as in project:"Home office"
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// idMatcher matches task IDs given as a list and/or range (e.g. "1,3-5")
+func idMatcher(token string) (taskMatcher, error) {
+	type idRange struct{ from, to int }
+	var ranges []idRange
+	for _, part := range strings.Split(token, ",") {
+		if part == "" {
+			continue
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		from, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID %q in filter", part)
+		}
+		to := from
+		if len(bounds) == 2 {
+			if to, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, fmt.Errorf("invalid ID range %q in filter", part)
+			}
+		}
+		ranges = append(ranges, idRange{from, to})
+	}
+	return func(t core.Task) bool {
+		for _, r := range ranges {
+			if t.ID != 0 && t.ID >= r.from && t.ID <= r.to {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// tagMatcher matches a tag, or one of the supported virtual tags (e.g. ACTIVE, WAITING)
+func tagMatcher(tag string) taskMatcher {
+	switch tag {
+	case "ACTIVE":
+		return func(t core.Task) bool { return isPending(t) && t.Start != nil }
+	case "WAITING":
+		return isWaiting
+	case "PENDING":
+		return isPending
+	case "COMPLETED", "DELETED":
+		status := strings.ToLower(tag)
+		return func(t core.Task) bool { return t.Status == status }
+	case "OVERDUE":
+		return func(t core.Task) bool { return isPending(t) && t.IsOverdue() }
+	case "TAGGED":
+		return func(t core.Task) bool { return len(t.Tags) > 0 }
+	case "BLOCKED":
+		return func(t core.Task) bool { return len(t.Depends) > 0 }
+	}
+	return func(t core.Task) bool { return hasTag(t, tag) }
+}
+
+// isWaiting reports whether a task is hidden until a future wait date
+func isWaiting(t core.Task) bool {
+	return t.Status == "waiting" || (t.Status == "pending" && t.Wait != nil && t.Wait.After(core.Now()))
+}
+
+// isPending reports whether a task is pending, including waiting tasks as Taskwarrior does
+func isPending(t core.Task) bool {
+	return t.Status == "pending" || t.Status == "waiting"
+}
+
+// statusMatcher matches a status; "active" and "waiting" are derived from the start and wait dates
+func statusMatcher(status string) taskMatcher {
+	switch status {
+	case "active":
+		return func(t core.Task) bool { return isPending(t) && t.Start != nil }
+	case "waiting":
+		return isWaiting
+	case "pending":
+		return func(t core.Task) bool { return isPending(t) && !isWaiting(t) }
+	}
+	return func(t core.Task) bool { return t.Status == status }
+}
+
+// containsMatcher matches a case-insensitive substring of an attribute value.
+// For "annotations" any annotation may match.
+func containsMatcher(attr, value string) taskMatcher {
+	value = strings.ToLower(value)
+	if attr == "annotations" || attr == "annotation" {
+		return func(t core.Task) bool {
+			for _, a := range t.Annotations {
+				if strings.Contains(strings.ToLower(a.Description), value) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return func(t core.Task) bool {
+		return strings.Contains(strings.ToLower(attributeValue(t, attr)), value)
+	}
+}
+
+// attributeValue returns the raw value of a task attribute, or "" when unset
+func attributeValue(t core.Task, attr string) string {
+	switch attr {
+	case "description":
+		return t.Description
+	case "project":
+		return t.Project
+	case "priority":
+		return t.Priority
+	case "status":
+		return t.Status
+	case "uuid":
+		return t.UUID
+	case "id":
+		if t.ID == 0 {
+			return ""
+		}
+		return strconv.Itoa(t.ID)
+	case "tags":
+		return strings.Join(t.Tags, " ")
+	}
+	if date := t.GetDateValue(attr); date != nil {
+		return date.Format("2006-01-02T15:04:05")
+	}
+	return t.GetUDA(attr)
+}
+
+// attributeMatcher compiles an "attribute[.modifier]:value" term
+func attributeMatcher(attr, value string) (taskMatcher, error) {
+	modifier := ""
+	if i := strings.Index(attr, "."); i > 0 {
+		attr, modifier = attr[:i], attr[i+1:]
+	}
+
+	if attr == "status" {
+		switch modifier {
+		case "any":
+			return matchAll, nil
+		case "", "is", "equals":
+			return statusMatcher(strings.ToLower(value)), nil
+		case "not", "isnt":
+			matcher := statusMatcher(strings.ToLower(value))
+			return func(t core.Task) bool { return !matcher(t) }, nil
+		}
+	}
+
+	if isDateAttribute(attr) && value != "" {
+		switch modifier {
+		case "", "is", "equals", "before", "below", "after", "above", "by":
+			return dateMatcher(attr, modifier, value)
+		}
+	}
+
+	lower := strings.ToLower(value)
+	equals := func(t core.Task) bool {
+		actual := strings.ToLower(attributeValue(t, attr))
+		if attr == "project" && lower != "" {
+			// Projects match their subprojects too (project:Home matches Home.Garden)
+			return actual == lower || strings.HasPrefix(actual, lower+".")
+		}
+		return actual == lower
+	}
+
+	switch modifier {
+	case "", "is", "equals":
+		return equals, nil
+	case "not", "isnt":
+		return func(t core.Task) bool { return !equals(t) }, nil
+	case "any":
+		return func(t core.Task) bool { return attributeValue(t, attr) != "" }, nil
+	case "none":
+		return func(t core.Task) bool { return attributeValue(t, attr) == "" }, nil
+	case "has", "contains":
+		return containsMatcher(attr, value), nil
+	case "hasnt":
+		matcher := containsMatcher(attr, value)
+		return func(t core.Task) bool { return !matcher(t) }, nil
+	case "startswith", "left":
+		return func(t core.Task) bool { return strings.HasPrefix(strings.ToLower(attributeValue(t, attr)), lower) }, nil
+	case "endswith", "right":
+		return func(t core.Task) bool { return strings.HasSuffix(strings.ToLower(attributeValue(t, attr)), lower) }, nil
+	}
+	return nil, fmt.Errorf("unsupported filter modifier %q", attr+"."+modifier)
+}
+
+// isDateAttribute reports whether an attribute holds a date
+func isDateAttribute(attr string) bool {
+	switch attr {
+	case "due", "scheduled", "wait", "start", "entry", "modified", "end":
+		return true
+	}
+	return false
+}
+
+// dateMatcher compiles a date comparison such as due:today or due.before:eom
+func dateMatcher(attr, modifier, value string) (taskMatcher, error) {
+	ref, err := parseDateValue(value, core.Now())
+	if err != nil {
+		return nil, err
+	}
+	return func(t core.Task) bool {
+		date := t.GetDateValue(attr)
+		if date == nil {
+			return false
+		}
+		switch modifier {
+		case "before", "below":
+			return date.Before(ref)
+		case "by":
+			return !date.After(ref)
+		case "after", "above":
+			return date.After(ref)
+		default:
+			// due:today matches any time on that day
+			return !date.Before(ref) && date.Before(ref.AddDate(0, 0, 1))
+		}
+	}, nil
+}
+
+// parseDateValue parses the date values supported in offline filters and modifications:
+// ISO dates (2026-01-31), weekday names (the next such day) and the named
+// dates now, today, sod, eod, yesterday, tomorrow, som, eom, soy and eoy
+func parseDateValue(value string, now time.Time) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(value, day.String()) {
+			days := (int(day)-int(today.Weekday())+6)%7 + 1
+			return today.AddDate(0, 0, days), nil
+		}
+	}
+	switch strings.ToLower(value) {
+	case "now":
+		return now, nil
+	case "today", "sod":
+		return today, nil
+	case "eod", "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	case "som":
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()), nil
+	case "eom":
+		return time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, now.Location()), nil
+	case "soy":
+		return time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location()), nil
+	case "eoy":
+		return time.Date(now.Year()+1, 1, 1, 0, 0, 0, 0, now.Location()), nil
+	}
+	for _, layout := range []string{"2006-01-02", "2006-01-02T15:04", "2006-01-02T15:04:05"} {
+		if date, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unsupported date %q", value)
+}
@@ -0,0 +1,106 @@
+package taskwarrior
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestParseFilter_DateModifiers(t *testing.T) {
+	now := time.Date(2026, 3, 15, 10, 0, 0, 0, time.Local)
+	core.SetNowFunc(func() time.Time { return now })
+	defer core.SetNowFunc(time.Now)
+
+	dueAt := func(date time.Time) core.Task { return core.Task{Status: "pending", Due: &date} }
+	yesterday := dueAt(now.AddDate(0, 0, -1))
+	nextWeek := dueAt(now.AddDate(0, 0, 7))
+	nextMonth := dueAt(now.AddDate(0, 1, 0))
+	noDue := core.Task{Status: "pending"}
+
+	tests := []struct {
+		filter  string
+		matches []core.Task
+		misses  []core.Task
+	}{
+		{"due.before:today", []core.Task{yesterday}, []core.Task{nextWeek, noDue}},
+		{"due.before:eom", []core.Task{yesterday, nextWeek}, []core.Task{nextMonth, noDue}},
+		{"due.after:2026-03-31", []core.Task{nextMonth}, []core.Task{yesterday, nextWeek}},
+		{"due:yesterday", []core.Task{yesterday}, []core.Task{nextWeek, noDue}},
+		{"due.any:", []core.Task{yesterday}, []core.Task{noDue}},
+		{"due.none:", []core.Task{noDue}, []core.Task{yesterday}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			matcher, err := parseFilter(tt.filter)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			for _, task := range tt.matches {
+				if !matcher(task) {
+					t.Errorf("Expected %q to match task due %v", tt.filter, task.Due)
+				}
+			}
+			for _, task := range tt.misses {
+				if matcher(task) {
+					t.Errorf("Expected %q not to match task due %v", tt.filter, task.Due)
+				}
+			}
+		})
+	}
+}
+
+func TestParseFilter_Errors(t *testing.T) {
+	for _, filter := range []string{
+		"( +bug",
+		"+bug )",
+		"or +bug",
+		"due.before:someday",
+		"project.matches:x",
+		"/[unclosed/",
+	} {
+		if _, err := parseFilter(filter); err == nil {
+			t.Errorf("Expected error for filter %q", filter)
+		}
+	}
+}
+
+func TestSplitFilter(t *testing.T) {
+	tests := []struct {
+		filter   string
+		expected []string
+	}{
+		{"status:pending  +work", []string{"status:pending", "+work"}},
+		{`project:"Home office" +errand`, []string{`project:"Home office"`, "+errand"}},
+		{"description~'buy milk'", []string{"description~'buy milk'"}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		got := SplitFilter(tt.filter)
+		if len(got) != len(tt.expected) {
+			t.Errorf("SplitFilter(%q) = %q, expected %q", tt.filter, got, tt.expected)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.expected[i] {
+				t.Errorf("SplitFilter(%q) = %q, expected %q", tt.filter, got, tt.expected)
+				break
+			}
+		}
+	}
+}
+
+func TestParseFilter_QuotedValue(t *testing.T) {
+	matcher, err := parseFilter(`project:"Home office"`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !matcher(core.Task{Status: "pending", Project: "Home office"}) {
+		t.Error("Expected the quoted project to match")
+	}
+	if matcher(core.Task{Status: "pending", Project: "Home"}) {
+		t.Error("Expected another project not to match")
+	}
+}
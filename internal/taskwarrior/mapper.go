@@ -2,6 +2,7 @@ package taskwarrior
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/clobrano/wui/internal/core"
@@ -69,6 +70,8 @@ func MapToCore(t TaskwarriorTask) core.Task {
 			coreTask.UDAs[key] = fmt.Sprintf("%v", value)
 		}
 	}
+	coreTask.Recur = coreTask.UDAs["recur"]
+	coreTask.Parent = coreTask.UDAs["parent"]
 
 	return coreTask
 }
@@ -89,3 +92,53 @@ func parseTaskwarriorDate(dateStr string) (*time.Time, error) {
 
 	return &tm, nil
 }
+
+// MapFromCore converts a core.Task back to a TaskwarriorTask, the inverse of MapToCore.
+// Numeric UDA values are restored as numbers.
+func MapFromCore(t core.Task) TaskwarriorTask {
+	twTask := TaskwarriorTask{
+		ID:          t.ID,
+		UUID:        t.UUID,
+		Description: t.Description,
+		Project:     t.Project,
+		Tags:        t.Tags,
+		Priority:    t.Priority,
+		Status:      t.Status,
+		Due:         formatTaskwarriorDate(t.Due),
+		Scheduled:   formatTaskwarriorDate(t.Scheduled),
+		Wait:        formatTaskwarriorDate(t.Wait),
+		Start:       formatTaskwarriorDate(t.Start),
+		Entry:       formatTaskwarriorDate(&t.Entry),
+		Modified:    formatTaskwarriorDate(t.Modified),
+		End:         formatTaskwarriorDate(t.End),
+		Depends:     t.Depends,
+		Urgency:     t.Urgency,
+	}
+
+	for _, a := range t.Annotations {
+		twTask.Annotations = append(twTask.Annotations, TaskwarriorAnnotation{
+			Entry:       formatTaskwarriorDate(&a.Entry),
+			Description: a.Description,
+		})
+	}
+
+	twTask.UDA = make(map[string]interface{}, len(t.UDAs))
+	for key, value := range t.UDAs {
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			twTask.UDA[key] = number
+		} else {
+			twTask.UDA[key] = value
+		}
+	}
+
+	return twTask
+}
+
+// formatTaskwarriorDate formats a date in the Taskwarrior format (20251016T120000Z).
+// Returns an empty string for nil or zero dates.
+func formatTaskwarriorDate(date *time.Time) string {
+	if date == nil || date.IsZero() {
+		return ""
+	}
+	return date.UTC().Format("20060102T150405Z")
+}
@@ -239,6 +239,34 @@ func TestParseTaskwarriorDate_InvalidFormat(t *testing.T) {
 	}
 }
 
+func TestMapToCore_Recurrence(t *testing.T) {
+	tw := TaskwarriorTask{
+		UUID:        "abc-123",
+		Description: "Water plants",
+		Status:      "pending",
+		Entry:       "20251016T120000Z",
+		UDA: map[string]interface{}{
+			"recur":  "weekly",
+			"parent": "template-uuid",
+		},
+	}
+
+	coreTask := MapToCore(tw)
+	if coreTask.Recur != "weekly" || coreTask.Parent != "template-uuid" {
+		t.Errorf("Expected recur and parent to be mapped, got %q and %q", coreTask.Recur, coreTask.Parent)
+	}
+	if !coreTask.IsRecurringInstance() {
+		t.Error("Expected a task with a parent to be a recurring instance")
+	}
+
+	// Templates have a period but no parent
+	delete(tw.UDA, "parent")
+	tw.Status = "recurring"
+	if coreTask := MapToCore(tw); coreTask.IsRecurringInstance() {
+		t.Error("Expected a template not to be a recurring instance")
+	}
+}
+
 func TestMapToCore_WithUDAs(t *testing.T) {
 	tw := TaskwarriorTask{
 		UUID:        "abc-123",
@@ -0,0 +1,189 @@
+package taskwarrior
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// attributeNamePattern matches the name in an "attribute:value" modification
+var attributeNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// modification is a parsed Taskwarrior add/modify argument list
+type modification struct {
+	words      []string          // Plain words, forming the (new) description
+	attributes map[string]string // attribute:value pairs; an empty value clears the attribute
+	addTags    []string
+	removeTags []string
+}
+
+// splitModifications splits add/modify arguments on whitespace, e.g. "project:home +duties"
+// becomes ["project:home", "+duties"]. A cleared attribute ("priority:") stays a
+// token of its own. Empty quotes typed out of shell habit, as in due:"", are
+// reduced to the bare form, since no shell runs to strip them.
+func splitModifications(modifications string) []string {
+	tokens := strings.Fields(modifications)
+	for i, token := range tokens {
+		if name, ok := strings.CutSuffix(token, `:""`); ok {
+			tokens[i] = name + ":"
+		} else if name, ok := strings.CutSuffix(token, ":''"); ok {
+			tokens[i] = name + ":"
+		}
+	}
+	return tokens
+}
+
+// parseModification splits modifications such as "Buy milk project:home +shopping due:tomorrow"
+// into description words, attributes and tag changes
+func parseModification(modifications string) modification {
+	mod := modification{attributes: make(map[string]string)}
+	for _, token := range splitModifications(modifications) {
+		switch {
+		case len(token) > 1 && token[0] == '+':
+			mod.addTags = append(mod.addTags, token[1:])
+			continue
+		case len(token) > 1 && token[0] == '-':
+			mod.removeTags = append(mod.removeTags, token[1:])
+			continue
+		}
+		if i := strings.Index(token, ":"); i > 0 && attributeNamePattern.MatchString(token[:i]) && !strings.HasPrefix(token[i+1:], "/") {
+			mod.attributes[strings.ToLower(token[:i])] = token[i+1:]
+			continue
+		}
+		mod.words = append(mod.words, token)
+	}
+	return mod
+}
+
+// apply applies the modification to a task. IDs in depends are resolved with resolveID.
+func (mod modification) apply(task *core.Task, resolveID func(id int) (string, bool)) error {
+	if len(mod.words) > 0 {
+		task.Description = strings.Join(mod.words, " ")
+	}
+
+	for name, value := range mod.attributes {
+		switch name {
+		case "description":
+			task.Description = value
+		case "project":
+			task.Project = value
+		case "priority":
+			task.Priority = strings.ToUpper(value)
+		case "depends":
+			depends, err := parseDepends(value, resolveID)
+			if err != nil {
+				return err
+			}
+			task.Depends = depends
+		case "due", "scheduled", "wait", "start", "end":
+			if err := setDate(task, name, value); err != nil {
+				return err
+			}
+		case "status", "uuid", "id", "entry", "modified", "urgency":
+			return fmt.Errorf("attribute %q cannot be modified", name)
+		default:
+			if task.UDAs == nil {
+				task.UDAs = make(map[string]string)
+			}
+			if value == "" {
+				delete(task.UDAs, name)
+			} else {
+				task.UDAs[name] = value
+			}
+		}
+	}
+
+	for _, tag := range mod.addTags {
+		if !hasTag(*task, tag) {
+			task.Tags = append(task.Tags, tag)
+		}
+	}
+	for _, tag := range mod.removeTags {
+		tags := task.Tags[:0]
+		for _, t := range task.Tags {
+			if t != tag {
+				tags = append(tags, t)
+			}
+		}
+		task.Tags = tags
+	}
+
+	// A wait date in the future hides the task, one in the past releases it
+	if task.Status == "pending" || task.Status == "waiting" {
+		task.Status = "pending"
+		if isWaiting(*task) {
+			task.Status = "waiting"
+		}
+	}
+	return nil
+}
+
+// setDate sets (or clears, for an empty value) a date attribute
+func setDate(task *core.Task, name, value string) error {
+	if value == "" {
+		switch name {
+		case "due":
+			task.Due = nil
+		case "scheduled":
+			task.Scheduled = nil
+		case "wait":
+			task.Wait = nil
+		case "start":
+			task.Start = nil
+		case "end":
+			task.End = nil
+		}
+		return nil
+	}
+
+	parsed, err := parseDateValue(value, core.Now())
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	parsed = parsed.UTC()
+	switch name {
+	case "due":
+		task.Due = &parsed
+	case "scheduled":
+		task.Scheduled = &parsed
+	case "wait":
+		task.Wait = &parsed
+	case "start":
+		task.Start = &parsed
+	case "end":
+		task.End = &parsed
+	}
+	return nil
+}
+
+// parseDepends parses a comma-separated list of task IDs and UUIDs
+func parseDepends(value string, resolveID func(id int) (string, bool)) ([]string, error) {
+	depends := []string{}
+	for _, dep := range strings.Split(value, ",") {
+		if dep == "" {
+			continue
+		}
+		if id, err := strconv.Atoi(dep); err == nil {
+			uuid, ok := resolveID(id)
+			if !ok {
+				return nil, fmt.Errorf("no task with ID %d", id)
+			}
+			dep = uuid
+		}
+		depends = append(depends, dep)
+	}
+	return depends, nil
+}
+
+// hasTag reports whether a task has a tag
+func hasTag(task core.Task, tag string) bool {
+	for _, t := range task.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package taskwarrior
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseModification(t *testing.T) {
+	mod := parseModification("Buy milk at 10:30 project:home +shopping -work due: https://example.com priority:H")
+
+	expectedWords := []string{"Buy", "milk", "at", "10:30", "https://example.com"}
+	if !reflect.DeepEqual(mod.words, expectedWords) {
+		t.Errorf("Expected words %v, got %v", expectedWords, mod.words)
+	}
+	expectedAttributes := map[string]string{"project": "home", "due": "", "priority": "H"}
+	if !reflect.DeepEqual(mod.attributes, expectedAttributes) {
+		t.Errorf("Expected attributes %v, got %v", expectedAttributes, mod.attributes)
+	}
+	if !reflect.DeepEqual(mod.addTags, []string{"shopping"}) || !reflect.DeepEqual(mod.removeTags, []string{"work"}) {
+		t.Errorf("Unexpected tag changes: +%v -%v", mod.addTags, mod.removeTags)
+	}
+}
+
+func TestSplitModifications(t *testing.T) {
+	tests := []struct {
+		modifications string
+		expected      []string
+	}{
+		{"priority:", []string{"priority:"}},
+		{"project:home  +duties", []string{"project:home", "+duties"}},
+		{`due:"" priority:''`, []string{"due:", "priority:"}},
+		{`Say "" twice`, []string{"Say", `""`, "twice"}},
+		{"", []string{}},
+	}
+
+	for _, tt := range tests {
+		if got := splitModifications(tt.modifications); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("splitModifications(%q) = %q, expected %q", tt.modifications, got, tt.expected)
+		}
+	}
+
+	// Quoted clears reach the file service as cleared attributes
+	mod := parseModification(`due:"" priority:''`)
+	if expected := map[string]string{"due": "", "priority": ""}; !reflect.DeepEqual(mod.attributes, expected) {
+		t.Errorf("Expected cleared attributes %v, got %v", expected, mod.attributes)
+	}
+}
@@ -3,6 +3,7 @@ package taskwarrior
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 )
 
 // TaskwarriorTask represents a task as returned by Taskwarrior JSON export
@@ -84,6 +85,26 @@ func (t *TaskwarriorTask) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements custom JSON marshaling that writes UDA fields back as top-level fields
+func (t TaskwarriorTask) MarshalJSON() ([]byte, error) {
+	type Alias TaskwarriorTask
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.UDA) == 0 {
+		return data, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range t.UDA {
+		if _, exists := fields[key]; !exists {
+			fields[key] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
 // TaskwarriorAnnotation represents an annotation in Taskwarrior format
 type TaskwarriorAnnotation struct {
 	Entry       string `json:"entry"`
@@ -106,3 +127,125 @@ func ParseTaskJSON(jsonBytes []byte) ([]TaskwarriorTask, error) {
 
 	return tasks, nil
 }
+
+// EntryError reports a task of a Taskwarrior export that could not be decoded
+type EntryError struct {
+	Index int    // Position of the task in the export
+	UUID  string // UUID of the task, when it could be read
+	Err   error
+}
+
+func (e *EntryError) Error() string {
+	if e.UUID != "" {
+		return fmt.Sprintf("task %d (%s): %v", e.Index, e.UUID, e.Err)
+	}
+	return fmt.Sprintf("task %d: %v", e.Index, e.Err)
+}
+
+func (e *EntryError) Unwrap() error {
+	return e.Err
+}
+
+// ParseTaskJSONLenient parses Taskwarrior JSON export output like ParseTaskJSON, but
+// skips the tasks that cannot be decoded instead of failing, so one malformed task
+// does not hide the others. The skipped tasks are returned as EntryErrors.
+// It only fails when the output is not a JSON array.
+func ParseTaskJSONLenient(jsonBytes []byte) ([]TaskwarriorTask, []error, error) {
+	// Handle empty input
+	if len(jsonBytes) == 0 {
+		return []TaskwarriorTask{}, nil, nil
+	}
+
+	var entries []json.RawMessage
+	if err := json.Unmarshal(jsonBytes, &entries); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal task JSON: %w", err)
+	}
+
+	tasks := make([]TaskwarriorTask, 0, len(entries))
+	var entryErrs []error
+	for i, entry := range entries {
+		var task TaskwarriorTask
+		if err := json.Unmarshal(entry, &task); err != nil {
+			var id struct {
+				UUID string `json:"uuid"`
+			}
+			_ = json.Unmarshal(entry, &id)
+			entryErrs = append(entryErrs, &EntryError{Index: i, UUID: id.UUID, Err: err})
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, entryErrs, nil
+}
+
+// StreamTaskJSON decodes a Taskwarrior JSON export from r one task at a time and
+// passes each task to fn, so the whole export never has to be held in memory.
+// Decoding stops after limit tasks when limit is positive, or when fn returns false.
+// It returns the number of tasks passed to fn.
+func StreamTaskJSON(r io.Reader, limit int, fn func(TaskwarriorTask) bool) (int, error) {
+	return streamTaskJSON(r, limit, fn, nil)
+}
+
+// StreamTaskJSONLenient decodes a Taskwarrior JSON export like StreamTaskJSON, but
+// skips the tasks that cannot be decoded instead of failing, like ParseTaskJSONLenient.
+// The skipped tasks are returned as EntryErrors and are not counted.
+func StreamTaskJSONLenient(r io.Reader, limit int, fn func(TaskwarriorTask) bool) (int, []error, error) {
+	var entryErrs []error
+	count, err := streamTaskJSON(r, limit, fn, func(entryErr error) {
+		entryErrs = append(entryErrs, entryErr)
+	})
+	return count, entryErrs, err
+}
+
+// streamTaskJSON decodes the tasks of an export from r. Tasks that cannot be
+// decoded are passed to skip when it is set, and fail the decoding otherwise.
+func streamTaskJSON(r io.Reader, limit int, fn func(TaskwarriorTask) bool, skip func(error)) (int, error) {
+	dec := json.NewDecoder(r)
+
+	// Handle empty input
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to unmarshal task JSON: %w", err)
+	}
+	if tok == nil {
+		return 0, nil // null, as accepted by ParseTaskJSON
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return 0, fmt.Errorf("failed to unmarshal task JSON: expected an array, got %v", tok)
+	}
+
+	count := 0
+	for index := 0; dec.More(); index++ {
+		if limit > 0 && count >= limit {
+			return count, nil
+		}
+		var entry json.RawMessage
+		if err := dec.Decode(&entry); err != nil {
+			return count, fmt.Errorf("failed to unmarshal task JSON: %w", err)
+		}
+		var task TaskwarriorTask
+		if err := json.Unmarshal(entry, &task); err != nil {
+			if skip == nil {
+				return count, fmt.Errorf("failed to unmarshal task JSON: %w", err)
+			}
+			var id struct {
+				UUID string `json:"uuid"`
+			}
+			_ = json.Unmarshal(entry, &id)
+			skip(&EntryError{Index: index, UUID: id.UUID, Err: err})
+			continue
+		}
+		count++
+		if !fn(task) {
+			return count, nil
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return count, fmt.Errorf("failed to unmarshal task JSON: %w", err)
+	}
+	return count, nil
+}
@@ -1,6 +1,9 @@
 package taskwarrior
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -261,3 +264,196 @@ func TestParseTaskJSON_WithUDAs(t *testing.T) {
 		t.Error("status should not be in UDA map (it's explicitly mapped)")
 	}
 }
+
+// syntheticExport returns a Taskwarrior export of n tasks with a UDA each
+func syntheticExport(n int) string {
+	var b strings.Builder
+	b.WriteString("[\n")
+	for i := range n {
+		if i > 0 {
+			b.WriteString(",\n")
+		}
+		fmt.Fprintf(&b, `{"id":%d,"uuid":"uuid-%d","description":"Task %d","status":"pending","entry":"20240101T120000Z","tags":["bulk"],"estimate":"%dh","urgency":%d.5}`,
+			i+1, i, i, i%8, i%10)
+	}
+	b.WriteString("\n]\n")
+	return b.String()
+}
+
+func TestStreamTaskJSON_Large(t *testing.T) {
+	const n = 20000
+	var last TaskwarriorTask
+	count, err := StreamTaskJSON(strings.NewReader(syntheticExport(n)), 0, func(task TaskwarriorTask) bool {
+		last = task
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if count != n {
+		t.Errorf("Expected %d tasks, got %d", n, count)
+	}
+	if last.UUID != fmt.Sprintf("uuid-%d", n-1) || last.ID != n {
+		t.Errorf("Expected the last task to be decoded, got %+v", last)
+	}
+	if last.UDA["estimate"] != fmt.Sprintf("%dh", (n-1)%8) {
+		t.Errorf("Expected UDAs to be captured, got %v", last.UDA)
+	}
+
+	// Matches the non-streaming parser
+	tasks, err := ParseTaskJSON([]byte(syntheticExport(n)))
+	if err != nil || len(tasks) != n || tasks[n-1].UUID != last.UUID {
+		t.Errorf("Expected ParseTaskJSON to agree, got %d tasks (err %v)", len(tasks), err)
+	}
+}
+
+func TestStreamTaskJSON_Limit(t *testing.T) {
+	var uuids []string
+	count, err := StreamTaskJSON(strings.NewReader(syntheticExport(1000)), 3, func(task TaskwarriorTask) bool {
+		uuids = append(uuids, task.UUID)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if count != 3 || strings.Join(uuids, ",") != "uuid-0,uuid-1,uuid-2" {
+		t.Errorf("Expected the first 3 tasks, got %d: %v", count, uuids)
+	}
+
+	// Stopping from the callback
+	count, err = StreamTaskJSON(strings.NewReader(syntheticExport(1000)), 0, func(task TaskwarriorTask) bool {
+		return task.UUID != "uuid-4"
+	})
+	if err != nil || count != 5 {
+		t.Errorf("Expected to stop after 5 tasks, got %d (err %v)", count, err)
+	}
+
+	// Decoding stops before malformed data past the limit
+	count, err = StreamTaskJSON(strings.NewReader(`[{"uuid":"a"},{"uuid":"b"},{broken`), 2, func(TaskwarriorTask) bool { return true })
+	if err != nil || count != 2 {
+		t.Errorf("Expected 2 tasks and no error, got %d (err %v)", count, err)
+	}
+}
+
+func TestStreamTaskJSON_EdgeCases(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		count   int
+		wantErr bool
+	}{
+		{"empty input", "", 0, false},
+		{"empty array", "[]", 0, false},
+		{"null", "null", 0, false},
+		{"not an array", `{"uuid":"a"}`, 0, true},
+		{"invalid JSON", "{invalid json}", 0, true},
+		{"truncated", `[{"uuid":"a"},{"uuid":`, 1, true},
+		{"unterminated array", `[{"uuid":"a"}`, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := StreamTaskJSON(strings.NewReader(tt.input), 0, func(TaskwarriorTask) bool { return true })
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if count != tt.count {
+				t.Errorf("Expected %d tasks, got %d", tt.count, count)
+			}
+		})
+	}
+}
+
+func BenchmarkParseTaskJSON(b *testing.B) {
+	data := []byte(syntheticExport(10000))
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := ParseTaskJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStreamTaskJSON(b *testing.B) {
+	data := syntheticExport(10000)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := StreamTaskJSON(strings.NewReader(data), 0, func(TaskwarriorTask) bool { return true }); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// exportWithBrokenTask has a task whose tags are not a list
+const exportWithBrokenTask = `[
+	{"uuid": "a", "description": "First", "status": "pending", "entry": "20240101T120000Z"},
+	{"uuid": "b", "description": "Broken", "status": "pending", "tags": "oops", "entry": "20240101T120000Z"},
+	{"uuid": "c", "description": "Third", "status": "pending", "entry": "20240101T120000Z", "urgency": "high"},
+	{"uuid": "d", "description": "Fourth", "status": "pending", "entry": "20240101T120000Z"}
+]`
+
+func TestParseTaskJSONLenient(t *testing.T) {
+	tasks, entryErrs, err := ParseTaskJSONLenient([]byte(exportWithBrokenTask))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].UUID != "a" || tasks[1].UUID != "d" {
+		t.Errorf("Expected the valid tasks a and d, got %+v", tasks)
+	}
+	if len(entryErrs) != 2 {
+		t.Fatalf("Expected 2 entry errors, got %v", entryErrs)
+	}
+	var entryErr *EntryError
+	if !errors.As(entryErrs[0], &entryErr) || entryErr.Index != 1 || entryErr.UUID != "b" {
+		t.Errorf("Expected the error of task 1 (b), got %v", entryErrs[0])
+	}
+	if !strings.Contains(entryErrs[1].Error(), "task 2 (c)") {
+		t.Errorf("Expected the error to name task 2, got %q", entryErrs[1].Error())
+	}
+
+	// The strict parser fails on the same input
+	if _, err := ParseTaskJSON([]byte(exportWithBrokenTask)); err == nil {
+		t.Error("Expected ParseTaskJSON to fail")
+	}
+}
+
+func TestStreamTaskJSONLenient(t *testing.T) {
+	var uuids []string
+	count, entryErrs, err := StreamTaskJSONLenient(strings.NewReader(exportWithBrokenTask), 0, func(task TaskwarriorTask) bool {
+		uuids = append(uuids, task.UUID)
+		return true
+	})
+	if err != nil || count != 2 || strings.Join(uuids, ",") != "a,d" {
+		t.Errorf("Expected the valid tasks a and d, got %v (count %d, err %v)", uuids, count, err)
+	}
+	var entryErr *EntryError
+	if len(entryErrs) != 2 || !errors.As(entryErrs[0], &entryErr) || entryErr.Index != 1 || entryErr.UUID != "b" {
+		t.Errorf("Expected the errors of tasks b and c, got %v", entryErrs)
+	}
+
+	// The limit counts the decoded tasks only
+	count, _, err = StreamTaskJSONLenient(strings.NewReader(exportWithBrokenTask), 2, func(TaskwarriorTask) bool { return true })
+	if err != nil || count != 2 {
+		t.Errorf("Expected 2 tasks, got %d (err %v)", count, err)
+	}
+
+	// Broken JSON still fails, and the strict decoder fails on the malformed task
+	if _, _, err := StreamTaskJSONLenient(strings.NewReader(`[{"uuid": "a"}, {broken`), 0, func(TaskwarriorTask) bool { return true }); err == nil {
+		t.Error("Expected an error for broken JSON")
+	}
+	if _, err := StreamTaskJSON(strings.NewReader(exportWithBrokenTask), 0, func(TaskwarriorTask) bool { return true }); err == nil {
+		t.Error("Expected StreamTaskJSON to fail")
+	}
+}
+
+func TestParseTaskJSONLenient_Invalid(t *testing.T) {
+	for _, input := range []string{`[{"uuid": "a"}, {broken`, `{"uuid": "a"}`} {
+		if _, _, err := ParseTaskJSONLenient([]byte(input)); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+	tasks, entryErrs, err := ParseTaskJSONLenient(nil)
+	if err != nil || len(tasks) != 0 || entryErrs != nil {
+		t.Errorf("Expected no tasks for empty input, got %v %v %v", tasks, entryErrs, err)
+	}
+}
@@ -0,0 +1,129 @@
+package taskwarrior
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// undoChange is an attribute of a task as it is before and after the last operation
+type undoChange struct {
+	name    string
+	prior   string // Value before the operation (empty when not set)
+	current string // Value after the operation (empty when not set)
+}
+
+// parseUndoOutput extracts the attributes shown by "task undo" with rc.undo.style=side:
+//
+//	The last modification was made 2025-06-01
+//
+//	             Prior Values  Current Values
+//	description  Pay rent      Pay rent
+//	priority     H             M
+//
+// Values wrapped over several lines are joined with a space.
+func parseUndoOutput(output []byte) ([]undoChange, error) {
+	var changes []undoChange
+	priorCol, currentCol := -1, -1
+
+	for _, line := range strings.Split(string(output), "\n") {
+		runes := []rune(strings.TrimRight(line, " \r"))
+
+		if priorCol < 0 {
+			prior := strings.Index(line, "Prior Values")
+			current := strings.Index(line, "Current Values")
+			if prior >= 0 && current > prior {
+				priorCol = len([]rune(line[:prior]))
+				currentCol = len([]rune(line[:current]))
+			}
+			continue
+		}
+
+		if strings.Trim(string(runes), "- ") == "" {
+			// Blank lines and header underlines
+			if len(changes) > 0 && len(runes) == 0 {
+				break
+			}
+			continue
+		}
+
+		name := strings.TrimSpace(column(runes, 0, priorCol))
+		prior := strings.TrimSpace(column(runes, priorCol, currentCol))
+		current := strings.TrimSpace(column(runes, currentCol, len(runes)))
+		if name == "" {
+			if len(changes) == 0 {
+				continue
+			}
+			// Continuation of the previous attribute
+			last := &changes[len(changes)-1]
+			last.prior = strings.TrimSpace(last.prior + " " + prior)
+			last.current = strings.TrimSpace(last.current + " " + current)
+			continue
+		}
+		changes = append(changes, undoChange{name: name, prior: prior, current: current})
+	}
+
+	if len(changes) == 0 {
+		return nil, errors.New("no undo information found")
+	}
+	return changes, nil
+}
+
+// column returns runes[from:to], clamped to the line
+func column(runes []rune, from, to int) string {
+	from = min(from, len(runes))
+	to = min(to, len(runes))
+	return string(runes[from:to])
+}
+
+// summarizeUndo describes what undoing the last operation reverts, e.g.
+// `modify "Pay rent": priority H→M`
+func summarizeUndo(changes []undoChange) (string, error) {
+	var description, priorStatus, currentStatus string
+	added := true
+	for _, change := range changes {
+		switch change.name {
+		case "description":
+			description = change.current
+			if description == "" {
+				description = change.prior
+			}
+		case "status":
+			priorStatus = strings.ToLower(change.prior)
+			currentStatus = strings.ToLower(change.current)
+		}
+		if change.prior != "" {
+			added = false
+		}
+	}
+	task := fmt.Sprintf("%q", description)
+
+	switch {
+	case added:
+		return "add " + task, nil
+	case currentStatus != priorStatus && currentStatus == "deleted":
+		return "delete " + task, nil
+	case currentStatus != priorStatus && currentStatus == "completed":
+		return "complete " + task, nil
+	}
+
+	var modified []string
+	for _, change := range changes {
+		if change.prior == change.current || change.name == "modified" {
+			continue
+		}
+		modified = append(modified, fmt.Sprintf("%s %s→%s", change.name, valueOrNone(change.prior), valueOrNone(change.current)))
+	}
+	if len(modified) == 0 {
+		return "", errors.New("the last operation changed nothing")
+	}
+	return "modify " + task + ": " + strings.Join(modified, ", "), nil
+}
+
+// valueOrNone shows unset values in undo summaries
+func valueOrNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
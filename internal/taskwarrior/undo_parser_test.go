@@ -0,0 +1,144 @@
+package taskwarrior
+
+import "testing"
+
+const sampleUndoOutput = `The last modification was made 2026-06-01
+
+             Prior Values                        Current Values
+description  Pay rent                            Pay rent
+entry        2026-06-01 10:00:00 (3 hours)       2026-06-01 10:00:00 (3 hours)
+modified     2026-06-01 10:00:00 (3 hours)       2026-06-01 13:00:00 (2 seconds)
+priority     H                                   M
+status       Pending                             Pending
+tags         home bills                          home bills
+             monthly                             monthly
+uuid         a1b2c3d4-0000-0000-0000-000000000001 a1b2c3d4-0000-0000-0000-000000000001
+
+The undo command is not reversible.  Are you sure you want to revert to the previous state? (yes/no)
+Task not reverted.
+`
+
+func TestParseUndoOutput(t *testing.T) {
+	changes, err := parseUndoOutput([]byte(sampleUndoOutput))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(changes) != 7 {
+		t.Fatalf("Expected 7 attributes, got %d: %+v", len(changes), changes)
+	}
+	if expected := (undoChange{name: "priority", prior: "H", current: "M"}); changes[3] != expected {
+		t.Errorf("Expected %+v, got %+v", expected, changes[3])
+	}
+	if expected := (undoChange{name: "tags", prior: "home bills monthly", current: "home bills monthly"}); changes[5] != expected {
+		t.Errorf("Expected wrapped values to be joined, got %+v", changes[5])
+	}
+
+	if _, err := parseUndoOutput([]byte("No undo information available.\n")); err == nil {
+		t.Error("Expected error without the undo table")
+	}
+}
+
+func TestSummarizeUndo(t *testing.T) {
+	tests := []struct {
+		name     string
+		changes  []undoChange
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "modify",
+			changes: []undoChange{
+				{"description", "Pay rent", "Pay rent"},
+				{"due", "", "2026-06-05"},
+				{"modified", "10:00", "13:00"},
+				{"priority", "H", "M"},
+			},
+			expected: `modify "Pay rent": due none→2026-06-05, priority H→M`,
+		},
+		{
+			name:     "add",
+			changes:  []undoChange{{"description", "", "Pay rent"}, {"status", "", "Pending"}},
+			expected: `add "Pay rent"`,
+		},
+		{
+			name:     "delete",
+			changes:  []undoChange{{"description", "Pay rent", "Pay rent"}, {"status", "Pending", "Deleted"}},
+			expected: `delete "Pay rent"`,
+		},
+		{
+			name:     "done",
+			changes:  []undoChange{{"description", "Pay rent", "Pay rent"}, {"end", "", "now"}, {"status", "pending", "completed"}},
+			expected: `complete "Pay rent"`,
+		},
+		{
+			name:    "only modified",
+			changes: []undoChange{{"description", "Pay rent", "Pay rent"}, {"modified", "10:00", "13:00"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary, err := summarizeUndo(tt.changes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if summary != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, summary)
+			}
+		})
+	}
+}
+
+func TestClientUndoPreview(t *testing.T) {
+	client := &Client{taskBin: fakeTaskBin(t, sampleUndoOutput)}
+	summary, err := client.UndoPreview()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := `modify "Pay rent": priority H→M`; summary != expected {
+		t.Errorf("Expected %q, got %q", expected, summary)
+	}
+
+	client = &Client{taskBin: fakeTaskBin(t, "No undo information available.\n")}
+	if _, err := client.UndoPreview(); err == nil {
+		t.Error("Expected error without undo information")
+	}
+}
+
+func TestFileTaskServiceUndoPreview(t *testing.T) {
+	service, err := NewFileTaskService(writeSampleFile(t), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.UndoPreview(); err == nil {
+		t.Error("Expected error with nothing to undo")
+	}
+
+	const uuid = "a1b2c3d4-0000-0000-0000-000000000001"
+	steps := []struct {
+		change   func() error
+		expected string
+	}{
+		{func() error { return service.Modify(uuid, "priority:M") }, `modify "Fix login crash": priority H→M`},
+		{func() error { return service.Done(uuid) }, `complete "Fix login crash"`},
+		{func() error { _, err := service.Add("Call mom"); return err }, `add "Call mom"`},
+	}
+	for _, step := range steps {
+		if err := step.change(); err != nil {
+			t.Fatal(err)
+		}
+		summary, err := service.UndoPreview()
+		if err != nil || summary != step.expected {
+			t.Errorf("Expected %q, got %q (err %v)", step.expected, summary, err)
+		}
+	}
+
+	// The preview describes the change Undo reverts
+	if err := service.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if summary, _ := service.UndoPreview(); summary != `complete "Fix login crash"` {
+		t.Errorf("Expected the previous change after undo, got %q", summary)
+	}
+}
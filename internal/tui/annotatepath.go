@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// readClipboard reads the clipboard text; replaced in tests
+var readClipboard = clipboard.ReadAll
+
+// fileURL returns path as a file:// URL, escaping spaces and other characters
+// that the annotation file path parser decodes back
+func fileURL(path string) string {
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
+
+// annotationPath picks the path to attach to a task: the clipboard content when
+// it names an existing absolute path (or file:// URL), otherwise the working directory
+func annotationPath(clipboardText, cwd string) string {
+	candidate := strings.TrimSpace(clipboardText)
+	if strings.HasPrefix(candidate, "file://") {
+		if parsed, err := url.Parse(candidate); err == nil {
+			candidate = parsed.Path
+		}
+	}
+	if candidate != "" && !strings.Contains(candidate, "\n") && filepath.IsAbs(candidate) {
+		if _, err := os.Stat(candidate); err == nil {
+			return filepath.Clean(candidate)
+		}
+	}
+	return cwd
+}
+
+// startPathAnnotation opens the annotate input pre-filled with the file:// URL of
+// the path in the clipboard or of the working directory
+func (m Model) startPathAnnotation() (tea.Model, tea.Cmd) {
+	if len(m.taskList.GetSelectedTasks()) == 0 {
+		return m, nil
+	}
+
+	clipboardText, _ := readClipboard()
+	cwd, err := os.Getwd()
+	path := annotationPath(clipboardText, cwd)
+	if path == "" {
+		if err != nil {
+			m.errorMessage = err.Error()
+		}
+		return m, nil
+	}
+
+	m.state = StateAnnotateInput
+	m.annotateInput.SetValue(fileURL(path) + " ")
+	m.updateComponentSizes()
+	return m, m.annotateInput.Focus()
+}
@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestFileURL(t *testing.T) {
+	tests := map[string]string{
+		"/home/user/project":       "file:///home/user/project",
+		"/home/user/my docs/a.txt": "file:///home/user/my%20docs/a.txt",
+	}
+	for path, expected := range tests {
+		if got := fileURL(path); got != expected {
+			t.Errorf("fileURL(%q) = %q, expected %q", path, got, expected)
+		}
+		// The annotation parser finds the path again
+		if paths := extractFilePathsFromText("see " + fileURL(path)); len(paths) != 1 || paths[0] != path {
+			t.Errorf("Expected %q to be extracted back, got %v", path, paths)
+		}
+	}
+}
+
+func TestAnnotationPath(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "notes.md")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		clipboard string
+		expected  string
+	}{
+		{"empty clipboard", "", "/work"},
+		{"existing path", "  " + file + "\n", file},
+		{"file URL", fileURL(file), file},
+		{"missing path", filepath.Join(dir, "missing"), "/work"},
+		{"relative path", "notes.md", "/work"},
+		{"other text", "buy milk", "/work"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := annotationPath(tt.clipboard, "/work"); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// stubClipboard makes readClipboard return text for the rest of the test
+func stubClipboard(t *testing.T, text string) {
+	t.Helper()
+	original := readClipboard
+	readClipboard = func() (string, error) { return text, nil }
+	t.Cleanup(func() { readClipboard = original })
+}
+
+func TestAnnotatePathKey(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "my project")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+	stubClipboard(t, "not a path")
+	cwd, _ := os.Getwd()
+
+	model := createTestModel(&core.MockTaskService{})
+	model = pressKey(t, model, "F")
+	if model.state != StateAnnotateInput {
+		t.Fatalf("Expected the annotate input, got state %v", model.state)
+	}
+	if got, expected := model.annotateInput.Value(), fileURL(cwd)+" "; got != expected {
+		t.Errorf("Expected the annotation to be pre-filled with %q, got %q", expected, got)
+	}
+}
+
+func TestAnnotatePathKeyFromClipboard(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "report.pdf")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	stubClipboard(t, file)
+
+	model := createTestModel(&core.MockTaskService{})
+	model = pressKey(t, model, "F")
+	if got, expected := model.annotateInput.Value(), fileURL(file)+" "; got != expected {
+		t.Errorf("Expected the clipboard path, got %q (expected %q)", got, expected)
+	}
+}
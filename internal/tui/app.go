@@ -15,7 +15,7 @@ func Run(service core.TaskService, cfg *config.Config) error {
 	model := NewModel(service, cfg)
 
 	// Create the program
-	p := tea.NewProgram(model, tea.WithAltScreen())
+	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithOutput(programOutput))
 
 	// Run the program
 	finalModel, err := p.Run()
@@ -25,6 +25,11 @@ func Run(service core.TaskService, cfg *config.Config) error {
 
 	// Print warnings after TUI has exited (if any)
 	if m, ok := finalModel.(Model); ok {
+		// Remember the active tab and filters for the next session
+		if cfg.StatePath != "" {
+			m.saveState(cfg.StatePath)
+		}
+
 		// Print shortcut override warnings (unless silenced in config)
 		silenceWarnings := m.config != nil && m.config.TUI != nil && m.config.TUI.SilenceShortcutOverrideWarnings
 		if len(m.shortcutWarnings) > 0 && !silenceWarnings {
@@ -45,7 +50,7 @@ func Run(service core.TaskService, cfg *config.Config) error {
 			for _, warning := range m.syncWarnings.Warnings {
 				fmt.Printf("⚠️  %s\n", warning)
 			}
-			fmt.Println("========================================\n")
+			fmt.Print("========================================\n\n")
 		}
 	}
 
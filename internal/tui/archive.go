@@ -0,0 +1,95 @@
+package tui
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// confirmArchive is the confirm action that archives the completed tasks shown
+const confirmArchive = "archive"
+
+// archiveTasks returns the completed tasks shown in the list, which the archive
+// action applies to
+func (m Model) archiveTasks() []core.Task {
+	// Group lists, project panes and the Home tab do not list the loaded tasks
+	if m.inGroupView || m.viewMode == ViewModeProjectPanes || m.isHomeView() {
+		return nil
+	}
+	return completedTasks(m.taskList.Tasks())
+}
+
+// startArchive asks to confirm archiving the completed tasks shown. The bulk
+// action is always confirmed, even when confirmations are disabled, and does
+// nothing until tui.archive_command is set.
+func (m Model) startArchive() (tea.Model, tea.Cmd) {
+	if m.archiveCommand() == "" {
+		m.statusMessage = m.text(msgArchiveNotConfigured)
+		return m, nil
+	}
+	if len(m.archiveTasks()) == 0 {
+		m.statusMessage = m.text(msgNoCompletedTasks)
+		return m, nil
+	}
+	m.state = StateConfirm
+	m.confirmAction = confirmArchive
+	return m, nil
+}
+
+// archiveTasksCmd archives tasks with tui.archive_command
+func (m Model) archiveTasksCmd(tasks []core.Task) tea.Cmd {
+	command := m.archiveCommand()
+	if command == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		return TaskModifiedMsg{Err: runArchiveCommand(command, tasks)}
+	}
+}
+
+// archiveCommand returns the configured archive command, if any
+func (m Model) archiveCommand() string {
+	if m.config.TUI == nil {
+		return ""
+	}
+	return strings.TrimSpace(m.config.TUI.ArchiveCommand)
+}
+
+// runArchiveCommand runs command once for all tasks, which it finds in the
+// WUI_TASK_UUIDS environment variable like custom commands do
+func runArchiveCommand(command string, tasks []core.Task) error {
+	parts, err := parseCommandLine(command)
+	if err != nil {
+		return fmt.Errorf("archive command parsing failed: %w", err)
+	}
+	if len(parts) == 0 {
+		return errors.New("empty archive command")
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Env = customCommandEnv(tasks)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if output := strings.TrimSpace(stderr.String()); output != "" {
+			return fmt.Errorf("archive command %q failed: %w: %s", parts[0], err, output)
+		}
+		return fmt.Errorf("archive command %q failed: %w", parts[0], err)
+	}
+	return nil
+}
+
+// expandArchivePlaceholders fills the {{.count}} and {{.command}} placeholders
+// of the archive prompt
+func (m Model) expandArchivePlaceholders(template string) string {
+	return strings.NewReplacer(
+		"{{.count}}", strconv.Itoa(len(m.archiveTasks())),
+		"{{.command}}", m.archiveCommand(),
+	).Replace(template)
+}
@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// archiveTestTasks mixes pending and completed tasks
+var archiveTestTasks = []core.Task{
+	{UUID: "open-1", Description: "Open", Status: "pending"},
+	{UUID: "done-1", Description: "Done", Status: "completed"},
+	{UUID: "done-2", Description: "Also done", Status: "completed"},
+	{UUID: "open-2", Description: "Waiting", Status: "waiting"},
+}
+
+func pressArchive(model Model) (Model, tea.Cmd) {
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	return updated.(Model), cmd
+}
+
+func TestArchiveNeedsCommand(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{
+		DeleteFunc: func(uuid string) error {
+			t.Errorf("Expected no task to be deleted, got %s", uuid)
+			return nil
+		},
+	})
+	model, _ = loadTasks(model, archiveTestTasks)
+
+	model, cmd := pressArchive(model)
+	if cmd != nil || model.state == StateConfirm {
+		t.Fatal("Expected nothing to happen without archive_command")
+	}
+	if model.statusMessage != model.text(msgArchiveNotConfigured) {
+		t.Errorf("Expected the not configured message, got %q", model.statusMessage)
+	}
+}
+
+func TestArchiveCommand(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "archived")
+	model := createTestModel(&core.MockTaskService{
+		DeleteFunc: func(uuid string) error {
+			t.Errorf("Expected the archive command instead of deleting %s", uuid)
+			return nil
+		},
+	})
+	model.config.TUI.ArchiveCommand = `sh -c "echo $WUI_TASK_UUIDS > ` + out + `"`
+	model.noConfirm = true
+	model, _ = loadTasks(model, archiveTestTasks)
+
+	// The bulk action is confirmed even with confirmations disabled
+	model, cmd := pressArchive(model)
+	if cmd != nil || model.state != StateConfirm || model.confirmAction != confirmArchive {
+		t.Fatal("Expected the archive to ask for confirmation")
+	}
+	if prompt := model.confirmMessage(); !strings.Contains(prompt, "2 completed tasks") || !strings.Contains(prompt, "sh -c") {
+		t.Errorf("Expected the prompt to count the tasks and name the command, got %q", prompt)
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	model = updated.(Model)
+	if cmd == nil || model.state != StateNormal {
+		t.Fatal("Expected a command archiving the tasks")
+	}
+	if msg, ok := cmd().(TaskModifiedMsg); !ok || msg.Err != nil {
+		t.Fatalf("Expected the archive command to succeed, got %+v", msg)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("Expected the archive command to run, got %v", err)
+	}
+	uuids := strings.Fields(string(data))
+	slices.Sort(uuids)
+	if !slices.Equal(uuids, []string{"done-1", "done-2"}) {
+		t.Errorf("Expected the completed task UUIDs, got %v", uuids)
+	}
+
+	model.config.TUI.ArchiveCommand = "false"
+	if msg := model.archiveTasksCmd(model.archiveTasks())().(TaskModifiedMsg); msg.Err == nil {
+		t.Error("Expected a failing archive command to report an error")
+	}
+}
+
+func TestArchiveWithoutCompletedTasks(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.ArchiveCommand = "true"
+	model, _ = loadTasks(model, archiveTestTasks[:1])
+
+	model, cmd := pressArchive(model)
+	if cmd != nil || model.state == StateConfirm {
+		t.Error("Expected nothing to archive")
+	}
+	if model.statusMessage != model.text(msgNoCompletedTasks) {
+		t.Errorf("Expected the no completed tasks message, got %q", model.statusMessage)
+	}
+}
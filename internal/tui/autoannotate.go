@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// autoAnnotations returns the configured auto-annotation templates keyed by action
+func (m Model) autoAnnotations() map[string]string {
+	if m.config.TUI == nil {
+		return nil
+	}
+	return m.config.TUI.AutoAnnotate
+}
+
+// autoAnnotate adds the annotation configured for action to the task.
+// It must only be called after the action itself succeeded; actions without a
+// template are a no-op. Templates whose placeholders cannot be expanded are used verbatim.
+func autoAnnotate(service core.TaskService, task core.Task, action string, templates map[string]string) error {
+	template := strings.TrimSpace(templates[action])
+	if template == "" {
+		return nil
+	}
+
+	text := template
+	if strings.Contains(template, "{{.") {
+		if expanded, err := expandCommandTemplate(template, &task); err == nil {
+			text = expanded
+		}
+	}
+	return service.Annotate(task.UUID, text)
+}
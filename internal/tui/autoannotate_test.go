@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// recordingService returns a mock that records start/stop/done/annotate calls in order
+func recordingService(calls *[]string, failPrimary bool) *core.MockTaskService {
+	primary := func(name string) func(string) error {
+		return func(uuid string) error {
+			*calls = append(*calls, name+" "+uuid)
+			if failPrimary {
+				return errors.New(name + " failed")
+			}
+			return nil
+		}
+	}
+	return &core.MockTaskService{
+		StartFunc: primary("start"),
+		StopFunc:  primary("stop"),
+		DoneFunc:  primary("done"),
+		AnnotateFunc: func(uuid, text string) error {
+			*calls = append(*calls, "annotate "+uuid+" "+text)
+			return nil
+		},
+	}
+}
+
+func TestAutoAnnotateAfterStart(t *testing.T) {
+	var calls []string
+	model := createTestModel(recordingService(&calls, false))
+	model.config.TUI.AutoAnnotate = map[string]string{
+		"start": "started via wui",
+		"done":  "completed: {{.description}}",
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if cmd == nil {
+		t.Fatal("Expected start command")
+	}
+	msg, ok := cmd().(TaskModifiedMsg)
+	if !ok || msg.Err != nil {
+		t.Fatalf("Expected successful TaskModifiedMsg, got %#v", msg)
+	}
+
+	expected := []string{"start test-uuid-1", "annotate test-uuid-1 started via wui"}
+	if len(calls) != len(expected) {
+		t.Fatalf("Expected calls %v, got %v", expected, calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("Expected call %d to be %q, got %q", i, expected[i], calls[i])
+		}
+	}
+}
+
+func TestAutoAnnotateTemplateAfterDone(t *testing.T) {
+	var calls []string
+	service := recordingService(&calls, false)
+	tasks := []core.Task{{UUID: "uuid-1", Description: "Write report", Status: "pending"}}
+
+	cmd := markTasksDoneCmd(service, tasks, map[string]string{"done": "completed: {{.description}}"})
+	cmd()
+
+	if len(calls) != 2 || calls[1] != "annotate uuid-1 completed: Write report" {
+		t.Errorf("Expected done then expanded annotation, got %v", calls)
+	}
+}
+
+func TestAutoAnnotateStopUsesStopTemplate(t *testing.T) {
+	var calls []string
+	service := recordingService(&calls, false)
+	started := time.Now()
+	tasks := []core.Task{{UUID: "uuid-1", Start: &started}}
+
+	cmd := toggleStartStopCmd(service, tasks, map[string]string{"start": "started", "stop": "stopped"})
+	cmd()
+
+	if len(calls) != 2 || calls[0] != "stop uuid-1" || calls[1] != "annotate uuid-1 stopped" {
+		t.Errorf("Expected stop then stop annotation, got %v", calls)
+	}
+}
+
+func TestAutoAnnotateSkippedOnFailure(t *testing.T) {
+	var calls []string
+	service := recordingService(&calls, true)
+	tasks := []core.Task{{UUID: "uuid-1"}}
+	annotations := map[string]string{"start": "started", "done": "done"}
+
+	msg := toggleStartStopCmd(service, tasks, annotations)().(TaskModifiedMsg)
+	if msg.Err == nil {
+		t.Error("Expected the start error to be reported")
+	}
+	msg = markTasksDoneCmd(service, tasks, annotations)().(TaskModifiedMsg)
+	if msg.Err == nil {
+		t.Error("Expected the done error to be reported")
+	}
+
+	for _, call := range calls {
+		if call == "annotate uuid-1 started" || call == "annotate uuid-1 done" {
+			t.Errorf("Expected no annotation after a failed action, got %v", calls)
+		}
+	}
+}
+
+func TestAutoAnnotateOffByDefault(t *testing.T) {
+	var calls []string
+	model := createTestModel(recordingService(&calls, false))
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if cmd == nil {
+		t.Fatal("Expected start command")
+	}
+	cmd()
+
+	if len(calls) != 1 || calls[0] != "start test-uuid-1" {
+		t.Errorf("Expected only the start call, got %v", calls)
+	}
+}
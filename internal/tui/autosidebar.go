@@ -0,0 +1,36 @@
+package tui
+
+import "github.com/clobrano/wui/internal/core"
+
+// dismissSidebar records that the user closed the sidebar: it is unpinned and not
+// reopened automatically for the current task
+func (m *Model) dismissSidebar() {
+	m.sidebarPinned = false
+	if task := m.taskList.SelectedTask(); task != nil {
+		m.sidebarDismissedUUID = task.UUID
+	}
+}
+
+// autoToggleSidebar opens the sidebar for a task with annotations and closes it for
+// a task without, when tui.auto_sidebar_if_annotated is set. A sidebar the user opened
+// stays open (pinned), and a task whose sidebar the user closed is left alone.
+func (m *Model) autoToggleSidebar(task *core.Task) {
+	if !m.config.TUI.AutoSidebarIfAnnotated || m.sidebarPinned || m.inGroupView {
+		return
+	}
+	if task != nil && task.UUID == m.sidebarDismissedUUID {
+		return
+	}
+	m.sidebarDismissedUUID = ""
+
+	annotated := task != nil && len(task.Annotations) > 0
+	switch {
+	case annotated && m.viewMode == ViewModeList:
+		m.viewMode = ViewModeListWithSidebar
+	case !annotated && m.viewMode == ViewModeListWithSidebar:
+		m.viewMode = ViewModeList
+	default:
+		return
+	}
+	m.updateComponentSizes()
+}
@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// createAutoSidebarModel returns a model with auto_sidebar_if_annotated set, where
+// only the second of three tasks has annotations
+func createAutoSidebarModel() Model {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.AutoSidebarIfAnnotated = true
+	model.tasks[1].Annotations = []core.Annotation{{Description: "see notes"}}
+	model.taskList.SetTasks(model.tasks)
+	model.viewMode = ViewModeList
+	return model
+}
+
+func TestAutoSidebarOpensAndCloses(t *testing.T) {
+	model := createAutoSidebarModel()
+
+	model = pressKey(t, model, "j")
+	if model.viewMode != ViewModeListWithSidebar {
+		t.Fatalf("Expected the sidebar to open on an annotated task, got %v", model.viewMode)
+	}
+
+	model = pressKey(t, model, "j")
+	if model.viewMode != ViewModeList {
+		t.Errorf("Expected the sidebar to close on a task without annotations, got %v", model.viewMode)
+	}
+}
+
+func TestAutoSidebarDisabled(t *testing.T) {
+	model := createAutoSidebarModel()
+	model.config.TUI.AutoSidebarIfAnnotated = false
+
+	model = pressKey(t, model, "j")
+	if model.viewMode != ViewModeList {
+		t.Errorf("Expected the list view without auto_sidebar_if_annotated, got %v", model.viewMode)
+	}
+}
+
+func TestAutoSidebarPinnedByUser(t *testing.T) {
+	model := createAutoSidebarModel()
+
+	// Opening the sidebar by hand keeps it open on tasks without annotations
+	model = pressKey(t, model, "v")
+	if model.viewMode != ViewModeListWithSidebar || !model.sidebarPinned {
+		t.Fatalf("Expected a pinned sidebar, got %v", model.viewMode)
+	}
+	model = pressKey(t, model, "G")
+	if model.viewMode != ViewModeListWithSidebar {
+		t.Errorf("Expected the pinned sidebar to stay open, got %v", model.viewMode)
+	}
+
+	// Closing it by hand unpins it
+	model = pressKey(t, model, "v")
+	if model.viewMode != ViewModeList || model.sidebarPinned {
+		t.Fatalf("Expected the sidebar to close and unpin, got %v", model.viewMode)
+	}
+	model = pressKey(t, model, "k")
+	if model.viewMode != ViewModeListWithSidebar {
+		t.Errorf("Expected the sidebar to open automatically again, got %v", model.viewMode)
+	}
+}
+
+func TestAutoSidebarDismissedByUser(t *testing.T) {
+	model := createAutoSidebarModel()
+
+	model = pressKey(t, model, "j")
+	model = pressKey(t, model, "v")
+	if model.viewMode != ViewModeList {
+		t.Fatalf("Expected the sidebar to close by hand on an annotated task, got %v", model.viewMode)
+	}
+
+	// Refreshing the same task does not reopen it, moving to another task resumes
+	model.updateSidebar()
+	if model.viewMode != ViewModeList {
+		t.Errorf("Expected the dismissed sidebar to stay closed, got %v", model.viewMode)
+	}
+	model = pressKey(t, model, "j")
+	model = pressKey(t, model, "k")
+	if model.viewMode != ViewModeListWithSidebar {
+		t.Errorf("Expected the sidebar to reopen after navigating back, got %v", model.viewMode)
+	}
+}
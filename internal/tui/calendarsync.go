@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/calendar"
+	"github.com/clobrano/wui/internal/config"
+)
+
+// runCalendarSync syncs the tasks matching cfg.CalendarSync to Google Calendar,
+// calling progress before each task is synced. Tests replace it with a fake sync.
+var runCalendarSync = func(cfg *config.Config, progress func(current, total int)) (*calendar.SyncResult, error) {
+	// Validate calendar configuration
+	if cfg.CalendarSync == nil {
+		return nil, fmt.Errorf("calendar sync not configured")
+	}
+	if cfg.CalendarSync.CalendarName == "" {
+		return nil, fmt.Errorf("calendar name is not configured")
+	}
+	if cfg.CalendarSync.TaskFilter == "" {
+		return nil, fmt.Errorf("task filter is not configured")
+	}
+	eventDuration, err := cfg.CalendarSync.EventDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	// We need access to the taskwarrior client to create the calendar sync client
+	// Since the service interface doesn't expose the underlying client,
+	// we need to recreate it from the config
+	// This is necessary because the calendar sync requires the taskwarrior.Client type
+	taskClient, err := createTaskClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task client: %w", err)
+	}
+
+	ctx := context.Background()
+	syncClient, err := calendar.NewSyncClient(ctx, taskClient,
+		cfg.CalendarSync.CredentialsPath, cfg.CalendarSync.TokenPath,
+		cfg.CalendarSync.CalendarName, cfg.CalendarSync.TaskFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sync client: %w", err)
+	}
+	// The TUI owns the terminal: report through the status line instead of stdout
+	syncClient.SetOutput(io.Discard)
+	syncClient.SetProgressFunc(progress)
+	syncClient.SetDefaultDuration(eventDuration)
+
+	result, err := syncClient.Sync(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sync failed: %w", err)
+	}
+	return result, nil
+}
+
+// calendarSyncCmd creates a command to sync tasks to Google Calendar.
+// Progress is sent on the progress channel, which is closed when the sync ends.
+func calendarSyncCmd(cfg *config.Config, progress chan<- CalendarSyncProgressMsg) tea.Cmd {
+	return func() tea.Msg {
+		defer close(progress)
+		result, err := runCalendarSync(cfg, func(current, total int) {
+			progress <- CalendarSyncProgressMsg{Current: current, Total: total}
+		})
+		return CalendarSyncCompletedMsg{
+			Result: result,
+			Err:    err,
+		}
+	}
+}
+
+// waitForCalendarSyncProgress waits for the next progress update of a running sync
+func waitForCalendarSyncProgress(progress <-chan CalendarSyncProgressMsg) tea.Cmd {
+	return func() tea.Msg {
+		msg, ok := <-progress
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+// startCalendarSync runs the calendar sync in the background, streaming its
+// progress to the status line
+func (m *Model) startCalendarSync() tea.Cmd {
+	progress := make(chan CalendarSyncProgressMsg)
+	m.calendarSyncProgress = progress
+	m.isLoading = true
+	return tea.Batch(
+		calendarSyncCmd(m.config, progress),
+		waitForCalendarSyncProgress(progress),
+	)
+}
+
+// syncCalendarNow starts a calendar sync without quitting when it completes
+func (m Model) syncCalendarNow() (tea.Model, tea.Cmd) {
+	if m.config.CalendarSync == nil || m.config.CalendarSync.CalendarName == "" {
+		m.errorMessage = m.text(msgErrCalendarNotConfigured)
+		return m, nil
+	}
+	if m.calendarSyncProgress != nil {
+		m.statusMessage = m.text(msgCalendarSyncRunning)
+		return m, nil
+	}
+	m.errorMessage = ""
+	m.statusMessage = m.text(msgCalendarSyncing)
+	return m, m.startCalendarSync()
+}
@@ -0,0 +1,217 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/calendar"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// fakeCalendarSync replaces runCalendarSync for the test with a sync of total
+// tasks that returns result and err
+func fakeCalendarSync(t *testing.T, total int, result *calendar.SyncResult, err error) *int {
+	t.Helper()
+	runs := 0
+	original := runCalendarSync
+	runCalendarSync = func(cfg *config.Config, progress func(current, total int)) (*calendar.SyncResult, error) {
+		runs++
+		for i := 1; i <= total; i++ {
+			progress(i, total)
+		}
+		return result, err
+	}
+	t.Cleanup(func() { runCalendarSync = original })
+	return &runs
+}
+
+// createCalendarSyncModel returns a test model with calendar sync configured
+func createCalendarSyncModel() Model {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.CalendarSync = &config.CalendarSync{
+		Enabled:         true,
+		CalendarName:    "Tasks",
+		TaskFilter:      "status:pending",
+		CredentialsPath: "/nonexistent/credentials.json",
+		TokenPath:       "/nonexistent/token.json",
+	}
+	return model
+}
+
+// runCalendarSyncCmds runs the commands of a started calendar sync, delivering
+// each progress update and the final result to the model. It returns the
+// model, the progress statuses shown and the command returned for the result.
+func runCalendarSyncCmds(t *testing.T, model Model, cmd tea.Cmd) (Model, []string, tea.Cmd) {
+	t.Helper()
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("Expected the sync and progress commands, got %v", batch)
+	}
+
+	done := make(chan tea.Msg, 1)
+	go func() { done <- batch[0]() }()
+
+	var statuses []string
+	wait := batch[1]
+	for {
+		msg := wait()
+		if msg == nil {
+			break
+		}
+		updated, next := model.Update(msg)
+		model = updated.(Model)
+		statuses = append(statuses, model.statusMessage)
+		wait = next
+	}
+
+	updated, next := model.Update(<-done)
+	return updated.(Model), statuses, next
+}
+
+func TestCalendarSyncNow(t *testing.T) {
+	runs := fakeCalendarSync(t, 2, &calendar.SyncResult{Total: 2, Created: 1, Updated: 1}, nil)
+	model := createCalendarSyncModel()
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	model = updated.(Model)
+	if !model.isLoading || model.statusMessage != "Syncing calendar..." {
+		t.Errorf("Expected the sync to start, got loading=%v status %q", model.isLoading, model.statusMessage)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a sync command")
+	}
+
+	// A second request while the sync runs is ignored
+	again, againCmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if againCmd != nil || again.(Model).statusMessage != "Calendar sync already running" {
+		t.Errorf("Expected the running sync to be reported, got %q", again.(Model).statusMessage)
+	}
+
+	model, statuses, cmd := runCalendarSyncCmds(t, model, cmd)
+	expected := []string{"Syncing calendar: task 1 of 2", "Syncing calendar: task 2 of 2"}
+	if strings.Join(statuses, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected progress %q, got %q", expected, statuses)
+	}
+	if *runs != 1 {
+		t.Errorf("Expected the sync to run once, ran %d times", *runs)
+	}
+	if model.statusMessage != "Calendar synced: 1 created, 1 updated" || model.isLoading {
+		t.Errorf("Expected the sync summary, got loading=%v status %q", model.isLoading, model.statusMessage)
+	}
+	if cmd != nil {
+		t.Error("Expected the TUI to keep running after the sync")
+	}
+	if model.calendarSyncProgress != nil {
+		t.Error("Expected the sync to be idle after completion")
+	}
+}
+
+func TestCalendarSyncNowNotConfigured(t *testing.T) {
+	runs := fakeCalendarSync(t, 0, &calendar.SyncResult{}, nil)
+	model := createTestModel(&core.MockTaskService{})
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	model = updated.(Model)
+	if cmd != nil || *runs != 0 {
+		t.Error("Expected no sync without calendar_sync configured")
+	}
+	if model.errorMessage != "Calendar sync not configured" {
+		t.Errorf("Expected the not configured error, got %q", model.errorMessage)
+	}
+}
+
+func TestCalendarSyncNowFailure(t *testing.T) {
+	fakeCalendarSync(t, 1, nil, errors.New("calendar 'Tasks' not found"))
+	model := createCalendarSyncModel()
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	model, _, cmd = runCalendarSyncCmds(t, updated.(Model), cmd)
+	if cmd != nil {
+		t.Error("Expected no command after a failed sync")
+	}
+	if model.errorMessage != "Calendar sync failed: calendar 'Tasks' not found" {
+		t.Errorf("Expected the sync error, got %q", model.errorMessage)
+	}
+}
+
+func TestCalendarSyncNowAuthSetupError(t *testing.T) {
+	fakeCalendarSync(t, 0, nil, fmt.Errorf("failed to create sync client: %w", calendar.ErrNoToken))
+	model := createCalendarSyncModel()
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	model, _, cmd = runCalendarSyncCmds(t, updated.(Model), cmd)
+	if cmd != nil || model.state != StateNormal {
+		t.Errorf("Expected no authorization flow without credentials, got state %v", model.state)
+	}
+	if !strings.HasPrefix(model.errorMessage, "Calendar auth setup failed: ") {
+		t.Errorf("Expected the auth setup error, got %q", model.errorMessage)
+	}
+}
+
+func TestCalendarSyncOnQuitStillQuits(t *testing.T) {
+	fakeCalendarSync(t, 1, &calendar.SyncResult{Total: 1, Created: 1}, nil)
+	model := createCalendarSyncModel()
+	model.config.CalendarSync.AutoSyncOnQuit = true
+
+	updated, cmd := model.quit()
+	model = updated.(Model)
+	if model.statusMessage != "Syncing calendar before quit..." {
+		t.Errorf("Expected the sync before quit, got %q", model.statusMessage)
+	}
+
+	_, _, cmd = runCalendarSyncCmds(t, model, cmd)
+	if cmd == nil {
+		t.Fatal("Expected the quit command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("Expected the TUI to quit after the sync")
+	}
+}
+
+func TestQuitDuringCalendarSync(t *testing.T) {
+	runs := fakeCalendarSync(t, 2, &calendar.SyncResult{Total: 2, Created: 2}, nil)
+	model := createCalendarSyncModel()
+	model.config.CalendarSync.AutoSyncOnQuit = true
+
+	updated, syncCmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	model = updated.(Model)
+
+	updated, cmd := model.quit()
+	model = updated.(Model)
+	if cmd != nil {
+		t.Fatal("Expected quit to wait for the running sync")
+	}
+	if !model.syncingBeforeQuit || model.statusMessage != "Syncing calendar before quit..." {
+		t.Errorf("Expected the quit to be deferred, got %q", model.statusMessage)
+	}
+
+	_, _, cmd = runCalendarSyncCmds(t, model, syncCmd)
+	if *runs != 1 {
+		t.Errorf("Expected a single sync, ran %d", *runs)
+	}
+	if cmd == nil {
+		t.Fatal("Expected the quit command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("Expected the TUI to quit after the running sync")
+	}
+}
+
+func TestCalendarSyncProgressAfterCompletion(t *testing.T) {
+	model := createCalendarSyncModel()
+	model.statusMessage = "Calendar synced: 1 created, 0 updated"
+
+	// A late progress update must not replace the summary nor wait on the
+	// closed progress channel
+	updated, cmd := model.Update(CalendarSyncProgressMsg{Current: 1, Total: 1})
+	if cmd != nil {
+		t.Error("Expected no command for progress after the sync completed")
+	}
+	if status := updated.(Model).statusMessage; status != "Calendar synced: 1 created, 0 updated" {
+		t.Errorf("Expected the sync summary to stay, got %q", status)
+	}
+}
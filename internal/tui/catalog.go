@@ -0,0 +1,299 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/clobrano/wui/internal/config"
+)
+
+// messageID identifies a user-facing string in the message catalog
+type messageID string
+
+// defaultLanguage is used when no language is configured and for missing translations
+const defaultLanguage = "en"
+
+// Empty-state messages
+const (
+	msgEmptyTasks     messageID = "empty.tasks"
+	msgEmptyTasksHint messageID = "empty.tasks_hint"
+	msgEmptySearch    messageID = "empty.search"
+)
+
+// Confirmation prompts (keyed as "confirm.<action>")
+const (
+	msgConfirmGeneric messageID = "confirm.generic"
+	msgConfirmDelete  messageID = "confirm.delete"
+
+	msgConfirmGroupDone   messageID = "confirm.group_done"
+	msgConfirmGroupDelete messageID = "confirm.group_delete"
+	msgConfirmReopen      messageID = "confirm.reopen"
+	msgConfirmStartStop   messageID = "confirm.start_stop"
+	msgConfirmUndo        messageID = "confirm.undo"
+	msgConfirmUndoLast    messageID = "confirm.undo_last"
+	msgConfirmArchive     messageID = "confirm.archive"
+)
+
+// Status messages
+const (
+	msgTaskUpdated               messageID = "status.task_updated"
+	msgNoTaskSelected            messageID = "status.no_task_selected"
+	msgNoResources               messageID = "status.no_resources"
+	msgCompletionCancelled       messageID = "status.completion_cancelled"
+	msgProjectPanesUnavailable   messageID = "status.project_panes_unavailable"
+	msgCalendarSynced            messageID = "status.calendar_synced"
+	msgCalendarSyncedSummary     messageID = "status.calendar_synced_summary"
+	msgCalendarSyncWarnings      messageID = "status.calendar_sync_warnings"
+	msgCalendarSyncingBeforeQuit messageID = "status.calendar_syncing_before_quit"
+	msgCalendarSyncing           messageID = "status.calendar_syncing"
+	msgCalendarSyncProgress      messageID = "status.calendar_sync_progress"
+	msgCalendarSyncRunning       messageID = "status.calendar_sync_running"
+	msgCalendarAuthorized        messageID = "status.calendar_authorized"
+	msgCalendarAuthCancelled     messageID = "status.calendar_auth_cancelled"
+	msgCounterNotConfigured      messageID = "status.counter_not_configured"
+	msgNoFilterToCopy            messageID = "status.no_filter_to_copy"
+	msgConfirmationsOff          messageID = "status.confirmations_off"
+	msgConfirmationsOn           messageID = "status.confirmations_on"
+	msgNotCompleted              messageID = "status.not_completed"
+	msgSyncingTasks              messageID = "status.syncing_tasks"
+	msgTasksSynced               messageID = "status.tasks_synced"
+	msgPendingActionCancelled    messageID = "status.pending_action_cancelled"
+	msgQuitWithSelection         messageID = "status.quit_with_selection"
+	msgCompletedLastOn           messageID = "status.completed_last_on"
+	msgCompletedLastOff          messageID = "status.completed_last_off"
+	msgNoSearchResults           messageID = "status.no_search_results"
+	msgNoHiddenTabs              messageID = "status.no_hidden_tabs"
+	msgEmptyInputCancelled       messageID = "status.empty_input_cancelled"
+	msgEmptyInputKept            messageID = "status.empty_input_kept"
+	msgSortedBy                  messageID = "status.sorted_by"
+	msgSortCleared               messageID = "status.sort_cleared"
+	msgFocusTask                 messageID = "status.focus_task"
+	msgNoContexts                messageID = "status.no_contexts"
+	msgContextSet                messageID = "status.context_set"
+	msgContextCleared            messageID = "status.context_cleared"
+	msgNoCompletedTasks          messageID = "status.no_completed_tasks"
+	msgArchiveNotConfigured      messageID = "status.archive_not_configured"
+	msgCopied                    messageID = "status.copied"
+	msgCopiedDescriptions        messageID = "status.copied_descriptions"
+	msgExportedChecklist         messageID = "status.exported_checklist"
+	msgExportedChecklists        messageID = "status.exported_checklists"
+	msgExportedMarkdown          messageID = "status.exported_markdown"
+	msgOpeningURL                messageID = "status.opening_url"
+	msgOpeningFile               messageID = "status.opening_file"
+	msgCommandExecuted           messageID = "status.command_executed"
+)
+
+// Error messages
+const (
+	msgErrLoadTasks             messageID = "error.load_tasks"
+	msgErrLoadProjectSummary    messageID = "error.load_project_summary"
+	msgErrTaskOperation         messageID = "error.task_operation"
+	msgErrCalendarAuthSetup     messageID = "error.calendar_auth_setup"
+	msgErrCalendarSync          messageID = "error.calendar_sync"
+	msgErrCalendarAuthorization messageID = "error.calendar_authorization"
+	msgErrCalendarNotConfigured messageID = "error.calendar_not_configured"
+	msgErrDeleteToken           messageID = "error.delete_token"
+	msgErrTaskSync              messageID = "error.task_sync"
+	msgErrLoadContexts          messageID = "error.load_contexts"
+	msgErrSetContext            messageID = "error.set_context"
+	msgErrCopyClipboard         messageID = "error.copy_clipboard"
+	msgErrUnsupportedPlatform   messageID = "error.unsupported_platform"
+	msgErrOpenURL               messageID = "error.open_url"
+	msgErrFileNotFound          messageID = "error.file_not_found"
+	msgErrOpenFile              messageID = "error.open_file"
+	msgErrCommandFailed         messageID = "error.command_failed"
+	msgErrCommandExitCode       messageID = "error.command_exit_code"
+)
+
+// Footer labels and key hints
+const (
+	msgFooterNoConfirm      messageID = "footer.no_confirm"
+	msgFooterContext        messageID = "footer.context"
+	msgFooterLoading        messageID = "footer.loading"
+	msgHintResourcePicker   messageID = "hint.resource_picker"
+	msgHintDuePresetPicker  messageID = "hint.due_preset_picker"
+	msgHintRecurrenceScope  messageID = "hint.recurrence_scope"
+	msgHintProjectPicker    messageID = "hint.project_picker"
+	msgHintTemplatePicker   messageID = "hint.template_picker"
+	msgHintRecurrencePicker messageID = "hint.recurrence_picker"
+	msgHintTabPicker        messageID = "hint.tab_picker"
+	msgHintContextPicker    messageID = "hint.context_picker"
+	msgHintGlobalSearch     messageID = "hint.global_search"
+	msgHintListPicker       messageID = "hint.list_picker"
+	msgHintTimePicker       messageID = "hint.time_picker"
+	msgHintCalendar         messageID = "hint.calendar"
+	msgHintHome             messageID = "hint.home"
+	msgHintGroups           messageID = "hint.groups"
+	msgHintProjectsPane     messageID = "hint.projects_pane"
+	msgHintTasksPane        messageID = "hint.tasks_pane"
+	msgHintTaskDetail       messageID = "hint.task_detail"
+	msgHintTaskList         messageID = "hint.task_list"
+	msgHintHelp             messageID = "hint.help"
+	msgHintMessages         messageID = "hint.messages"
+	msgHintInput            messageID = "hint.input"
+	msgHintConfirm          messageID = "hint.confirm"
+	msgHintFuzzyFind        messageID = "hint.fuzzy_find"
+	msgHintTaskValidation   messageID = "hint.task_validation"
+	msgHintModifyInput      messageID = "hint.modify_input"
+	msgHintDoneNote         messageID = "hint.done_note"
+	msgHintNewTaskInput     messageID = "hint.new_task_input"
+	msgHintPeek             messageID = "hint.peek"
+)
+
+// catalogs holds the translated messages for each supported language.
+// Messages may contain fmt verbs, filled in by translate.
+var catalogs = map[string]map[messageID]string{
+	defaultLanguage: englishMessages,
+	"it":            italianMessages,
+}
+
+// englishMessages is the reference catalog; every message ID must be present here
+var englishMessages = map[messageID]string{
+	msgEmptyTasks:     "No tasks found.",
+	msgEmptyTasksHint: "No tasks found.\n\nPress %s to add a task",
+	msgEmptySearch:    "Search across all tasks\n\nPress / to enter a search filter\n\nExamples:\n  • bug                    - search for 'bug' in all tasks\n  • project:home           - tasks in 'home' project\n  • status:completed       - completed tasks only\n  • +urgent due.before:eom - urgent tasks due before end of month",
+
+	msgConfirmGeneric: "Confirm? (y/N)",
+	msgConfirmDelete:  config.DefaultConfirmMessages()["delete"],
+
+	msgConfirmGroupDone:   "Mark all {{.count}} tasks in '{{.group}}' done? (y/N)",
+	msgConfirmGroupDelete: "Delete all {{.count}} tasks in '{{.group}}'? (y/N)",
+	msgConfirmReopen:      "Reopen task '{{.description}}'? (y/N)",
+	msgConfirmStartStop:   "Start/stop {{.count}} tasks? (y/N)",
+	msgConfirmUndo:        "Undo: {{.change}}? (y/N)",
+	msgConfirmUndoLast:    "Undo the last change? (y/N)",
+	msgConfirmArchive:     "Archive the {{.count}} completed tasks shown with {{.command}}? (y/N)",
+
+	msgTaskUpdated:               "Task updated successfully",
+	msgNoTaskSelected:            "No task selected",
+	msgNoResources:               "No URLs or file paths found in task annotations",
+	msgCompletionCancelled:       "Task completion cancelled",
+	msgProjectPanesUnavailable:   "Two-pane view is only available in the Projects tab",
+	msgCalendarSynced:            "Calendar synced successfully",
+	msgCalendarSyncedSummary:     "Calendar synced: %d created, %d updated",
+	msgCalendarSyncWarnings:      ", %d warnings - see output after quit",
+	msgCalendarSyncingBeforeQuit: "Syncing calendar before quit...",
+	msgCalendarSyncing:           "Syncing calendar...",
+	msgCalendarSyncProgress:      "Syncing calendar: task %d of %d",
+	msgCalendarSyncRunning:       "Calendar sync already running",
+	msgCalendarAuthorized:        "Authorized! Syncing calendar...",
+	msgCalendarAuthCancelled:     "Calendar authorization cancelled",
+	msgCounterNotConfigured:      "No counter UDA configured (set tui.counter_uda)",
+	msgNoFilterToCopy:            "No filter to copy",
+	msgConfirmationsOff:          "Confirmations disabled: destructive actions run immediately",
+	msgConfirmationsOn:           "Confirmations enabled",
+	msgNotCompleted:              "Only completed tasks can be reopened",
+	msgSyncingTasks:              "Syncing tasks...",
+	msgTasksSynced:               "Tasks synced",
+	msgPendingActionCancelled:    "Pending action cancelled; press %s again to quit",
+	msgQuitWithSelection:         "Selected tasks: %d; press %s again to quit",
+	msgCompletedLastOn:           "Completed tasks moved to the bottom",
+	msgCompletedLastOff:          "Completed tasks kept in list order",
+	msgNoSearchResults:           "No tasks match '%s'",
+	msgNoHiddenTabs:              "All tabs are shown (see tui.max_visible_tabs)",
+	msgEmptyInputCancelled:       "Input was empty: nothing was applied",
+	msgEmptyInputKept:            "Input is empty: type a value or press esc to cancel",
+	msgSortedBy:                  "Sorted by %s (%s)",
+	msgSortCleared:               "Sorted in tab order",
+	msgFocusTask:                 "Showing the task and its %d dependencies; press %s to show all tasks",
+	msgNoContexts:                "No Taskwarrior contexts defined (see task context define)",
+	msgContextSet:                "Context set to %s",
+	msgContextCleared:            "Context cleared",
+	msgNoCompletedTasks:          "No completed tasks shown to archive",
+	msgArchiveNotConfigured:      "Set tui.archive_command to archive completed tasks",
+	msgCopied:                    "Copied to clipboard: %s",
+	msgCopiedDescriptions:        "Copied %d task descriptions to clipboard",
+	msgExportedChecklist:         "Task exported to clipboard as checklist ✓",
+	msgExportedChecklists:        "%d tasks exported to clipboard as checklist ✓",
+	msgExportedMarkdown:          "Task exported to clipboard as markdown ✓",
+	msgOpeningURL:                "Opening URL in browser...",
+	msgOpeningFile:               "Opening file...",
+	msgCommandExecuted:           "Executed: %s",
+
+	msgErrLoadTasks:             "Failed to load tasks: %s",
+	msgErrLoadProjectSummary:    "Failed to load project summary: %s",
+	msgErrTaskOperation:         "Task operation failed: %s",
+	msgErrCalendarAuthSetup:     "Calendar auth setup failed: %s",
+	msgErrCalendarSync:          "Calendar sync failed: %s",
+	msgErrCalendarAuthorization: "Calendar authorization failed: %s",
+	msgErrCalendarNotConfigured: "Calendar sync not configured",
+	msgErrDeleteToken:           "Failed to delete token: %s",
+	msgErrTaskSync:              "Task sync failed: %s",
+	msgErrLoadContexts:          "Failed to load contexts: %s",
+	msgErrSetContext:            "Failed to set context: %s",
+	msgErrCopyClipboard:         "Failed to copy to clipboard: %s",
+	msgErrUnsupportedPlatform:   "Unsupported platform: %s",
+	msgErrOpenURL:               "Failed to open URL: %s",
+	msgErrFileNotFound:          "File not found: %s",
+	msgErrOpenFile:              "Failed to open file: %s",
+	msgErrCommandFailed:         "Command '%s' failed: %s",
+	msgErrCommandExitCode:       "Command '%s' failed (exit code %d)",
+
+	msgFooterNoConfirm:      "NO CONFIRM",
+	msgFooterContext:        "Context: %s",
+	msgFooterLoading:        "Loading...",
+	msgHintResourcePicker:   "↑↓: navigate | enter: open | esc: cancel",
+	msgHintDuePresetPicker:  "↑↓: navigate | enter: set due date | esc: cancel",
+	msgHintRecurrenceScope:  "↑↓: navigate | enter: modify | esc: cancel",
+	msgHintProjectPicker:    "type to search | ↑↓: navigate | enter: assign project | esc: cancel",
+	msgHintTemplatePicker:   "type to search | ↑↓: navigate | enter: use template | esc: cancel",
+	msgHintRecurrencePicker: "type a custom period | ↑↓: navigate | enter: choose first due date | esc: cancel",
+	msgHintTabPicker:        "type to search | ↑↓: navigate | enter: switch tab | esc: cancel",
+	msgHintContextPicker:    "type to search | ↑↓: navigate | enter: set context | esc: cancel",
+	msgHintGlobalSearch:     "type to narrow | ↑↓: navigate | enter: jump to task | esc: close",
+	msgHintListPicker:       "↑↓: navigate | enter: select | esc: cancel",
+	msgHintTimePicker:       "↑↓: change value | Tab/←→: switch field | N: now | enter: select | esc: cancel",
+	msgHintCalendar:         "B/N: prev/next month | T: today | E: edit date | arrows/hjkl: navigate | enter: select | esc: cancel",
+	msgHintHome:             "%s: refresh | tab: next section | %s: search all tasks",
+	msgHintGroups:           "enter: open in Search | j/k: navigate | tab: next section",
+	msgHintProjectsPane:     "j/k: select project | l/tab: tasks pane | p: group list",
+	msgHintTasksPane:        "h: projects pane | d: done | s: start/stop | e: edit | m: modify | a: annotate",
+	msgHintTaskDetail:       "k/j: scroll | K/J: prev/next task | esc: back | d: done | s: start/stop | e: edit | m: modify | a: annotate",
+	msgHintTaskList:         "d: done | s: start/stop | x: delete | e: edit | n: new | m: modify | a: annotate | u: undo",
+	msgHintHelp:             "?: close help",
+	msgHintMessages:         "j/k: scroll | g/G: newest/oldest | esc: close",
+	msgHintInput:            "enter: apply | esc: cancel",
+	msgHintConfirm:          "y: confirm | n: cancel",
+	msgHintFuzzyFind:        "type to narrow | ↑↓: navigate | enter: select | esc: cancel",
+	msgHintTaskValidation:   "y: complete anyway | n/esc: cancel",
+	msgHintModifyInput:      "enter: apply | esc: cancel | tab: date+time picker",
+	msgHintDoneNote:         "enter: complete | esc: cancel",
+	msgHintNewTaskInput:     "enter: create | esc: cancel | tab: date+time picker",
+	msgHintPeek:             "Press any key to close",
+}
+
+// translate returns the message for id in the given language, falling back to
+// English when the language or the message is missing. Arguments, if any, are
+// formatted into the message with fmt.Sprintf.
+func translate(language string, id messageID, args ...any) string {
+	text, ok := lookupMessage(language, id)
+	if !ok {
+		text = string(id)
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(text, args...)
+	}
+	return text
+}
+
+// lookupMessage returns the unformatted message for id in the given language,
+// falling back to English, and whether the message exists at all
+func lookupMessage(language string, id messageID) (string, bool) {
+	if text, ok := catalogs[language][id]; ok {
+		return text, true
+	}
+	text, ok := catalogs[defaultLanguage][id]
+	return text, ok
+}
+
+// language returns the configured UI language
+func (m Model) language() string {
+	if m.config != nil && m.config.TUI != nil && m.config.TUI.Language != "" {
+		return m.config.TUI.Language
+	}
+	return defaultLanguage
+}
+
+// text returns the message for id in the configured UI language
+func (m Model) text(id messageID, args ...any) string {
+	return translate(m.language(), id, args...)
+}
@@ -0,0 +1,117 @@
+package tui
+
+// italianMessages is the Italian catalog. The confirmation keys stay y/n, so
+// the prompts keep the (y/N) hint.
+var italianMessages = map[messageID]string{
+	msgEmptyTasks:     "Nessun task trovato.",
+	msgEmptyTasksHint: "Nessun task trovato.\n\nPremi %s per aggiungere un task",
+	msgEmptySearch:    "Cerca in tutti i task\n\nPremi / per inserire un filtro di ricerca\n\nEsempi:\n  • bug                    - cerca 'bug' in tutti i task\n  • project:home           - task del progetto 'home'\n  • status:completed       - solo i task completati\n  • +urgent due.before:eom - task urgenti in scadenza entro fine mese",
+
+	msgConfirmGeneric: "Confermi? (y/N)",
+	msgConfirmDelete:  "Eliminare il task '{{.description}}'? (y/N)",
+
+	msgConfirmGroupDone:   "Completare tutti i {{.count}} task di '{{.group}}'? (y/N)",
+	msgConfirmGroupDelete: "Eliminare tutti i {{.count}} task di '{{.group}}'? (y/N)",
+	msgConfirmReopen:      "Riaprire il task '{{.description}}'? (y/N)",
+	msgConfirmStartStop:   "Avviare/fermare {{.count}} task? (y/N)",
+	msgConfirmUndo:        "Annullare: {{.change}}? (y/N)",
+	msgConfirmUndoLast:    "Annullare l'ultima modifica? (y/N)",
+	msgConfirmArchive:     "Archiviare i {{.count}} task completati mostrati con {{.command}}? (y/N)",
+
+	msgTaskUpdated:               "Task aggiornato",
+	msgNoTaskSelected:            "Nessun task selezionato",
+	msgNoResources:               "Nessun URL o percorso di file nelle annotazioni del task",
+	msgCompletionCancelled:       "Completamento del task annullato",
+	msgProjectPanesUnavailable:   "La vista a due pannelli è disponibile solo nella scheda Projects",
+	msgCalendarSynced:            "Calendario sincronizzato",
+	msgCalendarSyncedSummary:     "Calendario sincronizzato: %d creati, %d aggiornati",
+	msgCalendarSyncWarnings:      ", %d avvisi - vedi l'output all'uscita",
+	msgCalendarSyncingBeforeQuit: "Sincronizzazione del calendario prima di uscire...",
+	msgCalendarSyncing:           "Sincronizzazione del calendario...",
+	msgCalendarSyncProgress:      "Sincronizzazione del calendario: task %d di %d",
+	msgCalendarSyncRunning:       "Sincronizzazione del calendario già in corso",
+	msgCalendarAuthorized:        "Autorizzato! Sincronizzazione del calendario...",
+	msgCalendarAuthCancelled:     "Autorizzazione del calendario annullata",
+	msgCounterNotConfigured:      "Nessuna UDA contatore configurata (imposta tui.counter_uda)",
+	msgNoFilterToCopy:            "Nessun filtro da copiare",
+	msgConfirmationsOff:          "Conferme disattivate: le azioni distruttive sono eseguite subito",
+	msgConfirmationsOn:           "Conferme attivate",
+	msgNotCompleted:              "Solo i task completati possono essere riaperti",
+	msgSyncingTasks:              "Sincronizzazione dei task...",
+	msgTasksSynced:               "Task sincronizzati",
+	msgPendingActionCancelled:    "Azione in sospeso annullata; premi di nuovo %s per uscire",
+	msgQuitWithSelection:         "Task selezionati: %d; premi di nuovo %s per uscire",
+	msgCompletedLastOn:           "Task completati spostati in fondo",
+	msgCompletedLastOff:          "Task completati nell'ordine della lista",
+	msgNoSearchResults:           "Nessun task corrisponde a '%s'",
+	msgNoHiddenTabs:              "Tutte le schede sono visibili (vedi tui.max_visible_tabs)",
+	msgEmptyInputCancelled:       "Input vuoto: nessuna modifica applicata",
+	msgEmptyInputKept:            "L'input è vuoto: inserisci un valore o premi esc per annullare",
+	msgSortedBy:                  "Ordinati per %s (%s)",
+	msgSortCleared:               "Ordinati come nella scheda",
+	msgFocusTask:                 "Il task e le sue %d dipendenze; premi %s per mostrare tutti i task",
+	msgNoContexts:                "Nessun contesto Taskwarrior definito (vedi task context define)",
+	msgContextSet:                "Contesto impostato a %s",
+	msgContextCleared:            "Contesto rimosso",
+	msgNoCompletedTasks:          "Nessun task completato mostrato da archiviare",
+	msgArchiveNotConfigured:      "Imposta tui.archive_command per archiviare i task completati",
+	msgCopied:                    "Copiato negli appunti: %s",
+	msgCopiedDescriptions:        "Copiate negli appunti le descrizioni di %d task",
+	msgExportedChecklist:         "Task esportato negli appunti come checklist ✓",
+	msgExportedChecklists:        "%d task esportati negli appunti come checklist ✓",
+	msgExportedMarkdown:          "Task esportato negli appunti in markdown ✓",
+	msgOpeningURL:                "Apertura dell'URL nel browser...",
+	msgOpeningFile:               "Apertura del file...",
+	msgCommandExecuted:           "Eseguito: %s",
+
+	msgErrLoadTasks:             "Caricamento dei task non riuscito: %s",
+	msgErrLoadProjectSummary:    "Caricamento del riepilogo dei progetti non riuscito: %s",
+	msgErrTaskOperation:         "Operazione sul task non riuscita: %s",
+	msgErrCalendarAuthSetup:     "Configurazione dell'autorizzazione del calendario non riuscita: %s",
+	msgErrCalendarSync:          "Sincronizzazione del calendario non riuscita: %s",
+	msgErrCalendarAuthorization: "Autorizzazione del calendario non riuscita: %s",
+	msgErrCalendarNotConfigured: "Sincronizzazione del calendario non configurata",
+	msgErrDeleteToken:           "Eliminazione del token non riuscita: %s",
+	msgErrTaskSync:              "Sincronizzazione dei task non riuscita: %s",
+	msgErrLoadContexts:          "Caricamento dei contesti non riuscito: %s",
+	msgErrSetContext:            "Impostazione del contesto non riuscita: %s",
+	msgErrCopyClipboard:         "Copia negli appunti non riuscita: %s",
+	msgErrUnsupportedPlatform:   "Piattaforma non supportata: %s",
+	msgErrOpenURL:               "Apertura dell'URL non riuscita: %s",
+	msgErrFileNotFound:          "File non trovato: %s",
+	msgErrOpenFile:              "Apertura del file non riuscita: %s",
+	msgErrCommandFailed:         "Comando '%s' non riuscito: %s",
+	msgErrCommandExitCode:       "Comando '%s' non riuscito (codice di uscita %d)",
+
+	msgFooterNoConfirm:      "SENZA CONFERMA",
+	msgFooterContext:        "Contesto: %s",
+	msgFooterLoading:        "Caricamento...",
+	msgHintResourcePicker:   "↑↓: sposta | enter: apri | esc: annulla",
+	msgHintDuePresetPicker:  "↑↓: sposta | enter: imposta la scadenza | esc: annulla",
+	msgHintRecurrenceScope:  "↑↓: sposta | enter: modifica | esc: annulla",
+	msgHintProjectPicker:    "scrivi per cercare | ↑↓: sposta | enter: assegna il progetto | esc: annulla",
+	msgHintTemplatePicker:   "scrivi per cercare | ↑↓: sposta | enter: usa il modello | esc: annulla",
+	msgHintRecurrencePicker: "scrivi un periodo | ↑↓: sposta | enter: scegli la prima scadenza | esc: annulla",
+	msgHintTabPicker:        "scrivi per cercare | ↑↓: sposta | enter: cambia scheda | esc: annulla",
+	msgHintContextPicker:    "scrivi per cercare | ↑↓: sposta | enter: imposta il contesto | esc: annulla",
+	msgHintGlobalSearch:     "scrivi per restringere | ↑↓: sposta | enter: vai al task | esc: chiudi",
+	msgHintListPicker:       "↑↓: sposta | enter: seleziona | esc: annulla",
+	msgHintTimePicker:       "↑↓: cambia valore | Tab/←→: cambia campo | N: adesso | enter: seleziona | esc: annulla",
+	msgHintCalendar:         "B/N: mese prec./succ. | T: oggi | E: modifica la data | frecce/hjkl: sposta | enter: seleziona | esc: annulla",
+	msgHintHome:             "%s: aggiorna | tab: sezione successiva | %s: cerca in tutti i task",
+	msgHintGroups:           "enter: apri in Ricerca | j/k: sposta | tab: sezione successiva",
+	msgHintProjectsPane:     "j/k: scegli il progetto | l/tab: riquadro dei task | p: lista dei gruppi",
+	msgHintTasksPane:        "h: riquadro dei progetti | d: completa | s: avvia/ferma | e: edita | m: modifica | a: annota",
+	msgHintTaskDetail:       "k/j: scorri | K/J: task prec./succ. | esc: indietro | d: completa | s: avvia/ferma | e: edita | m: modifica | a: annota",
+	msgHintTaskList:         "d: completa | s: avvia/ferma | x: elimina | e: edita | n: nuovo | m: modifica | a: annota | u: annulla",
+	msgHintHelp:             "?: chiudi l'aiuto",
+	msgHintMessages:         "j/k: scorri | g/G: più recenti/meno recenti | esc: chiudi",
+	msgHintInput:            "enter: applica | esc: annulla",
+	msgHintConfirm:          "y: conferma | n: annulla",
+	msgHintFuzzyFind:        "scrivi per restringere | ↑↓: sposta | enter: seleziona | esc: annulla",
+	msgHintTaskValidation:   "y: completa comunque | n/esc: annulla",
+	msgHintModifyInput:      "enter: applica | esc: annulla | tab: data e ora",
+	msgHintDoneNote:         "enter: completa | esc: annulla",
+	msgHintNewTaskInput:     "enter: crea | esc: annulla | tab: data e ora",
+	msgHintPeek:             "Premi un tasto per chiudere",
+}
@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestEnglishCatalogIsComplete(t *testing.T) {
+	ids := []messageID{
+		msgEmptyTasks, msgEmptySearch,
+		msgConfirmGeneric, msgConfirmDelete, msgConfirmGroupDone, msgConfirmGroupDelete, msgConfirmReopen, msgConfirmStartStop, msgConfirmUndo, msgConfirmUndoLast,
+		msgTaskUpdated, msgNoTaskSelected, msgNoResources, msgCompletionCancelled,
+		msgProjectPanesUnavailable, msgCalendarSynced, msgCalendarSyncedSummary,
+		msgCalendarSyncWarnings, msgCalendarSyncingBeforeQuit, msgCalendarAuthorized,
+		msgCalendarSyncing, msgCalendarSyncProgress, msgCalendarSyncRunning,
+		msgCalendarAuthCancelled, msgCounterNotConfigured,
+		msgErrLoadTasks, msgErrLoadProjectSummary, msgErrTaskOperation,
+		msgErrCalendarAuthSetup, msgErrCalendarSync, msgErrCalendarAuthorization,
+		msgErrCalendarNotConfigured, msgErrDeleteToken, msgErrTaskSync,
+		msgSyncingTasks, msgTasksSynced, msgPendingActionCancelled, msgQuitWithSelection,
+		msgCompletedLastOn, msgCompletedLastOff, msgNoSearchResults, msgNoHiddenTabs,
+		msgEmptyInputCancelled, msgEmptyInputKept, msgSortedBy, msgSortCleared,
+	}
+	for _, id := range ids {
+		if englishMessages[id] == "" {
+			t.Errorf("Expected an English message for %q", id)
+		}
+	}
+}
+
+func TestTranslateFallsBackToEnglish(t *testing.T) {
+	catalogs["xx"] = map[messageID]string{
+		msgTaskUpdated: "Tarea actualizada",
+	}
+	defer delete(catalogs, "xx")
+
+	if got := translate("xx", msgTaskUpdated); got != "Tarea actualizada" {
+		t.Errorf("Expected translated message, got %q", got)
+	}
+	// Missing key in the selected language
+	if got := translate("xx", msgNoTaskSelected); got != "No task selected" {
+		t.Errorf("Expected English fallback for missing key, got %q", got)
+	}
+	// Unknown language
+	if got := translate("zz", msgNoTaskSelected); got != "No task selected" {
+		t.Errorf("Expected English fallback for unknown language, got %q", got)
+	}
+	// Unknown message ID
+	if got := translate("en", messageID("no.such.message")); got != "no.such.message" {
+		t.Errorf("Expected the message ID for an unknown message, got %q", got)
+	}
+}
+
+func TestTranslateFormatsArguments(t *testing.T) {
+	if got := translate("en", msgErrLoadTasks, "boom"); got != "Failed to load tasks: boom" {
+		t.Errorf("Expected formatted message, got %q", got)
+	}
+	if got := translate("en", msgCalendarSyncedSummary, 2, 3); got != "Calendar synced: 2 created, 3 updated" {
+		t.Errorf("Expected formatted message, got %q", got)
+	}
+}
+
+func TestModelTextUsesConfiguredLanguage(t *testing.T) {
+	catalogs["xx"] = map[messageID]string{
+		msgConfirmDelete: "Eliminar '{{.description}}'? (s/N)",
+	}
+	defer delete(catalogs, "xx")
+
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.Language = "xx"
+	model.state = StateConfirm
+
+	model.confirmAction = "delete"
+	if got := model.confirmMessage(); got != "Eliminar 'Test task 1'? (s/N)" {
+		t.Errorf("Expected translated delete prompt, got %q", got)
+	}
+	// The generic prompt is not translated, so it falls back to English
+	model.confirmAction = "unknown"
+	if got := model.confirmMessage(); got != "Confirm? (y/N)" {
+		t.Errorf("Expected English generic prompt, got %q", got)
+	}
+
+	model.config.TUI.Language = ""
+	if got := model.text(msgNoTaskSelected); got != "No task selected" {
+		t.Errorf("Expected English when no language is configured, got %q", got)
+	}
+}
+
+func TestCatalogsMatchEnglish(t *testing.T) {
+	verbs := regexp.MustCompile(`%[a-z]|\{\{\.[a-z]+\}\}`)
+	for language, catalog := range catalogs {
+		for id, english := range englishMessages {
+			text, ok := catalog[id]
+			if !ok {
+				t.Errorf("%s: missing translation for %q", language, id)
+				continue
+			}
+			if got, expected := verbs.FindAllString(text, -1), verbs.FindAllString(english, -1); !slices.Equal(got, expected) {
+				t.Errorf("%s: %q has placeholders %v, expected %v", language, id, got, expected)
+			}
+		}
+	}
+}
+
+func TestItalianDeletePrompt(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.Language = "it"
+	model.confirmAction = "delete"
+	if got := model.confirmMessage(); got != "Eliminare il task 'Test task 1'? (y/N)" {
+		t.Errorf("Expected the Italian prompt instead of the default one, got %q", got)
+	}
+
+	// A configured prompt is shown as is
+	model.config.TUI.ConfirmMessages = map[string]string{"delete": "Really delete '{{.description}}'? (y/N)"}
+	if got := model.confirmMessage(); got != "Really delete 'Test task 1'? (y/N)" {
+		t.Errorf("Expected the configured prompt, got %q", got)
+	}
+}
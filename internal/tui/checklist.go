@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// taskChecklists returns the GitHub-flavored checklists of tasks, with their
+// dependencies looked up in allTasks
+func taskChecklists(tasks, allTasks []core.Task) string {
+	checklists := make([]string, len(tasks))
+	for i, task := range tasks {
+		checklists[i] = task.ToChecklist(allTasks)
+	}
+	return strings.Join(checklists, "\n")
+}
+
+// exportChecklistCmd copies tasks and their dependencies to the clipboard as a
+// GitHub-flavored checklist
+func exportChecklistCmd(language string, cb Clipboard, tasks, allTasks []core.Task) tea.Cmd {
+	return func() tea.Msg {
+		text := taskChecklists(tasks, allTasks)
+		if err := cb.WriteText(text); err != nil {
+			return StatusMsg{
+				Message: translate(language, msgErrCopyClipboard, text),
+				IsError: true,
+			}
+		}
+
+		message := translate(language, msgExportedChecklist)
+		if len(tasks) > 1 {
+			message = translate(language, msgExportedChecklists, len(tasks))
+		}
+		return StatusMsg{
+			Message: message,
+			IsError: false,
+		}
+	}
+}
+
+// checklistTasks returns the tasks that checklist dependencies can refer to:
+// the loaded tasks and the dependencies loaded separately
+func (m Model) checklistTasks() []core.Task {
+	return append(append([]core.Task{}, m.tasks...), m.depTasks...)
+}
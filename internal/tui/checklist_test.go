@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestTaskChecklists(t *testing.T) {
+	allTasks := []core.Task{
+		{UUID: "1", Description: "Write report", Status: "pending", Depends: []string{"3"}},
+		{UUID: "2", Description: "Buy milk", Status: "pending"},
+		{UUID: "3", Description: "Collect data", Status: "completed"},
+	}
+
+	expected := "- [ ] Write report\n  - [x] Collect data\n- [ ] Buy milk"
+	if got := taskChecklists(allTasks[:2], allTasks); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestExportChecklistKey(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a clipboard command")
+	}
+	if len(model.taskList.GetSelectedTasks()) != 1 {
+		t.Error("Expected the selection to be cleared after exporting")
+	}
+}
+
+func TestChecklistTasksIncludesDependencies(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.depTasks = []core.Task{{UUID: "dep", Description: "Done elsewhere", Status: "completed"}}
+
+	tasks := model.checklistTasks()
+	if len(tasks) != len(model.tasks)+1 || tasks[len(tasks)-1].UUID != "dep" {
+		t.Errorf("Expected the loaded tasks followed by the dependency tasks, got %+v", tasks)
+	}
+}
@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// isChord reports whether a keybinding is a key pressed twice, such as "gg"
+func isChord(binding string) bool {
+	runes := []rune(binding)
+	return len(runes) == 2 && runes[0] == runes[1] && unicode.IsPrint(runes[0]) && runes[0] != ' '
+}
+
+// startsChord reports whether keyPressed is the first key of a configured chord
+func (m Model) startsChord(keyPressed string) bool {
+	if m.config == nil || m.config.TUI == nil {
+		return false
+	}
+	for _, binding := range m.config.TUI.Keybindings {
+		if isChord(binding) && binding[:len(binding)/2] == keyPressed {
+			return true
+		}
+	}
+	return false
+}
+
+// chordKey resolves chord keybindings. It returns the chord when keyPressed completes
+// one, "" when keyPressed starts one and the second key is awaited, and keyPressed
+// otherwise. A chord that is not completed by the next key is dropped.
+func (m *Model) chordKey(keyPressed string) string {
+	pending := m.pendingChord
+	m.pendingChord = ""
+
+	if pending != "" && pending == keyPressed {
+		return pending + keyPressed
+	}
+	if pending == "" && m.startsChord(keyPressed) {
+		m.pendingChord = keyPressed
+		return ""
+	}
+	return keyPressed
+}
+
+// isPageKey reports whether keyPressed moves a list by half a page or a page
+func (m Model) isPageKey(keyPressed string) bool {
+	switch keyPressed {
+	case "ctrl+d", "ctrl+u", "ctrl+f", "ctrl+b", "pgdown", "pgup":
+		return true
+	}
+	return m.keyMatches(keyPressed, "page_up") || m.keyMatches(keyPressed, "page_down")
+}
+
+// navigateList moves the cursor of a task or group list. First and last are
+// resolved from the keybindings here, so remapped keys and chords such as "gg"
+// behave the same in both display modes.
+func (m *Model) navigateList(list components.TaskList, keyPressed string, msg tea.KeyMsg) components.TaskList {
+	switch {
+	case m.keyMatches(keyPressed, "first"):
+		list.MoveToStart()
+	case m.keyMatches(keyPressed, "last"):
+		list.MoveToEnd()
+	case m.keyMatches(keyPressed, "down") || keyPressed == "down":
+		for range m.navigationStep(keyPressed) {
+			list.MoveCursorDown()
+		}
+	case m.keyMatches(keyPressed, "up") || keyPressed == "up":
+		for range m.navigationStep(keyPressed) {
+			list.MoveCursorUp()
+		}
+	case m.keyMatches(keyPressed, "page_down"):
+		list.HalfPageDown()
+	case m.keyMatches(keyPressed, "page_up"):
+		list.HalfPageUp()
+	default:
+		list, _ = list.Update(msg)
+	}
+	return list
+}
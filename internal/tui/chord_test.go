@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestIsChord(t *testing.T) {
+	tests := map[string]bool{
+		"gg":     true,
+		"GG":     true,
+		"g":      false,
+		"gG":     false,
+		"up":     false,
+		"ctrl+g": false,
+		"  ":     false,
+	}
+	for binding, expected := range tests {
+		if got := isChord(binding); got != expected {
+			t.Errorf("isChord(%q) = %v, expected %v", binding, got, expected)
+		}
+	}
+}
+
+func TestFirstKeyChord(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.Keybindings["first"] = "gg"
+	model.taskList.MoveToEnd()
+
+	model = pressKey(t, model, "g")
+	if model.taskList.Cursor() != 2 || model.pendingChord != "g" {
+		t.Fatalf("Expected a single g to wait for the chord, got cursor %d", model.taskList.Cursor())
+	}
+	model = pressKey(t, model, "g")
+	if model.taskList.Cursor() != 0 || model.pendingChord != "" {
+		t.Errorf("Expected gg to jump to the first task, got cursor %d", model.taskList.Cursor())
+	}
+
+	// A chord interrupted by another key is dropped
+	model = pressKey(t, model, "g")
+	model = pressKey(t, model, "j")
+	model = pressKey(t, model, "g")
+	if model.taskList.Cursor() != 1 || model.pendingChord != "g" {
+		t.Errorf("Expected the interrupted chord to be dropped, got cursor %d", model.taskList.Cursor())
+	}
+}
+
+func TestRemappedFirstLastInGroupView(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.Keybindings["first"] = "home"
+	model.config.TUI.Keybindings["last"] = "end"
+	model.inGroupView = true
+	model.groups = []core.TaskGroup{{Name: "Home"}, {Name: "Work"}, {Name: "Errands"}}
+	model.taskList.SetGroups(model.groups)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	model = updated.(Model)
+	if model.taskList.Cursor() != 2 {
+		t.Errorf("Expected the remapped last key to select the last group, got %d", model.taskList.Cursor())
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyHome})
+	model = updated.(Model)
+	if model.taskList.Cursor() != 0 {
+		t.Errorf("Expected the remapped first key to select the first group, got %d", model.taskList.Cursor())
+	}
+}
+
+func TestPageKeysMoveTaskList(t *testing.T) {
+	model, _ := createLongListModel(t, 100)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	model = updated.(Model)
+	page := model.taskList.Cursor()
+	if page <= 1 {
+		t.Fatalf("Expected ctrl+f to move a page, cursor at %d", page)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	model = updated.(Model)
+	if cursor := model.taskList.Cursor(); cursor != page-page/2 {
+		t.Errorf("Expected ctrl+u to move half a page up to %d, got %d", page-page/2, cursor)
+	}
+
+	// In the task details, page keys scroll the details and keep the task
+	model.viewMode = ViewModeTaskDetail
+	before := model.taskList.Cursor()
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if cursor := updated.(Model).taskList.Cursor(); cursor != before {
+		t.Errorf("Expected the task to stay selected in the details, cursor moved to %d", cursor)
+	}
+}
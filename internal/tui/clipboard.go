@@ -0,0 +1,112 @@
+package tui
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/atotto/clipboard"
+)
+
+// Clipboard receives the text of the copy actions
+type Clipboard interface {
+	WriteText(text string) error
+}
+
+// newClipboard returns the clipboard of the copy actions for tui.clipboard:
+// "osc52" asks the terminal to set it, "library" uses the system clipboard, and
+// "command" (or no value) runs tui.clipboard_command, when set, or else uses the
+// system clipboard
+func newClipboard(mode, command string) Clipboard {
+	switch mode {
+	case "osc52":
+		return osc52Clipboard{out: programOutput, tmux: os.Getenv("TMUX") != ""}
+	case "library":
+		return libraryClipboard{}
+	}
+	if strings.TrimSpace(command) == "" {
+		return libraryClipboard{}
+	}
+	return commandClipboard{command: command}
+}
+
+// libraryClipboard writes to the system clipboard, which may not be reachable
+// over SSH or inside tmux
+type libraryClipboard struct{}
+
+func (libraryClipboard) WriteText(text string) error {
+	return clipboard.WriteAll(text)
+}
+
+// commandClipboard runs a command with the text on its standard input
+type commandClipboard struct {
+	command string
+}
+
+func (c commandClipboard) WriteText(text string) error {
+	parts, err := parseCommandLine(c.command)
+	if err != nil {
+		return fmt.Errorf("clipboard command parsing failed: %w", err)
+	}
+	if len(parts) == 0 {
+		return errors.New("empty clipboard command")
+	}
+
+	// The output is not captured: tools like xclip keep running in the
+	// background with it open
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("clipboard command %q failed: %w", parts[0], err)
+	}
+	return nil
+}
+
+// osc52Clipboard emits the OSC52 escape sequence that asks the terminal to set
+// its clipboard, which also works through SSH
+type osc52Clipboard struct {
+	out  io.Writer
+	tmux bool // Wrap the sequence for tmux to pass it through to the terminal
+}
+
+func (c osc52Clipboard) WriteText(text string) error {
+	_, err := io.WriteString(c.out, osc52Sequence(text, c.tmux))
+	return err
+}
+
+// programOutput is the terminal output of the TUI program. The OSC52 clipboard
+// writes to it too, from the goroutine of its command.
+var programOutput = &terminalOutput{File: os.Stdout}
+
+// terminalOutput serializes the writes to a terminal. The renderer writes each
+// frame at once, so an OSC52 sequence lands between two frames instead of in
+// the middle of one. It is still an *os.File for the terminal setup.
+type terminalOutput struct {
+	*os.File
+	mu sync.Mutex
+}
+
+func (o *terminalOutput) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.File.Write(p)
+}
+
+func (o *terminalOutput) WriteString(s string) (int, error) {
+	return o.Write([]byte(s))
+}
+
+// osc52Sequence returns the OSC52 sequence setting the clipboard to text. Inside
+// tmux, the sequence is wrapped in a DCS passthrough with its escapes doubled.
+func osc52Sequence(text string, tmux bool) string {
+	sequence := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+	if !tmux {
+		return sequence
+	}
+	return "\x1bPtmux;" + strings.ReplaceAll(sequence, "\x1b", "\x1b\x1b") + "\x1b\\"
+}
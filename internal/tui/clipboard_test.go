@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestClipboardCommand(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "clipboard")
+	cb := newClipboard("", `sh -c "cat > `+out+`"`)
+	if _, ok := cb.(commandClipboard); !ok {
+		t.Fatalf("Expected clipboard_command to be used, got %T", cb)
+	}
+
+	if err := cb.WriteText("Fix login crash\nWrite report"); err != nil {
+		t.Fatalf("Expected the command to succeed, got %v", err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("Expected the command to write the text, got %v", err)
+	}
+	if string(data) != "Fix login crash\nWrite report" {
+		t.Errorf("Expected the copied text on stdin, got %q", data)
+	}
+
+	if err := (commandClipboard{command: "false"}).WriteText("text"); err == nil {
+		t.Error("Expected a failing command to return an error")
+	}
+}
+
+func TestNewClipboard(t *testing.T) {
+	tests := []struct {
+		mode, command string
+		expected      Clipboard
+	}{
+		{"", "", libraryClipboard{}},
+		{"library", "wl-copy", libraryClipboard{}},
+		{"command", "", libraryClipboard{}},
+		{"command", "wl-copy", commandClipboard{command: "wl-copy"}},
+	}
+	for _, tt := range tests {
+		if got := newClipboard(tt.mode, tt.command); got != tt.expected {
+			t.Errorf("newClipboard(%q, %q) = %#v, expected %#v", tt.mode, tt.command, got, tt.expected)
+		}
+	}
+	if _, ok := newClipboard("osc52", "wl-copy").(osc52Clipboard); !ok {
+		t.Error("Expected osc52 to take precedence over clipboard_command")
+	}
+}
+
+func TestOSC52Sequence(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		tmux     bool
+		expected string
+	}{
+		{"plain", "hello", false, "\x1b]52;c;aGVsbG8=\a"},
+		{"empty", "", false, "\x1b]52;c;\a"},
+		{"multiline", "Fix login crash\nWrite report", false, "\x1b]52;c;Rml4IGxvZ2luIGNyYXNoCldyaXRlIHJlcG9ydA==\a"},
+		{"unicode", "Caffè ✓", false, "\x1b]52;c;Q2FmZsOoIOKckw==\a"},
+		{"tmux", "hello", true, "\x1bPtmux;\x1b\x1b]52;c;aGVsbG8=\a\x1b\\"},
+	}
+	for _, tt := range tests {
+		if got := osc52Sequence(tt.text, tt.tmux); got != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestOSC52ClipboardWritesSequence(t *testing.T) {
+	var out strings.Builder
+	if err := (osc52Clipboard{out: &out}).WriteText("hello"); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "\x1b]52;c;aGVsbG8=\a" {
+		t.Errorf("Expected the OSC52 sequence on the terminal, got %q", out.String())
+	}
+}
+
+func TestTerminalOutputSerializesWrites(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	out := &terminalOutput{File: w}
+
+	frame := strings.Repeat("frame ", 2000) + "\n"
+	sequence := osc52Sequence("hello", false)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() { defer wg.Done(); _, _ = out.Write([]byte(frame)) }()
+		go func() { defer wg.Done(); _ = (osc52Clipboard{out: out}).WriteText("hello") }()
+	}
+	go func() { wg.Wait(); w.Close() }()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rest := strings.ReplaceAll(strings.ReplaceAll(string(data), frame, ""), sequence, "")
+	if rest != "" || strings.Count(string(data), sequence) != 10 {
+		t.Errorf("Expected whole frames and sequences, got %d bytes left over", len(rest))
+	}
+}
+
+func TestCopyActionsUseClipboardCommand(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "clipboard")
+	cfg := config.DefaultConfig()
+	cfg.TUI.ClipboardCommand = `sh -c "cat > ` + out + `"`
+	model := NewModel(&core.MockTaskService{}, cfg)
+	model, _ = loadTasks(model, []core.Task{{UUID: "test-uuid-1", Description: "Fix login crash"}})
+
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{"Y", "Fix login crash"},
+		{"M", "Fix login crash"},
+		{"C", "- [ ] Fix login crash"},
+		{"y", "task "},
+	}
+	for _, tt := range tests {
+		updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.key)})
+		model = updated.(Model)
+		if cmd == nil {
+			t.Fatalf("%s: expected a copy command", tt.key)
+		}
+		if msg, ok := cmd().(StatusMsg); !ok || msg.IsError {
+			t.Fatalf("%s: expected the copy to succeed, got %+v", tt.key, msg)
+		}
+		data, err := os.ReadFile(out)
+		if err != nil {
+			t.Fatalf("%s: expected the configured command to run, got %v", tt.key, err)
+		}
+		if !strings.Contains(string(data), tt.expected) {
+			t.Errorf("%s: expected %q to be copied, got %q", tt.key, tt.expected, data)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// nextSortKey returns the column sort after key: each of components.SortKeys,
+// then none (the tab's own sort)
+func nextSortKey(key string) string {
+	i := slices.Index(components.SortKeys, key)
+	if i+1 < len(components.SortKeys) {
+		return components.SortKeys[i+1]
+	}
+	return ""
+}
+
+// cycleSortKey sorts the task list by the next column
+func (m Model) cycleSortKey() (tea.Model, tea.Cmd) {
+	key, descending := m.taskList.SortKey()
+	m.taskList.SetSortKey(nextSortKey(key), descending)
+	m.resortTasks()
+	m.statusMessage = m.sortStatus()
+	return m, nil
+}
+
+// toggleSortDirection reverses the column sort of the task list
+func (m Model) toggleSortDirection() (tea.Model, tea.Cmd) {
+	key, descending := m.taskList.SortKey()
+	if key == "" {
+		key = components.SortKeys[0]
+	}
+	m.taskList.SetSortKey(key, !descending)
+	m.resortTasks()
+	m.statusMessage = m.sortStatus()
+	return m, nil
+}
+
+// sortStatus describes the column sort of the task list
+func (m Model) sortStatus() string {
+	key, descending := m.taskList.SortKey()
+	if key == "" {
+		return m.text(msgSortCleared)
+	}
+	direction := "ascending"
+	if descending {
+		direction = "descending"
+	}
+	return m.text(msgSortedBy, key, direction)
+}
@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestNextSortKey(t *testing.T) {
+	key := ""
+	var keys []string
+	for range 7 {
+		key = nextSortKey(key)
+		keys = append(keys, key)
+	}
+	expected := []string{"id", "due", "priority", "project", "urgency", "description", ""}
+	for i := range expected {
+		if keys[i] != expected[i] {
+			t.Errorf("Expected sort key %d to be %q, got %q", i, expected[i], keys[i])
+		}
+	}
+}
+
+func TestSortKeys(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.tasks[0].ID, model.tasks[1].ID, model.tasks[2].ID = 3, 1, 2
+	model.taskList.SetTasks(model.tasks)
+
+	model = pressKey(t, model, "I")
+	if key, descending := model.taskList.SortKey(); key != "id" || descending {
+		t.Fatalf("Expected an ascending id sort, got %q descending=%v", key, descending)
+	}
+	if got := model.taskList.SelectedTask().UUID; got != "test-uuid-2" {
+		t.Errorf("Expected task 1 first, got %s", got)
+	}
+	if model.statusMessage != "Sorted by id (ascending)" {
+		t.Errorf("Expected the sort in the status bar, got %q", model.statusMessage)
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	model = updated.(Model)
+	if key, descending := model.taskList.SortKey(); key != "id" || !descending {
+		t.Fatalf("Expected a descending id sort, got %q descending=%v", key, descending)
+	}
+	if got := model.taskList.SelectedTask().UUID; got != "test-uuid-1" {
+		t.Errorf("Expected task 3 first, got %s", got)
+	}
+}
@@ -0,0 +1,43 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// commandLogSize is the number of command lines kept until the message history shows them
+const commandLogSize = 100
+
+// commandLogger is implemented by services that run task commands and can
+// report their command lines, like the Taskwarrior client
+type commandLogger interface {
+	SetCommandLog(commands chan<- string)
+}
+
+// startCommandLog makes service report the task commands it runs when enabled
+// (tui.show_commands). It returns nil when the commands are not shown.
+func startCommandLog(service core.TaskService, enabled bool) <-chan string {
+	logger, ok := service.(commandLogger)
+	if !enabled || !ok {
+		return nil
+	}
+	commands := make(chan string, commandLogSize)
+	logger.SetCommandLog(commands)
+	return commands
+}
+
+// waitForCommand waits for the next command line reported by the service
+func waitForCommand(commands <-chan string) tea.Cmd {
+	if commands == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		return CommandRunMsg{Command: <-commands}
+	}
+}
+
+// showCommand adds a command line to the message history and waits for the next one
+func (m *Model) showCommand(msg CommandRunMsg) tea.Cmd {
+	m.appendMessage(messageEntry{Time: core.Now(), Message: msg.Command, Output: true})
+	return waitForCommand(m.commandLog)
+}
@@ -0,0 +1,61 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// loggingService is a service that reports the task commands it runs
+type loggingService struct {
+	core.MockTaskService
+	commands chan<- string
+}
+
+func (s *loggingService) SetCommandLog(commands chan<- string) {
+	s.commands = commands
+}
+
+func TestShowCommands(t *testing.T) {
+	service := &loggingService{}
+	service.ExportFunc = func(filter string) ([]core.Task, error) {
+		service.commands <- "task " + filter + " export"
+		return nil, nil
+	}
+	cfg := config.DefaultConfig()
+	cfg.TUI.ShowCommands = true
+	model := NewModel(service, cfg)
+	if service.commands == nil || model.commandLog == nil {
+		t.Fatal("Expected the service to report its commands")
+	}
+
+	if _, err := model.service.Export("+work"); err != nil {
+		t.Fatal(err)
+	}
+	msg := waitForCommand(model.commandLog)()
+	if run, ok := msg.(CommandRunMsg); !ok || run.Command != "task +work export" {
+		t.Fatalf("Expected the export command, got %+v", msg)
+	}
+
+	updated, cmd := model.Update(msg)
+	model = updated.(Model)
+	last := model.messageHistory[len(model.messageHistory)-1]
+	if last.Message != "task +work export" || !last.Output {
+		t.Errorf("Expected the command in the message history, got %+v", last)
+	}
+	if cmd == nil {
+		t.Error("Expected to wait for the next command")
+	}
+}
+
+func TestShowCommandsDisabled(t *testing.T) {
+	service := &loggingService{}
+	model := NewModel(service, config.DefaultConfig())
+	if service.commands != nil || model.commandLog != nil {
+		t.Error("Expected no command log unless show_commands is set")
+	}
+	if waitForCommand(model.commandLog) != nil {
+		t.Error("Expected no command waiting for commands")
+	}
+}
@@ -0,0 +1,56 @@
+package tui
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// captureOutput redirects the stdout and stderr of a command that runs in the
+// background to a buffer
+func captureOutput(c *exec.Cmd) *bytes.Buffer {
+	var output bytes.Buffer
+	c.Stdout = &output
+	c.Stderr = &output
+	return &output
+}
+
+// captureExecOutput copies the stderr of a command run with tea.ExecProcess to a
+// buffer. Stdout is left on the terminal: editors and pagers refuse to run when
+// it is not a tty.
+func captureExecOutput(c *exec.Cmd) *bytes.Buffer {
+	var output bytes.Buffer
+	c.Stderr = io.MultiWriter(os.Stderr, &output)
+	return &output
+}
+
+// withCommandOutput returns msg followed by the output captured from the command
+// named name, when there is any
+func withCommandOutput(msg tea.Msg, name string, output *bytes.Buffer) tea.Msg {
+	if output == nil {
+		return msg
+	}
+	text := strings.TrimSpace(output.String())
+	if text == "" {
+		return msg
+	}
+	return tea.BatchMsg{
+		func() tea.Msg { return msg },
+		func() tea.Msg { return CommandOutputMsg{Name: name, Output: text} },
+	}
+}
+
+// showCommandOutput adds captured command output to the message history and
+// opens it, unless another view is being used
+func (m *Model) showCommandOutput(msg CommandOutputMsg) {
+	m.appendMessage(messageEntry{Time: core.Now(), Message: msg.Name + "\n" + msg.Output, Output: true})
+	if m.state == StateNormal {
+		m.state = StateMessages
+		m.messagesOffset = 0
+	}
+}
@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestCustomCommandCapturesOutput(t *testing.T) {
+	cmd := config.CustomCommand{Name: "Hook", Command: `sh -c "echo hello; echo oops >&2"`, CaptureOutput: true}
+
+	batch, ok := executeCustomCommand(defaultLanguage, cmd, []core.Task{{UUID: "a"}})().(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("Expected a status and the captured output, got %+v", batch)
+	}
+	if status, ok := batch[0]().(StatusMsg); !ok || status.IsError {
+		t.Errorf("Expected a success status, got %+v", status)
+	}
+	output, ok := batch[1]().(CommandOutputMsg)
+	if !ok || output.Name != "Hook" || output.Output != "hello\noops" {
+		t.Errorf("Expected stdout and stderr to be captured, got %+v", output)
+	}
+}
+
+func TestCustomCommandWithoutCapture(t *testing.T) {
+	cmd := config.CustomCommand{Name: "Hook", Command: `sh -c "echo hello"`}
+
+	if msg := executeCustomCommand(defaultLanguage, cmd, []core.Task{{UUID: "a"}})(); msg != (StatusMsg{Message: "Executed: Hook"}) {
+		t.Errorf("Expected the output to be discarded, got %+v", msg)
+	}
+}
+
+func TestCaptureExecOutput(t *testing.T) {
+	c := exec.Command("sh", "-c", "echo failed to edit >&2")
+	output := captureExecOutput(c)
+	if c.Stdout != nil {
+		t.Error("Expected stdout to be left to the terminal")
+	}
+	if err := c.Run(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	msg := withCommandOutput(TaskModifiedMsg{}, "task edit", output)
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("Expected the result and the captured output, got %+v", msg)
+	}
+	if got, ok := batch[1]().(CommandOutputMsg); !ok || got.Output != "failed to edit" {
+		t.Errorf("Expected the stderr output, got %+v", got)
+	}
+
+	// Commands without output only report their result
+	if msg := withCommandOutput(TaskModifiedMsg{}, "task edit", &bytes.Buffer{}); msg != (TaskModifiedMsg{}) {
+		t.Errorf("Expected the result alone, got %+v", msg)
+	}
+}
+
+func TestCommandOutputShownInMessages(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	updated, _ := model.Update(CommandOutputMsg{Name: "Hook", Output: "line one\nline two"})
+	model = updated.(Model)
+	if model.state != StateMessages {
+		t.Fatalf("Expected the message history to open, got state %v", model.state)
+	}
+	view := model.renderMessages()
+	for _, expected := range []string{"$ Hook", "line one", "line two"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the message history, got:\n%s", expected, view)
+		}
+	}
+	if strings.Index(view, "line one") > strings.Index(view, "line two") {
+		t.Error("Expected the output lines in order")
+	}
+}
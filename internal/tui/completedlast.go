@@ -0,0 +1,36 @@
+package tui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// toggleCompletedLast switches between moving completed tasks to the bottom of
+// the list and keeping them where the service (or the section sort) puts them
+func (m Model) toggleCompletedLast() (tea.Model, tea.Cmd) {
+	m.taskList.ToggleCompletedLast()
+	m.resortTasks()
+
+	if m.taskList.CompletedLast() {
+		m.statusMessage = m.text(msgCompletedLastOn)
+	} else {
+		m.statusMessage = m.text(msgCompletedLastOff)
+	}
+	return m, nil
+}
+
+// resortTasks sorts the shown tasks again after a sorting option changed
+func (m *Model) resortTasks() {
+	switch {
+	case m.viewMode == ViewModeProjectPanes:
+		m.syncProjectPaneTasks()
+	case m.inGroupView:
+		// Group lists have no task order to change
+	default:
+		sortMethod := ""
+		reverse := false
+		if m.currentSection != nil {
+			sortMethod = m.currentSection.Sort
+			reverse = m.currentSection.Reverse
+		}
+		m.taskList.SetTasksWithSort(m.listedTasks(), sortMethod, reverse)
+		m.updateSidebar()
+	}
+}
@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestToggleCompletedLastKey(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.tasks = []core.Task{
+		{UUID: "done-1", Description: "Finished", Status: "completed"},
+		{ID: 1, UUID: "pending-1", Description: "Open", Status: "pending"},
+	}
+	model.taskList.SetTasks(model.tasks)
+	if task := model.taskList.SelectedTask(); task.UUID != "pending-1" {
+		t.Fatalf("Expected the completed task at the bottom, got %s first", task.UUID)
+	}
+
+	model = pressKey(t, model, "B")
+	if model.taskList.CompletedLast() {
+		t.Fatal("Expected completed tasks to be kept in place")
+	}
+	model.taskList.SetCursor(0)
+	if task := model.taskList.SelectedTask(); task.UUID != "done-1" {
+		t.Errorf("Expected the service order to be restored, got %s first", task.UUID)
+	}
+	if model.statusMessage != "Completed tasks kept in list order" {
+		t.Errorf("Unexpected status %q", model.statusMessage)
+	}
+
+	// Later loads keep the setting
+	model, _ = loadTasks(model, model.tasks)
+	model.taskList.SetCursor(0)
+	if task := model.taskList.SelectedTask(); task.UUID != "done-1" {
+		t.Errorf("Expected reloaded tasks in service order, got %s first", task.UUID)
+	}
+
+	model = pressKey(t, model, "B")
+	model.taskList.SetCursor(0)
+	if task := model.taskList.SelectedTask(); task.UUID != "pending-1" || !model.taskList.CompletedLast() {
+		t.Errorf("Expected completed tasks back at the bottom, got %s first", task.UUID)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/clobrano/wui/internal/core"
 )
 
 // Calendar is a date picker component that displays a month view calendar
@@ -30,7 +31,7 @@ type CalendarResult struct {
 // NewCalendar creates a new calendar picker
 func NewCalendar(initialDate time.Time) Calendar {
 	if initialDate.IsZero() {
-		initialDate = time.Now()
+		initialDate = core.Now()
 	}
 
 	// Create text input for date editing
@@ -155,7 +156,7 @@ func (c Calendar) Update(msg tea.Msg) (Calendar, tea.Cmd) {
 
 		case "t":
 			// Today
-			today := time.Now()
+			today := core.Now()
 			c.currentMonth = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.Local)
 			c.cursorDay = today.Day()
 			c.updateSelectedDate()
@@ -206,7 +207,7 @@ func (c Calendar) View() string {
 		Foreground(lipgloss.Color("205")).
 		Underline(true)
 
-	today := time.Now()
+	today := core.Now()
 	isCurrentMonth := today.Year() == c.currentMonth.Year() && today.Month() == c.currentMonth.Month()
 
 	// Render calendar grid
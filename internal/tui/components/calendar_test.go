@@ -0,0 +1,26 @@
+package components
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestCalendarTodayUsesClock(t *testing.T) {
+	today := time.Date(2030, 7, 4, 9, 0, 0, 0, time.Local)
+
+	cal := NewCalendar(time.Date(2024, 1, 10, 0, 0, 0, 0, time.Local))
+	core.SetNowFunc(func() time.Time { return today })
+	defer core.SetNowFunc(nil)
+
+	cal, _ = cal.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+
+	if cal.currentMonth.Year() != 2030 || cal.currentMonth.Month() != time.July {
+		t.Errorf("Expected July 2030 after jumping to today, got %v", cal.currentMonth)
+	}
+	if cal.cursorDay != 4 {
+		t.Errorf("Expected cursor on day 4, got %d", cal.cursorDay)
+	}
+}
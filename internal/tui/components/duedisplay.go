@@ -0,0 +1,41 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// Values of tui.due_display
+const (
+	DueDisplayAbsolute = "absolute" // Date as YYYY-MM-DD or YYYY-MM-DD HH:MM (default)
+	DueDisplayRelative = "relative" // Compact time from now, e.g. "in 2d" or "3d ago"
+)
+
+// relativeDueWidth is the due column width in relative mode; the longest
+// value is "59min ago"
+const relativeDueWidth = 9 + 1
+
+// formatRelativeDue words the compact relative time of a due date,
+// e.g. "in 2d" or "3d ago". Unlike formatRelativeCompact, weeks start at
+// 14 days, so that the next two weeks read in days.
+func formatRelativeDue(due time.Time) string {
+	now := core.Now()
+	token := relativeCompactFrom(due, now)
+	if days := int(due.Sub(now).Abs() / (24 * time.Hour)); days >= 7 && days < 14 {
+		token = fmt.Sprintf("%dd", days)
+		if due.Before(now) {
+			token = "-" + token
+		}
+	}
+	switch {
+	case token == "now":
+		return token
+	case strings.HasPrefix(token, "-"):
+		return token[1:] + " ago"
+	default:
+		return "in " + token
+	}
+}
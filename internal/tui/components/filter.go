@@ -32,8 +32,7 @@ func NewFilter() Filter {
 
 // Focus sets focus on the filter input
 func (f *Filter) Focus() tea.Cmd {
-	f.textArea.Focus()
-	return nil
+	return f.textArea.Focus()
 }
 
 // Blur removes focus from the filter input
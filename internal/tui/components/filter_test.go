@@ -9,11 +9,11 @@ import (
 func TestNewFilter(t *testing.T) {
 	filter := NewFilter()
 
-	if filter.textInput.Value() != "" {
-		t.Errorf("expected empty initial value, got %q", filter.textInput.Value())
+	if filter.textArea.Value() != "" {
+		t.Errorf("expected empty initial value, got %q", filter.textArea.Value())
 	}
 
-	if filter.textInput.Placeholder == "" {
+	if filter.textArea.Placeholder == "" {
 		t.Error("expected non-empty placeholder")
 	}
 }
@@ -22,13 +22,13 @@ func TestFilter_Focus(t *testing.T) {
 	filter := NewFilter()
 
 	// Initially should not be focused
-	if filter.textInput.Focused() {
+	if filter.textArea.Focused() {
 		t.Error("expected filter to not be focused initially")
 	}
 
 	// Focus should work
 	filter.Focus()
-	if !filter.textInput.Focused() {
+	if !filter.textArea.Focused() {
 		t.Error("expected filter to be focused after Focus()")
 	}
 }
@@ -38,7 +38,7 @@ func TestFilter_Blur(t *testing.T) {
 	filter.Focus()
 
 	filter.Blur()
-	if filter.textInput.Focused() {
+	if filter.textArea.Focused() {
 		t.Error("expected filter to not be focused after Blur()")
 	}
 }
@@ -161,9 +161,14 @@ func defaultKeybindingGroups() []KeybindingGroup {
 			Bindings: []Keybinding{
 				{Keys: []string{"j", "↓"}, Description: "Move down"},
 				{Keys: []string{"k", "↑"}, Description: "Move up"},
-				{Keys: []string{"g"}, Description: "Jump to first task"},
-				{Keys: []string{"G"}, Description: "Jump to last task"},
+				{Keys: []string{"g"}, Description: "Jump to first task or group"},
+				{Keys: []string{"G"}, Description: "Jump to last task or group"},
+				{Keys: []string{"Ctrl+d"}, Description: "Half page down"},
+				{Keys: []string{"Ctrl+u"}, Description: "Half page up"},
+				{Keys: []string{"Ctrl+f", "PgDn"}, Description: "Page down"},
+				{Keys: []string{"Ctrl+b", "PgUp"}, Description: "Page up"},
 				{Keys: []string{"1-9"}, Description: "Quick jump to task"},
+				{Keys: []string{"f"}, Description: "Jump to a labeled row (with jump_labels)"},
 			},
 		},
 		{
@@ -172,6 +177,7 @@ func defaultKeybindingGroups() []KeybindingGroup {
 				{Keys: []string{"Tab", "l", "→"}, Description: "Next section"},
 				{Keys: []string{"Shift+Tab", "h", "←"}, Description: "Previous section"},
 				{Keys: []string{"1-5"}, Description: "Jump to section"},
+				{Keys: []string{"0"}, Description: "List tabs hidden by max_visible_tabs"},
 			},
 		},
 		{
@@ -189,11 +195,25 @@ func defaultKeybindingGroups() []KeybindingGroup {
 				{Keys: []string{"x"}, Description: "Delete task(s)"},
 				{Keys: []string{"e"}, Description: "Edit task in $EDITOR"},
 				{Keys: []string{"n"}, Description: "Create new task"},
+				{Keys: []string{"R"}, Description: "Create new recurring task"},
+				{Keys: []string{"A"}, Description: "Add a subtask linked by a dependency"},
 				{Keys: []string{"m"}, Description: "Modify task(s) (quick edit)"},
 				{Keys: []string{"M"}, Description: "Export task(s) as markdown (to clipboard)"},
+				{Keys: []string{"Y"}, Description: "Copy task description(s) to clipboard"},
+				{Keys: []string{"C"}, Description: "Export task(s) and dependencies as a GitHub checklist (to clipboard)"},
 				{Keys: []string{"a"}, Description: "Add annotation to task(s)"},
+				{Keys: []string{"F"}, Description: "Annotate with the clipboard path or working directory"},
 				{Keys: []string{"o"}, Description: "Open URL from annotations"},
+				{Keys: []string{"w"}, Description: "Set due date from presets"},
+				{Keys: []string{"D"}, Description: "Set due date of task(s) in the calendar"},
+				{Keys: []string{"W"}, Description: "Clear due date of task(s)"},
+				{Keys: []string{"P"}, Description: "Assign task(s) to a project"},
+				{Keys: []string{">", "<"}, Description: "Raise/lower priority (none, L, M, H)"},
+				{Keys: []string{"]", "["}, Description: "Increment/decrement counter UDA"},
 				{Keys: []string{"u"}, Description: "Undo last operation"},
+				{Keys: []string{"O"}, Description: "Reopen completed task(s)"},
+				{Keys: []string{"ctrl+a"}, Description: "Archive the completed tasks shown"},
+				{Keys: []string{"c"}, Description: "Duplicate task(s)"},
 			},
 		},
 		{
@@ -202,7 +222,25 @@ func defaultKeybindingGroups() []KeybindingGroup {
 				{Keys: []string{"Enter"}, Description: "Toggle sidebar / Drill into group"},
 				{Keys: []string{"Esc"}, Description: "Close sidebar / Back to group list"},
 				{Keys: []string{"/"}, Description: "Filter tasks"},
+				{Keys: []string{"="}, Description: "Filter to the selected task's project"},
+				{Keys: []string{"+"}, Description: "Filter to the selected task's first tag"},
+				{Keys: []string{"Ctrl+g"}, Description: "Search all tasks without leaving the tab"},
 				{Keys: []string{"r"}, Description: "Refresh task list"},
+				{Keys: []string{"S"}, Description: "Run task sync and refresh"},
+				{Keys: []string{"Ctrl+s"}, Description: "Sync Google Calendar now"},
+				{Keys: []string{"T"}, Description: "Open a task shell, refresh on exit"},
+				{Keys: []string{"y"}, Description: "Copy filter as a task command"},
+				{Keys: []string{"Ctrl+y"}, Description: "Copy tab, task count and filter"},
+				{Keys: []string{"Ctrl+x"}, Description: "Choose the Taskwarrior context"},
+				{Keys: []string{"p"}, Description: "Toggle two-pane Projects view"},
+				{Keys: []string{"v"}, Description: "Cycle view modes (list, sidebar, ...)"},
+				{Keys: []string{"i"}, Description: "Peek at task (any key closes)"},
+				{Keys: []string{"z"}, Description: "Focus on task and its dependencies (again to show all)"},
+				{Keys: []string{"U"}, Description: "Toggle long UUIDs for tasks without an ID"},
+				{Keys: []string{"B"}, Description: "Toggle completed tasks at the bottom"},
+				{Keys: []string{"I"}, Description: "Sort by next column (id, due, priority, project, urgency, description)"},
+				{Keys: []string{"ctrl+r"}, Description: "Reverse column sort"},
+				{Keys: []string{"!"}, Description: "Toggle confirmations for destructive actions"},
 			},
 		},
 		{
@@ -214,12 +252,15 @@ func defaultKeybindingGroups() []KeybindingGroup {
 				{Keys: []string{"Ctrl+u"}, Description: "Jump to top"},
 				{Keys: []string{"Ctrl+f", "PgDn"}, Description: "Scroll down full page"},
 				{Keys: []string{"Ctrl+b", "PgUp"}, Description: "Scroll up full page"},
+				{Keys: []string{"Ctrl+n", "Ctrl+p"}, Description: "Highlight next / previous file"},
+				{Keys: []string{"Ctrl+o"}, Description: "Open highlighted file"},
 			},
 		},
 		{
 			Title: "Other",
 			Bindings: []Keybinding{
 				{Keys: []string{"?"}, Description: "Toggle this help screen"},
+				{Keys: []string{"E"}, Description: "Show recent status and error messages"},
 				{Keys: []string{"q"}, Description: "Quit wui"},
 				{Keys: []string{"Ctrl+c"}, Description: "Force quit"},
 			},
@@ -243,11 +284,14 @@ func keybindingGroupsFromConfig(keybindings map[string]string) []KeybindingGroup
 			Bindings: []Keybinding{
 				{Keys: []string{getKey("down", "j"), "↓"}, Description: "Move down"},
 				{Keys: []string{getKey("up", "k"), "↑"}, Description: "Move up"},
-				{Keys: []string{getKey("first", "g")}, Description: "Jump to first task"},
-				{Keys: []string{getKey("last", "G")}, Description: "Jump to last task"},
-				{Keys: []string{getKey("page_down", "ctrl+d")}, Description: "Page down"},
-				{Keys: []string{getKey("page_up", "ctrl+u")}, Description: "Page up"},
+				{Keys: []string{getKey("first", "g")}, Description: "Jump to first task or group"},
+				{Keys: []string{getKey("last", "G")}, Description: "Jump to last task or group"},
+				{Keys: []string{getKey("page_down", "ctrl+d")}, Description: "Half page down"},
+				{Keys: []string{getKey("page_up", "ctrl+u")}, Description: "Half page up"},
+				{Keys: []string{"ctrl+f", "pgdown"}, Description: "Page down"},
+				{Keys: []string{"ctrl+b", "pgup"}, Description: "Page up"},
 				{Keys: []string{"1-9"}, Description: "Quick jump to task"},
+				{Keys: []string{getKey("jump", "f")}, Description: "Jump to a labeled row (with jump_labels)"},
 			},
 		},
 		{
@@ -256,6 +300,7 @@ func keybindingGroupsFromConfig(keybindings map[string]string) []KeybindingGroup
 				{Keys: []string{"Tab", "l", "→"}, Description: "Next section"},
 				{Keys: []string{"Shift+Tab", "h", "←"}, Description: "Previous section"},
 				{Keys: []string{"1-5"}, Description: "Jump to section"},
+				{Keys: []string{getKey("more_tabs", "0")}, Description: "List tabs hidden by max_visible_tabs"},
 			},
 		},
 		{
@@ -273,12 +318,26 @@ func keybindingGroupsFromConfig(keybindings map[string]string) []KeybindingGroup
 				{Keys: []string{getKey("delete", "x")}, Description: "Delete task(s)"},
 				{Keys: []string{getKey("edit", "e")}, Description: "Edit task in $EDITOR"},
 				{Keys: []string{getKey("new", "n")}, Description: "Create new task"},
+				{Keys: []string{getKey("new_recurring", "R")}, Description: "Create new recurring task"},
+				{Keys: []string{getKey("add_subtask", "A")}, Description: "Add a subtask linked by a dependency"},
 				{Keys: []string{getKey("modify", "m")}, Description: "Modify task(s) (quick edit)"},
 				{Keys: []string{"M"}, Description: "Export task(s) as markdown (to clipboard)"},
+				{Keys: []string{getKey("yank_description", "Y")}, Description: "Copy task description(s) to clipboard"},
+				{Keys: []string{getKey("export_checklist", "C")}, Description: "Export task(s) and dependencies as a GitHub checklist (to clipboard)"},
 				{Keys: []string{getKey("annotate", "a")}, Description: "Add annotation to task(s)"},
+				{Keys: []string{getKey("annotate_path", "F")}, Description: "Annotate with the clipboard path or working directory"},
 				{Keys: []string{getKey("todo", "t")}, Description: "Add TODO annotation to task(s)"},
 				{Keys: []string{getKey("open_url", "o")}, Description: "Open URL from annotations"},
+				{Keys: []string{getKey("due_presets", "w")}, Description: "Set due date from presets"},
+				{Keys: []string{getKey("set_due", "D")}, Description: "Set due date of task(s) in the calendar"},
+				{Keys: []string{getKey("clear_due", "W")}, Description: "Clear due date of task(s)"},
+				{Keys: []string{getKey("assign_project", "P")}, Description: "Assign task(s) to a project"},
+				{Keys: []string{getKey("priority_up", ">"), getKey("priority_down", "<")}, Description: "Raise/lower priority (none, L, M, H)"},
+				{Keys: []string{getKey("counter_up", "]"), getKey("counter_down", "[")}, Description: "Increment/decrement counter UDA"},
 				{Keys: []string{getKey("undo", "u")}, Description: "Undo last operation"},
+				{Keys: []string{getKey("reopen", "O")}, Description: "Reopen completed task(s)"},
+				{Keys: []string{getKey("archive", "ctrl+a")}, Description: "Archive the completed tasks shown"},
+				{Keys: []string{getKey("duplicate", "c")}, Description: "Duplicate task(s)"},
 			},
 		},
 		{
@@ -287,7 +346,26 @@ func keybindingGroupsFromConfig(keybindings map[string]string) []KeybindingGroup
 				{Keys: []string{"Enter"}, Description: "Toggle sidebar / Drill into group"},
 				{Keys: []string{"Esc"}, Description: "Close sidebar / Back to group list"},
 				{Keys: []string{getKey("filter", "/")}, Description: "Filter tasks"},
+				{Keys: []string{getKey("filter_project", "=")}, Description: "Filter to the selected task's project"},
+				{Keys: []string{getKey("filter_tag", "+")}, Description: "Filter to the selected task's first tag"},
+				{Keys: []string{getKey("global_search", "ctrl+g")}, Description: "Search all tasks without leaving the tab"},
+				{Keys: []string{getKey("fuzzy_find", "ctrl+k")}, Description: "Fuzzy find in the loaded tasks"},
 				{Keys: []string{getKey("refresh", "r")}, Description: "Refresh task list"},
+				{Keys: []string{getKey("task_sync", "S")}, Description: "Run task sync and refresh"},
+				{Keys: []string{getKey("calendar_sync", "ctrl+s")}, Description: "Sync Google Calendar now"},
+				{Keys: []string{getKey("task_shell", "T")}, Description: "Open a task shell, refresh on exit"},
+				{Keys: []string{getKey("copy_filter", "y")}, Description: "Copy filter as a task command"},
+				{Keys: []string{getKey("copy_status", "ctrl+y")}, Description: "Copy tab, task count and filter"},
+				{Keys: []string{getKey("context", "ctrl+x")}, Description: "Choose the Taskwarrior context"},
+				{Keys: []string{getKey("project_panes", "p")}, Description: "Toggle two-pane Projects view"},
+				{Keys: []string{getKey("cycle_view", "v")}, Description: "Cycle view modes (list, sidebar, ...)"},
+				{Keys: []string{getKey("peek", "i")}, Description: "Peek at task (any key closes)"},
+				{Keys: []string{getKey("focus_task", "z")}, Description: "Focus on task and its dependencies (again to show all)"},
+				{Keys: []string{getKey("toggle_uuids", "U")}, Description: "Toggle long UUIDs for tasks without an ID"},
+				{Keys: []string{getKey("toggle_completed_last", "B")}, Description: "Toggle completed tasks at the bottom"},
+				{Keys: []string{getKey("sort_column", "I")}, Description: "Sort by next column (id, due, priority, project, urgency, description)"},
+				{Keys: []string{getKey("sort_reverse", "ctrl+r")}, Description: "Reverse column sort"},
+				{Keys: []string{getKey("toggle_confirm", "!")}, Description: "Toggle confirmations for destructive actions"},
 			},
 		},
 		{
@@ -299,12 +377,15 @@ func keybindingGroupsFromConfig(keybindings map[string]string) []KeybindingGroup
 				{Keys: []string{"Ctrl+u"}, Description: "Jump to top"},
 				{Keys: []string{"Ctrl+f", "PgDn"}, Description: "Scroll down full page"},
 				{Keys: []string{"Ctrl+b", "PgUp"}, Description: "Scroll up full page"},
+				{Keys: []string{"Ctrl+n", "Ctrl+p"}, Description: "Highlight next / previous file"},
+				{Keys: []string{"Ctrl+o"}, Description: "Open highlighted file"},
 			},
 		},
 		{
 			Title: "Other",
 			Bindings: []Keybinding{
 				{Keys: []string{getKey("help", "?")}, Description: "Toggle this help screen"},
+				{Keys: []string{getKey("messages", "E")}, Description: "Show recent status and error messages"},
 				{Keys: []string{getKey("quit", "q")}, Description: "Quit wui"},
 				{Keys: []string{"Ctrl+c"}, Description: "Force quit"},
 			},
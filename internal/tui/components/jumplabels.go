@@ -0,0 +1,99 @@
+package components
+
+import "strings"
+
+// jumpAlphabet lists the keys used in jump labels, home row first
+const jumpAlphabet = "asdfghjklqwertyuiopzxcvbnm"
+
+// jumpLabels returns labels for count rows. All labels have the same length, one
+// letter while the alphabet suffices and two letters otherwise, so that no label
+// is the prefix of another. Rows beyond the two-letter combinations get none.
+func jumpLabels(count int) []string {
+	n := len(jumpAlphabet)
+	labels := make([]string, 0, min(count, n*n))
+	for i := 0; i < count && i < n*n; i++ {
+		if count <= n {
+			labels = append(labels, jumpAlphabet[i:i+1])
+		} else {
+			labels = append(labels, string(jumpAlphabet[i/n])+string(jumpAlphabet[i%n]))
+		}
+	}
+	return labels
+}
+
+// resolveJumpLabel returns the position of label among labels
+func resolveJumpLabel(labels []string, label string) (int, bool) {
+	for i, l := range labels {
+		if l == label {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// visibleItemRange returns the start and end indices of the tasks or groups in the viewport
+func (t TaskList) visibleItemRange() (start, end int) {
+	if t.displayMode == DisplayModeTasks && !t.needsSmallScreenMode() {
+		return t.getVisibleTaskRange()
+	}
+	total, visible := t.scrollExtent()
+	return t.offset, min(t.offset+visible, total)
+}
+
+// StartJump draws a label next to each visible row; typing a label moves the cursor there
+func (t *TaskList) StartJump() {
+	t.jumpActive = true
+	t.jumpBuffer = ""
+}
+
+// JumpActive returns true while jump labels are shown
+func (t TaskList) JumpActive() bool {
+	return t.jumpActive
+}
+
+// CancelJump hides the jump labels
+func (t *TaskList) CancelJump() {
+	t.jumpActive = false
+	t.jumpBuffer = ""
+}
+
+// JumpKey adds a typed key to the jump buffer. When the buffer spells a label the
+// cursor moves to its row; a key that no label continues with cancels the jump.
+func (t *TaskList) JumpKey(key string) {
+	start, end := t.visibleItemRange()
+	labels := jumpLabels(end - start)
+	typed := t.jumpBuffer + key
+
+	if i, ok := resolveJumpLabel(labels, typed); ok {
+		t.CancelJump()
+		t.cursor = start + i
+		t.updateScroll()
+		return
+	}
+	for _, label := range labels {
+		if strings.HasPrefix(label, typed) {
+			t.jumpBuffer = typed
+			return
+		}
+	}
+	t.CancelJump()
+}
+
+// jumpLabelFor returns the label drawn next to the item at index, padded to the
+// width of the labels, or "" when jump labels are hidden. Rows whose label does
+// not continue the keys typed so far show blanks.
+func (t TaskList) jumpLabelFor(index int) string {
+	if !t.jumpActive {
+		return ""
+	}
+	start, end := t.visibleItemRange()
+	labels := jumpLabels(end - start)
+	if len(labels) == 0 {
+		return ""
+	}
+	width := len(labels[0])
+	if index < start || index-start >= len(labels) || !strings.HasPrefix(labels[index-start], t.jumpBuffer) {
+		return strings.Repeat(" ", width)
+	}
+	return labels[index-start]
+}
@@ -0,0 +1,129 @@
+package components
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestJumpLabels(t *testing.T) {
+	if got := jumpLabels(3); !reflect.DeepEqual(got, []string{"a", "s", "d"}) {
+		t.Errorf("Expected single letter labels, got %v", got)
+	}
+
+	// Beyond the alphabet, every label has two letters
+	labels := jumpLabels(30)
+	if len(labels) != 30 || labels[0] != "aa" || labels[1] != "as" || labels[26] != "sa" {
+		t.Errorf("Expected two letter labels, got %v", labels)
+	}
+	for i, a := range labels {
+		for j, b := range labels {
+			if i != j && strings.HasPrefix(b, a) {
+				t.Fatalf("Expected no label to prefix another, got %q and %q", a, b)
+			}
+		}
+	}
+
+	if got := len(jumpLabels(1000)); got != len(jumpAlphabet)*len(jumpAlphabet) {
+		t.Errorf("Expected labels to stop at the two letter combinations, got %d", got)
+	}
+}
+
+func TestResolveJumpLabel(t *testing.T) {
+	labels := jumpLabels(30)
+	tests := []struct {
+		label string
+		index int
+		found bool
+	}{
+		{"aa", 0, true},
+		{"as", 1, true},
+		{"sa", 26, true},
+		{"sd", 28, true},
+		{"a", 0, false},
+		{"zz", 0, false},
+	}
+
+	for _, tt := range tests {
+		index, found := resolveJumpLabel(labels, tt.label)
+		if found != tt.found || index != tt.index {
+			t.Errorf("resolveJumpLabel(%q) = %d, %v, expected %d, %v", tt.label, index, found, tt.index, tt.found)
+		}
+	}
+}
+
+// createJumpTaskList returns a task list showing count tasks
+func createJumpTaskList(count int) TaskList {
+	var tasks []core.Task
+	for i := range count {
+		tasks = append(tasks, core.Task{ID: i + 1, UUID: fmt.Sprintf("uuid-%d", i), Description: fmt.Sprintf("Task %d", i), Status: "pending"})
+	}
+	tl := NewTaskList(100, count+2, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	tl.SetTasks(tasks)
+	return tl
+}
+
+// typeKeys sends each rune of keys to the task list
+func typeKeys(tl TaskList, keys string) TaskList {
+	for _, r := range keys {
+		tl, _ = tl.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return tl
+}
+
+func TestJumpToLabeledRow(t *testing.T) {
+	tl := createJumpTaskList(30)
+
+	tl.StartJump()
+	if !tl.JumpActive() {
+		t.Fatal("Expected jump labels to be shown")
+	}
+	if view := tl.View(); !strings.Contains(view, "sd") {
+		t.Errorf("Expected jump labels next to the rows, got:\n%s", view)
+	}
+
+	tl = typeKeys(tl, "s")
+	if !tl.JumpActive() || tl.SelectedIndex() != 0 {
+		t.Fatal("Expected the jump to wait for the second key")
+	}
+	if view := tl.View(); strings.Contains(view, "aa") {
+		t.Errorf("Expected labels not starting with the typed key to be hidden, got:\n%s", view)
+	}
+
+	tl = typeKeys(tl, "d")
+	if tl.JumpActive() {
+		t.Error("Expected the labels to hide after the jump")
+	}
+	if tl.SelectedIndex() != 28 {
+		t.Errorf("Expected the cursor on row 28, got %d", tl.SelectedIndex())
+	}
+}
+
+func TestJumpCancel(t *testing.T) {
+	tl := createJumpTaskList(5)
+
+	// A key that starts no label cancels the jump
+	tl.StartJump()
+	tl = typeKeys(tl, "z")
+	if tl.JumpActive() || tl.SelectedIndex() != 0 {
+		t.Errorf("Expected the jump to be cancelled, cursor at %d", tl.SelectedIndex())
+	}
+
+	tl.StartJump()
+	tl, _ = tl.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if tl.JumpActive() {
+		t.Error("Expected esc to hide the labels")
+	}
+
+	// Single letter labels jump at once
+	tl.StartJump()
+	tl = typeKeys(tl, "f")
+	if tl.JumpActive() || tl.SelectedIndex() != 3 {
+		t.Errorf("Expected the cursor on row 3, got %d", tl.SelectedIndex())
+	}
+}
@@ -0,0 +1,46 @@
+package components
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// compactUnits are the units of compact relative times, largest first
+var compactUnits = []struct {
+	suffix string
+	size   time.Duration
+}{
+	{"y", 365 * 24 * time.Hour},
+	{"mo", 30 * 24 * time.Hour},
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"min", time.Minute},
+}
+
+// formatRelativeCompact returns a short token for the time between now and t,
+// for narrow columns and badges: "2d" in the future, "-2d" in the past, or "now".
+// Unlike the sidebar's formatRelativeTime it uses a single abbreviated unit.
+func formatRelativeCompact(t time.Time) string {
+	return relativeCompactFrom(t, core.Now())
+}
+
+// relativeCompactFrom returns the compact token for t computed against now.
+// A unit is used once a whole one has passed, so 23h59m is "23h" and 24h is "1d".
+func relativeCompactFrom(t, now time.Time) string {
+	diff := t.Sub(now)
+	sign := ""
+	if diff < 0 {
+		diff = -diff
+		sign = "-"
+	}
+
+	for _, unit := range compactUnits {
+		if n := int(diff / unit.size); n > 0 {
+			return fmt.Sprintf("%s%d%s", sign, n, unit.suffix)
+		}
+	}
+	return "now"
+}
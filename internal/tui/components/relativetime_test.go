@@ -0,0 +1,43 @@
+package components
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeCompact(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		name     string
+		offset   time.Duration
+		expected string
+	}{
+		{"same time", 0, "now"},
+		{"under a minute ahead", 59 * time.Second, "now"},
+		{"under a minute ago", -59 * time.Second, "now"},
+		{"minutes ahead", 5 * time.Minute, "5min"},
+		{"minutes ago", -5 * time.Minute, "-5min"},
+		{"hours ahead", 3 * time.Hour, "3h"},
+		{"hours ago", -3 * time.Hour, "-3h"},
+		{"just before a day", 23*time.Hour + 59*time.Minute, "23h"},
+		{"a day ahead", 24 * time.Hour, "1d"},
+		{"a day ago", -24 * time.Hour, "-1d"},
+		{"just before a day ago", -(23*time.Hour + 59*time.Minute), "-23h"},
+		{"days ahead", 2 * 24 * time.Hour, "2d"},
+		{"days ago", -6 * 24 * time.Hour, "-6d"},
+		{"a week ahead", 7 * 24 * time.Hour, "1w"},
+		{"weeks ago", -15 * 24 * time.Hour, "-2w"},
+		{"months ahead", 45 * 24 * time.Hour, "1mo"},
+		{"months ago", -90 * 24 * time.Hour, "-3mo"},
+		{"a year ahead", 365 * 24 * time.Hour, "1y"},
+		{"years ago", -800 * 24 * time.Hour, "-2y"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := relativeCompactFrom(now.Add(tt.offset), now); got != tt.expected {
+				t.Errorf("relativeCompactFrom(%v) = %q, expected %q", tt.offset, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	scrollbarTrack = "│"
+	scrollbarThumb = "┃"
+	scrollbarWidth = 1 // Columns reserved on the right edge when the scrollbar is enabled
+)
+
+// renderScrollbar returns one cell per line for a vertical scrollbar of the
+// given height. total is the content size and offset the first visible
+// position, both in the same unit as height. When everything fits, the
+// column is left blank.
+func renderScrollbar(height, total, offset int) []string {
+	if height <= 0 {
+		return nil
+	}
+	cells := make([]string, height)
+	if total <= height {
+		for i := range cells {
+			cells[i] = " "
+		}
+		return cells
+	}
+
+	thumbSize, thumbTop := scrollbarThumbRange(height, total, offset)
+	for i := range cells {
+		if i >= thumbTop && i < thumbTop+thumbSize {
+			cells[i] = scrollbarThumb
+		} else {
+			cells[i] = scrollbarTrack
+		}
+	}
+	return cells
+}
+
+// scrollbarThumbRange computes the thumb size and its top position within the
+// track. The thumb is proportional to the visible fraction of the content and
+// reaches the bottom of the track exactly when the last line is visible.
+func scrollbarThumbRange(height, total, offset int) (size, top int) {
+	size = (height*height + total/2) / total
+	if size < 1 {
+		size = 1
+	}
+	if size > height {
+		size = height
+	}
+
+	maxOffset := total - height
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > maxOffset {
+		offset = maxOffset
+	}
+	top = ((height-size)*offset + maxOffset/2) / maxOffset
+	return size, top
+}
+
+// attachScrollbar pads each line to width and appends the matching scrollbar
+// cell, styled with style.
+func attachScrollbar(lines []string, width int, cells []string, style lipgloss.Style) []string {
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		if pad := width - lipgloss.Width(line); pad > 0 {
+			line += strings.Repeat(" ", pad)
+		}
+		cell := " "
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		result[i] = line + style.Render(cell)
+	}
+	return result
+}
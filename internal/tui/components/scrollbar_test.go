@@ -0,0 +1,124 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestScrollbarThumbRange(t *testing.T) {
+	tests := []struct {
+		name                  string
+		height, total, offset int
+		size, top             int
+	}{
+		{"top of content", 10, 40, 0, 3, 0},
+		{"middle of content", 10, 40, 15, 3, 4},
+		{"bottom of content", 10, 40, 30, 3, 7},
+		{"offset past the end is clamped", 10, 40, 99, 3, 7},
+		{"negative offset is clamped", 10, 40, -5, 3, 0},
+		{"thumb is at least one cell", 5, 1000, 0, 1, 0},
+		{"thumb at the bottom with a tiny thumb", 5, 1000, 995, 1, 4},
+		{"half visible", 10, 20, 5, 5, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, top := scrollbarThumbRange(tt.height, tt.total, tt.offset)
+			if size != tt.size || top != tt.top {
+				t.Errorf("Expected size=%d top=%d, got size=%d top=%d", tt.size, tt.top, size, top)
+			}
+		})
+	}
+}
+
+func TestRenderScrollbar(t *testing.T) {
+	cells := renderScrollbar(4, 8, 4)
+	expected := []string{scrollbarTrack, scrollbarTrack, scrollbarThumb, scrollbarThumb}
+	if strings.Join(cells, "") != strings.Join(expected, "") {
+		t.Errorf("Expected %v, got %v", expected, cells)
+	}
+
+	// Content that fits leaves the column blank
+	for i, cell := range renderScrollbar(4, 3, 0) {
+		if cell != " " {
+			t.Errorf("Expected blank cell %d when content fits, got %q", i, cell)
+		}
+	}
+
+	if cells := renderScrollbar(0, 10, 0); len(cells) != 0 {
+		t.Errorf("Expected no cells for zero height, got %v", cells)
+	}
+}
+
+func TestTaskListScrollbar(t *testing.T) {
+	tl := NewTaskList(80, 12, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	tasks := make([]core.Task, 30)
+	for i := range tasks {
+		tasks[i] = core.Task{ID: i + 1, UUID: fmt.Sprintf("uuid-%d", i+1), Description: "Task", Status: "pending"}
+	}
+	tl.SetTasks(tasks)
+
+	for _, line := range strings.Split(tl.View(), "\n") {
+		if strings.Contains(line, scrollbarThumb) {
+			t.Fatalf("Expected no scrollbar when disabled, got %q", line)
+		}
+	}
+
+	tl.SetScrollbar(true)
+	if tl.width != 80-scrollbarWidth {
+		t.Errorf("Expected content width %d with scrollbar, got %d", 80-scrollbarWidth, tl.width)
+	}
+
+	lines := strings.Split(tl.View(), "\n")
+	if len(lines) != 12 {
+		t.Fatalf("Expected 12 lines, got %d", len(lines))
+	}
+	thumbs := 0
+	for i, line := range lines {
+		if w := lipgloss.Width(line); w != 80 {
+			t.Errorf("Line %d: expected width 80, got %d", i, w)
+		}
+		if strings.HasSuffix(line, scrollbarThumb) {
+			thumbs++
+			if i < 2 {
+				t.Errorf("Expected no thumb on header line %d", i)
+			}
+		}
+	}
+	// 10 visible of 30 lines: a 3-cell thumb at the top of the track
+	if thumbs != 3 || !strings.HasSuffix(lines[2], scrollbarThumb) {
+		t.Errorf("Expected a 3-cell thumb starting below the header, got %d cells", thumbs)
+	}
+
+	tl.SetScrollbar(false)
+	if tl.width != 80 {
+		t.Errorf("Expected full content width when disabled, got %d", tl.width)
+	}
+}
+
+func TestSidebarScrollbar(t *testing.T) {
+	annotations := make([]core.Annotation, 20)
+	for i := range annotations {
+		annotations[i] = core.Annotation{Description: fmt.Sprintf("Note %d", i+1)}
+	}
+	task := &core.Task{UUID: "uuid-1", Description: "Long task", Status: "pending", Annotations: annotations}
+
+	sb := NewSidebar(100, 12, defaultSidebarStyles())
+	sb.SetTask(task)
+	if strings.Contains(sb.View(), scrollbarThumb) {
+		t.Error("Expected no scrollbar when disabled")
+	}
+
+	sb.SetScrollbar(true)
+	view := sb.View()
+	if !strings.Contains(view, scrollbarThumb) {
+		t.Error("Expected a scrollbar thumb for overflowing content")
+	}
+	if w := lipgloss.Width(view); w > 100 {
+		t.Errorf("Expected the scrollbar to fit within the sidebar width, got %d", w)
+	}
+}
@@ -22,9 +22,13 @@ type Sections struct {
 	ActiveIndex int
 	TaskCount   int
 	Width       int
+	MaxVisible  int // Tabs shown before the overflow entry; 0 shows them all
 	styles      SectionsStyles
 }
 
+// overflowLabel is the tabs bar entry that stands for the tabs beyond MaxVisible
+const overflowLabel = "More ▾"
+
 // SectionChangedMsg is sent when the active section changes
 type SectionChangedMsg struct {
 	Section core.Section
@@ -147,7 +151,7 @@ func (s Sections) View() string {
 
 	var tabs []string
 
-	for i, section := range s.Items {
+	for i, section := range s.Items[:s.visibleCount()] {
 		var style lipgloss.Style
 
 		if i == s.ActiveIndex {
@@ -158,15 +162,25 @@ func (s Sections) View() string {
 			style = s.styles.Inactive
 		}
 
-		// Use abbreviated or full name based on screen size
+		// Use abbreviated or full name based on screen size. On small screens
+		// the icon, when set, replaces the abbreviation.
 		displayName := section.Name
 		if isSmallScreen {
 			displayName = abbreviateSectionName(section.Name)
+			if section.Icon != "" {
+				displayName = section.Icon
+			}
+		} else if section.Icon != "" {
+			displayName = section.Icon + " " + section.Name
 		}
 
 		tabs = append(tabs, style.Render(displayName))
 	}
 
+	if s.HasOverflow() {
+		tabs = append(tabs, s.renderOverflowEntry(isSmallScreen))
+	}
+
 	tabsLine := strings.Join(tabs, " ")
 
 	// Add task count for active section if set
@@ -179,6 +193,28 @@ func (s Sections) View() string {
 	return lipgloss.NewStyle().Width(s.Width).Render(tabsLine)
 }
 
+// renderOverflowEntry renders the entry for the hidden tabs. While one of them
+// is active, the entry shows its name instead of "More".
+func (s Sections) renderOverflowEntry(isSmallScreen bool) string {
+	label := overflowLabel
+	if isSmallScreen {
+		label = "▾"
+	}
+	if s.ActiveIndex < s.visibleCount() {
+		return s.styles.Inactive.Render(label)
+	}
+
+	active := s.GetActiveSection()
+	name := active.Name
+	if isSmallScreen {
+		name = abbreviateSectionName(active.Name)
+		if active.Icon != "" {
+			name = active.Icon
+		}
+	}
+	return s.styles.Active.Render(name + " ▾")
+}
+
 // abbreviateSectionName returns abbreviated section names for small screens
 func abbreviateSectionName(name string) string {
 	abbrev := map[string]string{
@@ -211,6 +247,40 @@ func (s *Sections) SetTaskCount(count int) {
 	s.TaskCount = count
 }
 
+// SetMaxVisible sets how many tabs are shown before the overflow entry (0 shows them all)
+func (s *Sections) SetMaxVisible(count int) {
+	s.MaxVisible = count
+}
+
+// visibleCount returns the number of tabs shown in the tabs bar
+func (s Sections) visibleCount() int {
+	if s.MaxVisible <= 0 || s.MaxVisible >= len(s.Items) {
+		return len(s.Items)
+	}
+	return s.MaxVisible
+}
+
+// HasOverflow returns true if some tabs are hidden behind the overflow entry
+func (s Sections) HasOverflow() bool {
+	return s.visibleCount() < len(s.Items)
+}
+
+// OverflowItems returns the tabs hidden behind the overflow entry, in order
+func (s Sections) OverflowItems() []core.Section {
+	return s.Items[s.visibleCount():]
+}
+
+// ActivateOverflow activates the hidden tab with the given name
+func (s *Sections) ActivateOverflow(name string) tea.Cmd {
+	for i := s.visibleCount(); i < len(s.Items); i++ {
+		if s.Items[i].Name == name {
+			s.ActiveIndex = i
+			return s.sectionChangedCmd()
+		}
+	}
+	return nil
+}
+
 // SetSize updates the width of the component
 func (s *Sections) SetSize(width int) {
 	s.Width = width
@@ -201,6 +201,56 @@ func TestSectionsViewHighlightActive(t *testing.T) {
 	}
 }
 
+func TestSectionsViewIcons(t *testing.T) {
+	sections := []core.Section{
+		{Name: "Search"},
+		{Name: "Next", Icon: "📌"},
+		{Name: "Waiting"},
+		{Name: "Home", Icon: "🏠"},
+	}
+	s := NewSections(sections, 100, defaultSectionsStyles())
+	s.ActiveIndex = 1
+
+	view := s.View()
+	for _, expected := range []string{"📌 Next", "🏠 Home", "Search", "Waiting"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q, got %q", expected, view)
+		}
+	}
+	if w := lipgloss.Width(view); w != 100 {
+		t.Errorf("Expected the tabs line to span 100 columns, got %d", w)
+	}
+
+	// The active highlight covers the icon and the name
+	active := defaultSectionsStyles().Active.Render("📌 Next")
+	if !strings.Contains(view, active) {
+		t.Errorf("Expected the active tab to render as %q", active)
+	}
+
+	// Tabs without icons render as before
+	plain := NewSections([]core.Section{{Name: "Search"}, {Name: "Next"}, {Name: "Waiting"}, {Name: "Home"}}, 100, defaultSectionsStyles())
+	if !strings.Contains(plain.View(), defaultSectionsStyles().Inactive.Render("Waiting")) {
+		t.Error("Expected tabs without icons to be unchanged")
+	}
+
+	// On small screens the icon replaces the abbreviation
+	s.SetSize(60)
+	if view := s.View(); !strings.Contains(view, "🏠") || strings.Contains(view, "Home") {
+		t.Errorf("Expected only the icon on a small screen, got %q", view)
+	}
+
+	// Number keys still select tabs by position
+	for key, index := range map[rune]int{'2': 1, '4': 3} {
+		updated, cmd := s.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+		if updated.ActiveIndex != index || cmd == nil {
+			t.Fatalf("Expected key %c to select tab %d, got %d", key, index, updated.ActiveIndex)
+		}
+		if msg := cmd().(SectionChangedMsg); msg.Section.Name != sections[index].Name {
+			t.Errorf("Expected key %c to select %q, got %q", key, sections[index].Name, msg.Section.Name)
+		}
+	}
+}
+
 func TestSectionsViewEmpty(t *testing.T) {
 	s := NewSections([]core.Section{}, 100, defaultSectionsStyles())
 
@@ -500,3 +550,68 @@ func TestSectionsUpdateArrowKeys(t *testing.T) {
 		t.Error("Expected command to be returned for section change")
 	}
 }
+
+func TestSectionsOverflow(t *testing.T) {
+	sections := []core.Section{
+		{Name: "Search"},
+		{Name: "Next"},
+		{Name: "Waiting"},
+		{Name: "Work"},
+		{Name: "Someday"},
+	}
+	s := NewSections(sections, 100, defaultSectionsStyles())
+	s.SetMaxVisible(3)
+
+	if !s.HasOverflow() {
+		t.Fatal("Expected tabs beyond max visible to overflow")
+	}
+	var hidden []string
+	for _, section := range s.OverflowItems() {
+		hidden = append(hidden, section.Name)
+	}
+	if strings.Join(hidden, ",") != "Work,Someday" {
+		t.Errorf("Expected Work and Someday in the overflow, got %v", hidden)
+	}
+
+	view := s.View()
+	for _, expected := range []string{"Search", "Next", "Waiting", "More ▾"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q, got %q", expected, view)
+		}
+	}
+	for _, unexpected := range []string{"Work", "Someday"} {
+		if strings.Contains(view, unexpected) {
+			t.Errorf("Expected %q to be hidden, got %q", unexpected, view)
+		}
+	}
+
+	// An active hidden tab replaces "More" in the overflow entry
+	cmd := s.ActivateOverflow("Someday")
+	if cmd == nil || s.ActiveIndex != 4 {
+		t.Fatalf("Expected Someday to be activated, got index %d", s.ActiveIndex)
+	}
+	if msg := cmd().(SectionChangedMsg); msg.Section.Name != "Someday" {
+		t.Errorf("Expected a section change to Someday, got %q", msg.Section.Name)
+	}
+	if view := s.View(); !strings.Contains(view, "Someday ▾") || strings.Contains(view, "More") {
+		t.Errorf("Expected the overflow entry to show the active tab, got %q", view)
+	}
+
+	if cmd := s.ActivateOverflow("Next"); cmd != nil {
+		t.Error("Expected visible tabs not to be activated from the overflow")
+	}
+}
+
+func TestSectionsNoOverflow(t *testing.T) {
+	sections := core.DefaultSections()
+	for _, limit := range []int{0, len(sections)} {
+		s := NewSections(sections, 100, defaultSectionsStyles())
+		s.SetMaxVisible(limit)
+		if s.HasOverflow() || len(s.OverflowItems()) != 0 {
+			t.Errorf("Expected no overflow with max visible %d", limit)
+		}
+		if strings.Contains(s.View(), "More") {
+			t.Errorf("Expected no overflow entry with max visible %d", limit)
+		}
+	}
+}
@@ -31,40 +31,146 @@ type SidebarStyles struct {
 
 // Sidebar displays detailed information about a task
 type Sidebar struct {
-	task     *core.Task
-	allTasks []core.Task // All tasks for dependency lookups
-	width    int
-	height   int
-	offset   int // Scroll offset for main content (left panel)
-	styles   SidebarStyles
+	task           *core.Task
+	allTasks       []core.Task // All tasks for dependency lookups
+	width          int
+	height         int
+	offset         int // Scroll offset for main content (left panel)
+	styles         SidebarStyles
+	relPrecision   string            // RelativePrecisionCoarse or RelativePrecisionFine
+	scrollbar      bool              // Show a vertical scrollbar next to the main content
+	projectDisplay string            // How nested project names are shown ("full", "leaf" or "abbreviated")
+	ellipsis       string            // Marker appended to truncated file names
+	labels         map[string]string // Label overrides keyed like defaultSidebarLabels
+	files          []string          // File paths found in the annotations, as displayed
+	fileCursor     int               // Index of the highlighted entry in files
+	group          *core.TaskGroup   // Group shown instead of a task in the Projects and Tags lists
+	cache          *sidebarCache     // Rendered main content, dropped when the task or size changes
+}
+
+// defaultSidebarLabels are the field and section labels of the sidebar, keyed by
+// the names used in tui.sidebar_labels
+var defaultSidebarLabels = map[string]string{
+	"task":          "Task",
+	"uuid":          "UUID",
+	"status":        "Status",
+	"project":       "Project",
+	"priority":      "Priority",
+	"tags":          "Tags",
+	"virtual":       "Virtual",
+	"urgency":       "Urgency",
+	"dates":         "Dates",
+	"due":           "Due",
+	"scheduled":     "Sched",
+	"wait":          "Wait",
+	"started":       "Started",
+	"created":       "Created",
+	"modified":      "Modified",
+	"done":          "Done",
+	"dependencies":  "Dependencies",
+	"files":         "Files",
+	"annotations":   "Annotations",
+	"custom_fields": "Custom Fields",
+	"group_tasks":   "Tasks",
+	"completed":     "Completed",
+	"top_tags":      "Top tags",
+	"nearest_due":   "Next due",
+	"total_urgency": "Total urgency",
 }
 
 // NewSidebar creates a new sidebar component
 func NewSidebar(width, height int, styles SidebarStyles) Sidebar {
 	return Sidebar{
-		task:   nil,
-		width:  width,
-		height: height,
-		offset: 0,
-		styles: styles,
+		task:     nil,
+		width:    width,
+		height:   height,
+		offset:   0,
+		styles:   styles,
+		ellipsis: defaultEllipsis,
+		cache:    &sidebarCache{},
 	}
 }
 
 // SetTask updates the task being displayed
 func (s *Sidebar) SetTask(task *core.Task) {
 	s.task = task
+	s.group = nil
 	s.offset = 0 // Reset scroll when task changes
+	s.fileCursor = 0
+	s.invalidateContent()
+}
+
+// SetFiles sets the file paths listed in the Files section, in display form.
+// The section is hidden when files is empty.
+func (s *Sidebar) SetFiles(files []string) {
+	s.files = files
+	s.invalidateContent()
+	if s.fileCursor >= len(files) {
+		s.fileCursor = 0
+	}
+}
+
+// SelectedFile returns the index of the highlighted file, or -1 when there are none
+func (s Sidebar) SelectedFile() int {
+	if len(s.files) == 0 {
+		return -1
+	}
+	return s.fileCursor
 }
 
 // SetAllTasks updates the list of all tasks for dependency lookups
 func (s *Sidebar) SetAllTasks(tasks []core.Task) {
 	s.allTasks = tasks
+	s.invalidateContent()
 }
 
 // SetSize updates the sidebar dimensions
 func (s *Sidebar) SetSize(width, height int) {
 	s.width = width
 	s.height = height
+	s.invalidateContent()
+}
+
+// SetScrollbar enables or disables the scrollbar next to the main content
+func (s *Sidebar) SetScrollbar(enabled bool) {
+	s.scrollbar = enabled
+	s.invalidateContent()
+}
+
+// SetEllipsis sets the marker appended to a truncated title
+func (s *Sidebar) SetEllipsis(ellipsis string) {
+	if ellipsis == "" {
+		ellipsis = defaultEllipsis
+	}
+	s.ellipsis = ellipsis
+	s.invalidateContent()
+}
+
+// SetLabels overrides field and section labels, keyed like defaultSidebarLabels.
+// Labels that are not overridden keep their English default.
+func (s *Sidebar) SetLabels(labels map[string]string) {
+	s.labels = labels
+	s.invalidateContent()
+}
+
+// label returns the label shown for key
+func (s Sidebar) label(key string) string {
+	if label := s.labels[key]; label != "" {
+		return label
+	}
+	return defaultSidebarLabels[key]
+}
+
+// SetProjectDisplay sets how nested project names are shown in the Project field
+func (s *Sidebar) SetProjectDisplay(mode string) {
+	s.projectDisplay = mode
+	s.invalidateContent()
+}
+
+// SetRelativePrecision sets how many units relative dates show
+func (s *Sidebar) SetRelativePrecision(precision string) {
+	s.relPrecision = precision
+	s.invalidateContent()
 }
 
 // Update handles messages for the sidebar
@@ -96,6 +202,14 @@ func (s *Sidebar) handleKey(msg tea.KeyMsg) {
 		s.scrollDown(s.height)
 	case "ctrl+b", "pgup": // Full page up
 		s.scrollUp(s.height)
+	case "ctrl+n": // Highlight next file
+		if s.fileCursor < len(s.files)-1 {
+			s.fileCursor++
+		}
+	case "ctrl+p": // Highlight previous file
+		if s.fileCursor > 0 {
+			s.fileCursor--
+		}
 	}
 }
 
@@ -108,10 +222,13 @@ func (s *Sidebar) scrollDown(amount int) {
 		contentWidth = 10
 	}
 
-	lines := strings.Split(s.renderMainContent(contentWidth), "\n")
-	totalLines := len(lines)
+	if s.scrollbar {
+		contentWidth -= scrollbarWidth
+	}
 
-	contentHeight := s.height - titleHeight()
+	totalLines := len(s.mainContentLines(contentWidth))
+
+	contentHeight := s.height - s.titleHeight()
 	if contentHeight < 1 {
 		contentHeight = 1
 	}
@@ -145,9 +262,13 @@ func (s *Sidebar) scrollToTop() {
 	s.offset = 0
 }
 
-// titleHeight returns the number of lines the title section occupies
-func titleHeight() int {
-	return 2 // title line + separator line
+// titleHeight returns the number of lines the title section occupies: the
+// wrapped title lines and the separator line
+func (s Sidebar) titleHeight() int {
+	if s.task == nil {
+		return 2
+	}
+	return strings.Count(s.renderTitle(), "\n") + 1
 }
 
 // rightPanelWidth returns the width of the right metadata panel
@@ -164,6 +285,9 @@ func rightPanelWidth(totalWidth int) int {
 
 // View renders the task detail page
 func (s Sidebar) View() string {
+	if s.group != nil {
+		return s.renderGroup()
+	}
 	if s.task == nil {
 		return s.renderEmpty()
 	}
@@ -171,8 +295,8 @@ func (s Sidebar) View() string {
 	rightWidth := rightPanelWidth(s.width)
 	leftWidth := s.width - rightWidth
 
-	// Title occupies 2 lines (content + separator)
-	th := titleHeight()
+	// Title occupies its wrapped lines and the separator
+	th := s.titleHeight()
 	contentHeight := s.height - th
 	if contentHeight < 1 {
 		contentHeight = 1
@@ -183,13 +307,15 @@ func (s Sidebar) View() string {
 	if leftContentWidth < 10 {
 		leftContentWidth = 10
 	}
+	if s.scrollbar {
+		leftContentWidth -= scrollbarWidth
+	}
 
 	// Render title (full width)
 	title := s.renderTitle()
 
 	// Render and scroll main content (dependencies + annotations)
-	mainContent := s.renderMainContent(leftContentWidth)
-	mainLines := strings.Split(mainContent, "\n")
+	mainLines := s.mainContentLines(leftContentWidth)
 
 	maxOffset := len(mainLines) - contentHeight
 	if maxOffset < 0 {
@@ -213,6 +339,12 @@ func (s Sidebar) View() string {
 		Height(contentHeight).
 		Padding(0, 2).
 		Render(strings.Join(visibleLines, "\n"))
+	if s.scrollbar {
+		panelLines := strings.Split(leftPanel, "\n")
+		cells := renderScrollbar(len(panelLines), len(mainLines), offset)
+		style := lipgloss.NewStyle().Foreground(s.styles.Dim.GetForeground())
+		leftPanel = strings.Join(attachScrollbar(panelLines, lipgloss.Width(leftPanel), cells, style), "\n")
+	}
 
 	// Right panel: proper sidebar with a full-height │ separator on the left.
 	// Overhead: 1 (│) + 1 (left pad) + 1 (right pad) = 3 chars.
@@ -264,13 +396,45 @@ func (s Sidebar) renderEmpty() string {
 		Render(strings.Join(lines, "\n"))
 }
 
+// PeekView renders a compact summary of the task (description, due date and tags)
+// for the peek popup
+func (s Sidebar) PeekView() string {
+	if s.task == nil {
+		return ""
+	}
+
+	lines := []string{s.styles.Title.Render(s.task.Description), ""}
+
+	due := "-"
+	if s.task.Due != nil {
+		style := lipgloss.NewStyle()
+		if s.task.IsOverdue() {
+			style = style.Foreground(s.styles.DueOverdue)
+		}
+		due = style.Render(s.formatDateWithRelative(*s.task.Due))
+	}
+	lines = append(lines, s.renderField(s.label("due"), due))
+
+	if len(s.task.Tags) > 0 {
+		lines = append(lines, s.renderTags())
+	} else {
+		lines = append(lines, s.renderField(s.label("tags"), "-"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // renderTitle renders the task title bar: "#ID  Description" + separator
 func (s Sidebar) renderTitle() string {
 	idStr := s.styles.Label.Render(fmt.Sprintf("#%d", s.task.ID))
 	if s.task.ID == 0 {
-		idStr = s.styles.Label.Render("Task")
+		idStr = s.styles.Label.Render(s.label("task"))
 	}
-	titleLine := idStr + "  " + s.task.Description
+	// Wrap long descriptions, aligned after the ID: the title is the only place
+	// where they can be read in full
+	indent := lipgloss.Width(idStr) + 2
+	description := wrapText(s.task.Description, s.width-indent)
+	titleLine := idStr + "  " + strings.ReplaceAll(description, "\n", "\n"+strings.Repeat(" ", indent))
 	separator := s.styles.Dim.Render(strings.Repeat("─", s.width))
 	return titleLine + "\n" + separator
 }
@@ -284,6 +448,11 @@ func (s Sidebar) renderMainContent(contentWidth int) string {
 		sections = append(sections, "")
 	}
 
+	if len(s.files) > 0 {
+		sections = append(sections, s.renderFiles(contentWidth))
+		sections = append(sections, "")
+	}
+
 	if len(s.task.Annotations) > 0 {
 		sections = append(sections, s.renderAnnotations(contentWidth))
 	}
@@ -302,18 +471,18 @@ func (s Sidebar) renderMetadata() string {
 	// UUID (show short form when task has no numeric ID, i.e. completed/deleted)
 	if s.task.ID == 0 {
 		shortUUID, _ := s.task.GetProperty("short_uuid")
-		lines = append(lines, s.renderField("UUID", shortUUID))
+		lines = append(lines, s.renderField(s.label("uuid"), shortUUID))
 	}
 
 	// Status
 	lines = append(lines, s.renderStatusField())
 
 	// Project
-	project := s.task.Project
+	project := core.FormatProjectName(s.task.Project, s.projectDisplay)
 	if project == "" {
 		project = "-"
 	}
-	lines = append(lines, s.renderField("Project", project))
+	lines = append(lines, s.renderField(s.label("project"), project))
 
 	// Priority
 	priority := s.task.Priority
@@ -339,7 +508,7 @@ func (s Sidebar) renderMetadata() string {
 
 	// Urgency
 	lines = append(lines, "")
-	lines = append(lines, s.renderField("Urgency", fmt.Sprintf("%.2f", s.task.Urgency)))
+	lines = append(lines, s.renderField(s.label("urgency"), fmt.Sprintf("%.2f", s.task.Urgency)))
 
 	// UDAs
 	if len(s.task.UDAs) > 0 {
@@ -376,7 +545,7 @@ func (s Sidebar) renderStatusField() string {
 		}
 	}
 
-	return fmt.Sprintf("%s: %s", s.styles.Label.Render("Status"), statusStyle.Render(statusText))
+	return fmt.Sprintf("%s: %s", s.styles.Label.Render(s.label("status")), statusStyle.Render(statusText))
 }
 
 // renderPriorityField renders priority with color coding
@@ -391,7 +560,7 @@ func (s Sidebar) renderPriorityField(priority string) string {
 		priorityStyle = priorityStyle.Foreground(s.styles.PriorityLow)
 	}
 
-	return fmt.Sprintf("%s: %s", s.styles.Label.Render("Priority"), priorityStyle.Render(priority))
+	return fmt.Sprintf("%s: %s", s.styles.Label.Render(s.label("priority")), priorityStyle.Render(priority))
 }
 
 // renderTags renders the tags section
@@ -403,7 +572,7 @@ func (s Sidebar) renderTags() string {
 		tags[i] = tagStyle.Render("+" + tag)
 	}
 
-	return fmt.Sprintf("%s: %s", s.styles.Label.Render("Tags"), strings.Join(tags, " "))
+	return fmt.Sprintf("%s: %s", s.styles.Label.Render(s.label("tags")), strings.Join(tags, " "))
 }
 
 // getVirtualTags returns virtual tags based on task state
@@ -427,37 +596,37 @@ func (s Sidebar) renderVirtualTags(virtualTags []string) string {
 		styledTags[i] = tagStyle.Render("+" + tag)
 	}
 
-	return fmt.Sprintf("%s: %s", s.styles.Label.Render("Virtual"), strings.Join(styledTags, " "))
+	return fmt.Sprintf("%s: %s", s.styles.Label.Render(s.label("virtual")), strings.Join(styledTags, " "))
 }
 
 // renderDatesCompact renders date fields in a compact format for the right panel
 func (s Sidebar) renderDatesCompact() string {
 	var lines []string
 
-	lines = append(lines, s.styles.Label.Underline(true).Render("Dates"))
+	lines = append(lines, s.styles.Label.Underline(true).Render(s.label("dates")))
 
 	if s.task.Due != nil {
 		style := lipgloss.NewStyle()
 		if s.task.IsOverdue() {
 			style = style.Foreground(s.styles.DueOverdue)
 		}
-		lines = append(lines, fmt.Sprintf("  Due: %s", style.Render(formatDateWithRelative(*s.task.Due))))
+		lines = append(lines, fmt.Sprintf("  %s: %s", s.label("due"), style.Render(s.formatDateWithRelative(*s.task.Due))))
 	}
 	if s.task.Scheduled != nil {
-		lines = append(lines, fmt.Sprintf("  Sched: %s", formatDateWithRelative(*s.task.Scheduled)))
+		lines = append(lines, fmt.Sprintf("  %s: %s", s.label("scheduled"), s.formatDateWithRelative(*s.task.Scheduled)))
 	}
 	if s.task.Wait != nil {
-		lines = append(lines, fmt.Sprintf("  Wait: %s", formatDateWithRelative(*s.task.Wait)))
+		lines = append(lines, fmt.Sprintf("  %s: %s", s.label("wait"), s.formatDateWithRelative(*s.task.Wait)))
 	}
 	if s.task.Start != nil {
-		lines = append(lines, fmt.Sprintf("  Started: %s", formatDateWithRelative(*s.task.Start)))
+		lines = append(lines, fmt.Sprintf("  %s: %s", s.label("started"), s.formatDateWithRelative(*s.task.Start)))
 	}
-	lines = append(lines, fmt.Sprintf("  Created: %s", formatDateWithRelative(s.task.Entry)))
+	lines = append(lines, fmt.Sprintf("  %s: %s", s.label("created"), s.formatDateWithRelative(s.task.Entry)))
 	if s.task.Modified != nil {
-		lines = append(lines, fmt.Sprintf("  Modified: %s", formatDateWithRelative(*s.task.Modified)))
+		lines = append(lines, fmt.Sprintf("  %s: %s", s.label("modified"), s.formatDateWithRelative(*s.task.Modified)))
 	}
 	if s.task.End != nil {
-		lines = append(lines, fmt.Sprintf("  Done: %s", formatDateWithRelative(*s.task.End)))
+		lines = append(lines, fmt.Sprintf("  %s: %s", s.label("done"), s.formatDateWithRelative(*s.task.End)))
 	}
 
 	return strings.Join(lines, "\n")
@@ -466,7 +635,7 @@ func (s Sidebar) renderDatesCompact() string {
 // renderDependencies renders task dependencies
 func (s Sidebar) renderDependencies() string {
 	var lines []string
-	lines = append(lines, s.styles.Label.Underline(true).Render("Dependencies"))
+	lines = append(lines, s.styles.Label.Underline(true).Render(s.label("dependencies")))
 
 	var completedLines []string
 	for _, uuid := range s.task.Depends {
@@ -500,13 +669,29 @@ func (s Sidebar) findTaskByUUID(uuid string) *core.Task {
 	return nil
 }
 
+// renderFiles renders the file paths found in the annotations, marking the highlighted one
+func (s Sidebar) renderFiles(contentWidth int) string {
+	var lines []string
+	lines = append(lines, s.styles.Label.Underline(true).Render(s.label("files")))
+
+	for i, file := range s.files {
+		marker := "  "
+		if i == s.fileCursor {
+			marker = "▸ "
+		}
+		lines = append(lines, "  "+marker+truncate(file, contentWidth-6, s.ellipsis))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // renderAnnotations renders task annotations
 func (s Sidebar) renderAnnotations(contentWidth int) string {
 	var lines []string
-	lines = append(lines, s.styles.Label.Underline(true).Render("Annotations"))
+	lines = append(lines, s.styles.Label.Underline(true).Render(s.label("annotations")))
 
 	for _, ann := range s.task.Annotations {
-		dateStr := formatDateWithRelative(ann.Entry)
+		dateStr := s.formatDateWithRelative(ann.Entry)
 		lines = append(lines, "  "+s.styles.AnnotationTimestamp.Render("["+dateStr+"]"))
 
 		wrapped := wrapText(ann.Description, contentWidth-4)
@@ -522,7 +707,7 @@ func (s Sidebar) renderAnnotations(contentWidth int) string {
 // renderUDAs renders user-defined attributes
 func (s Sidebar) renderUDAs() string {
 	var lines []string
-	lines = append(lines, s.styles.Label.Underline(true).Render("Custom Fields"))
+	lines = append(lines, s.styles.Label.Underline(true).Render(s.label("custom_fields")))
 
 	for key, value := range s.task.UDAs {
 		lines = append(lines, fmt.Sprintf("  %s: %s", key, value))
@@ -532,23 +717,68 @@ func (s Sidebar) renderUDAs() string {
 }
 
 // formatDateWithRelative formats a date with relative time
-func formatDateWithRelative(t time.Time) string {
+func (s Sidebar) formatDateWithRelative(t time.Time) string {
 	localTime := t.Local()
 	dateStr := localTime.Format("2006-01-02 15:04")
-	relativeStr := formatRelativeTime(t)
+	relativeStr := formatRelativeTime(t, core.Now(), s.relPrecision)
 	if relativeStr != "" {
 		return fmt.Sprintf("%s (%s)", dateStr, relativeStr)
 	}
 	return dateStr
 }
 
-// formatRelativeTime returns a human-readable relative time string
-func formatRelativeTime(t time.Time) string {
-	now := time.Now()
+// Values of tui.relative_precision
+const (
+	RelativePrecisionCoarse = "coarse" // One unit, e.g. "2 days ago" (default)
+	RelativePrecisionFine   = "fine"   // Two units, e.g. "2 days 3 hours ago"
+)
+
+// relativeUnits are the units of fine relative times, largest first
+var relativeUnits = []struct {
+	name string
+	size time.Duration
+}{
+	{"week", 7 * 24 * time.Hour},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+}
+
+// fineDuration formats d with its two largest adjacent units, e.g. "2 days 3 hours".
+// The second unit is left out when it is zero.
+func fineDuration(d time.Duration) string {
+	var parts []string
+	for _, unit := range relativeUnits {
+		n := int(d / unit.size)
+		if n == 0 {
+			if len(parts) > 0 {
+				break
+			}
+			continue
+		}
+		part := fmt.Sprintf("%d %ss", n, unit.name)
+		if n == 1 {
+			part = "1 " + unit.name
+		}
+		parts = append(parts, part)
+		if len(parts) == 2 {
+			break
+		}
+		d -= time.Duration(n) * unit.size
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatRelativeTime returns a human-readable relative time string computed against now.
+// With RelativePrecisionFine, times an hour or more away show two units.
+func formatRelativeTime(t, now time.Time, precision string) string {
 	diff := now.Sub(t)
 
 	if diff < 0 {
 		diff = -diff
+		if precision == RelativePrecisionFine && diff >= time.Hour && diff < 7*24*time.Hour {
+			return "in " + fineDuration(diff)
+		}
 		if diff < time.Minute {
 			return "in moments"
 		} else if diff < time.Hour {
@@ -572,6 +802,9 @@ func formatRelativeTime(t time.Time) string {
 		return ""
 	}
 
+	if precision == RelativePrecisionFine && diff >= time.Hour && diff < 30*24*time.Hour {
+		return fineDuration(diff) + " ago"
+	}
 	if diff < time.Minute {
 		return "just now"
 	} else if diff < time.Hour {
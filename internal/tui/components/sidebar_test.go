@@ -107,7 +107,7 @@ func TestViewWithTask(t *testing.T) {
 	sb.SetTask(task)
 	view := sb.View()
 
-	if !strings.Contains(view, "Task #42") {
+	if !strings.Contains(view, "#42") {
 		t.Error("Expected task number in view")
 	}
 	if !strings.Contains(view, "Test task description") {
@@ -128,7 +128,8 @@ func TestViewWithUUID(t *testing.T) {
 	sb.SetTask(task)
 	view := sb.View()
 
-	if !strings.Contains(view, "abc-123-def-456") {
+	// Tasks without a numeric ID show their short UUID
+	if !strings.Contains(view, "abc-123-") {
 		t.Error("Expected UUID in view")
 	}
 }
@@ -183,9 +184,33 @@ func TestViewWithDates(t *testing.T) {
 	}
 }
 
+func TestViewWithDatesFixedClock(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.Local)
+	due := now.Add(-3 * time.Hour)
+	entry := now.AddDate(0, 0, -14)
+
+	sb := NewSidebar(100, 24, defaultSidebarStyles())
+	core.SetNowFunc(func() time.Time { return now })
+	defer core.SetNowFunc(nil)
+	sb.SetTask(&core.Task{
+		ID:          1,
+		Description: "Test task",
+		Entry:       entry,
+		Due:         &due,
+	})
+	view := sb.View()
+
+	if !strings.Contains(view, "(3 hours ago)") {
+		t.Errorf("Expected due date relative to the fixed clock, got %q", view)
+	}
+	if !strings.Contains(view, "(2 weeks ago)") {
+		t.Errorf("Expected created date relative to the fixed clock, got %q", view)
+	}
+}
+
 func TestViewWithAnnotations(t *testing.T) {
 	now := time.Now()
-	sb := NewSidebar(40, 100, defaultSidebarStyles()) // Taller height so all content is visible
+	sb := NewSidebar(100, 100, defaultSidebarStyles()) // Taller height so all content is visible
 	task := &core.Task{
 		ID:          1,
 		Description: "Test task",
@@ -210,7 +235,7 @@ func TestViewWithAnnotations(t *testing.T) {
 }
 
 func TestViewWithDependencies(t *testing.T) {
-	sb := NewSidebar(40, 24, defaultSidebarStyles())
+	sb := NewSidebar(100, 24, defaultSidebarStyles())
 	task := &core.Task{
 		ID:          1,
 		Description: "Test task",
@@ -223,8 +248,8 @@ func TestViewWithDependencies(t *testing.T) {
 	if !strings.Contains(view, "Dependencies") {
 		t.Error("Expected 'Dependencies' section in view")
 	}
-	if !strings.Contains(view, "Blocked by") {
-		t.Error("Expected 'Blocked by' text in view")
+	if !strings.Contains(view, "uuid-1") {
+		t.Error("Expected dependency UUID in view")
 	}
 }
 
@@ -403,7 +428,7 @@ func TestFormatRelativeTime(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := formatRelativeTime(tt.time)
+			result := formatRelativeTime(tt.time, now, RelativePrecisionCoarse)
 			if result != tt.expected {
 				if tt.alternativeOk && strings.Contains(result, "hour") {
 					// Allow hour-based responses near day boundaries
@@ -415,6 +440,52 @@ func TestFormatRelativeTime(t *testing.T) {
 	}
 }
 
+func TestFormatRelativeTimeFine(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		offset   time.Duration
+		expected string
+	}{
+		{"seconds", -30 * time.Second, "just now"},
+		{"minutes only", -5 * time.Minute, "5 minutes ago"},
+		{"hour and minutes", -(time.Hour + 5*time.Minute), "1 hour 5 minutes ago"},
+		{"whole hours", -2 * time.Hour, "2 hours ago"},
+		{"days and hours", -(2*24*time.Hour + 3*time.Hour + 20*time.Minute), "2 days 3 hours ago"},
+		{"one day", -(24*time.Hour + time.Hour), "1 day 1 hour ago"},
+		{"weeks and days", -(9 * 24 * time.Hour), "1 week 2 days ago"},
+		{"weeks skip zero days", -(14*24*time.Hour + 5*time.Hour), "2 weeks ago"},
+		{"too old", -(40 * 24 * time.Hour), ""},
+		{"future", 26*time.Hour + 30*time.Minute, "in 1 day 2 hours"},
+		{"future minutes", 10 * time.Minute, "in 10 minutes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatRelativeTime(now.Add(tt.offset), now, RelativePrecisionFine); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSidebarRelativePrecision(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	sb := NewSidebar(40, 24, defaultSidebarStyles())
+	core.SetNowFunc(func() time.Time { return now })
+	defer core.SetNowFunc(nil)
+	date := now.Add(-(2*24*time.Hour + 3*time.Hour))
+
+	if got := sb.formatDateWithRelative(date); !strings.HasSuffix(got, "(2 days ago)") {
+		t.Errorf("Expected coarse relative date by default, got %q", got)
+	}
+	sb.SetRelativePrecision(RelativePrecisionFine)
+	if got := sb.formatDateWithRelative(date); !strings.HasSuffix(got, "(2 days 3 hours ago)") {
+		t.Errorf("Expected fine relative date, got %q", got)
+	}
+}
+
 func TestWrapText(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -510,7 +581,7 @@ func TestLongDescription(t *testing.T) {
 }
 
 func TestDependencyLookup(t *testing.T) {
-	sb := NewSidebar(40, 100, defaultSidebarStyles()) // Taller height so all content is visible
+	sb := NewSidebar(100, 100, defaultSidebarStyles()) // Taller height so all content is visible
 
 	// Create all tasks including the dependency
 	allTasks := []core.Task{
@@ -548,14 +619,14 @@ func TestDependencyLookup(t *testing.T) {
 		t.Errorf("Expected dependency description in view")
 	}
 
-	// Verify the "Blocked by" label is present
-	if !strings.Contains(view, "Blocked by:") {
-		t.Error("Expected 'Blocked by:' label in view")
+	// Verify the dependency section header is present
+	if !strings.Contains(view, "Dependencies") {
+		t.Error("Expected 'Dependencies' header in view")
 	}
 }
 
 func TestDependencyNotFound(t *testing.T) {
-	sb := NewSidebar(40, 100, defaultSidebarStyles()) // Taller height so all content is visible
+	sb := NewSidebar(100, 100, defaultSidebarStyles()) // Taller height so all content is visible
 
 	// Create task with dependency that doesn't exist in allTasks
 	task := &core.Task{
@@ -580,7 +651,7 @@ func TestDependencyNotFound(t *testing.T) {
 }
 
 func TestCompletedDependencyDisplay(t *testing.T) {
-	sb := NewSidebar(40, 100, defaultSidebarStyles()) // Taller height so all content is visible
+	sb := NewSidebar(100, 100, defaultSidebarStyles()) // Taller height so all content is visible
 
 	// Create all tasks including completed and pending dependencies
 	allTasks := []core.Task{
@@ -614,9 +685,9 @@ func TestCompletedDependencyDisplay(t *testing.T) {
 	// Render the view
 	view := sb.View()
 
-	// Verify completed dependency shows with "x" prefix (no ID)
-	if !strings.Contains(view, "x Completed dependency task") {
-		t.Error("Expected completed dependency to show with 'x' prefix")
+	// Verify completed dependency shows with a check mark prefix (no ID)
+	if !strings.Contains(view, "✓ Completed dependency task") {
+		t.Error("Expected completed dependency to show with '✓' prefix")
 	}
 
 	// Verify pending dependency shows with ID
@@ -634,8 +705,161 @@ func TestCompletedDependencyDisplay(t *testing.T) {
 		t.Error("Expected completed dependencies to appear after pending ones")
 	}
 
-	// Verify the "Blocked by" label is present
-	if !strings.Contains(view, "Blocked by:") {
-		t.Error("Expected 'Blocked by:' label in view")
+	// Verify the dependency section header is present
+	if !strings.Contains(view, "Dependencies") {
+		t.Error("Expected 'Dependencies' header in view")
+	}
+}
+
+func TestViewProjectDisplay(t *testing.T) {
+	task := &core.Task{UUID: "uuid-1", Description: "Task", Project: "Home.garden.shed", Status: "pending"}
+	tests := map[string]string{
+		"full":        "Home.garden.shed",
+		"leaf":        "shed",
+		"abbreviated": "H.g.shed",
+	}
+	for mode, expected := range tests {
+		sb := NewSidebar(120, 30, defaultSidebarStyles())
+		sb.SetProjectDisplay(mode)
+		sb.SetTask(task)
+		if view := sb.View(); !strings.Contains(view, expected) {
+			t.Errorf("%s: expected project %q in sidebar, got:\n%s", mode, expected, view)
+		}
+	}
+}
+
+func TestViewTitleWraps(t *testing.T) {
+	sb := NewSidebar(30, 24, defaultSidebarStyles())
+	sb.SetTask(&core.Task{ID: 7, UUID: "uuid-1", Description: "A description that does not fit on the title line", Status: "pending"})
+
+	// The title lines come before the separator
+	var title []string
+	for _, line := range strings.Split(sb.View(), "\n") {
+		if strings.Contains(line, "───") {
+			break
+		}
+		line = strings.TrimRight(line, " ")
+		if w := lipgloss.Width(line); w > 30 {
+			t.Errorf("Expected the title lines to fit the 30 column width, got %q (%d)", line, w)
+		}
+		if len(title) > 0 && !strings.HasPrefix(line, "    ") {
+			t.Errorf("Expected the wrapped lines aligned after the ID, got %q", line)
+		}
+		title = append(title, strings.TrimSpace(strings.TrimPrefix(line, "#7")))
+	}
+	if len(title) < 2 {
+		t.Fatalf("Expected the title to wrap, got %q", title)
+	}
+	if got := strings.Join(title, " "); got != "A description that does not fit on the title line" {
+		t.Errorf("Expected the whole description in the title, got %q", got)
+	}
+}
+
+func TestViewLabels(t *testing.T) {
+	due := time.Now().Add(48 * time.Hour)
+	task := &core.Task{
+		UUID:        "uuid-1",
+		Description: "Task",
+		Status:      "pending",
+		Project:     "Home",
+		Due:         &due,
+		Depends:     []string{"uuid-2"},
+	}
+
+	sb := NewSidebar(120, 30, defaultSidebarStyles())
+	sb.SetLabels(map[string]string{
+		"urgency":      "Urg",
+		"dependencies": "Blocked by",
+		"project":      "Projekt",
+		"due":          "Fällig",
+	})
+	sb.SetTask(task)
+	view := sb.View()
+
+	for _, expected := range []string{"Urg:", "Blocked by", "Projekt:", "Fällig:", "Status:", "Created:"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected label %q in sidebar, got:\n%s", expected, view)
+		}
+	}
+	for _, replaced := range []string{"Urgency", "Dependencies", "Project:", "Due:"} {
+		if strings.Contains(view, replaced) {
+			t.Errorf("Expected label %q to be overridden, got:\n%s", replaced, view)
+		}
+	}
+}
+
+func TestViewFiles(t *testing.T) {
+	task := &core.Task{UUID: "uuid-1", Description: "Task", Status: "pending"}
+
+	sb := NewSidebar(120, 30, defaultSidebarStyles())
+	sb.SetTask(task)
+	if view := sb.View(); strings.Contains(view, "Files") {
+		t.Errorf("Expected no Files section without paths, got:\n%s", view)
+	}
+	if sb.SelectedFile() != -1 {
+		t.Errorf("Expected no selected file, got %d", sb.SelectedFile())
+	}
+
+	sb.SetFiles([]string{"Notes in /tmp/notes.txt", "Spec at ~/spec.pdf"})
+	view := sb.View()
+	for _, expected := range []string{"Files", "▸ Notes in /tmp/notes.txt", "Spec at ~/spec.pdf"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in sidebar, got:\n%s", expected, view)
+		}
+	}
+
+	sb, _ = sb.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	sb, _ = sb.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	if sb.SelectedFile() != 1 {
+		t.Errorf("Expected the last file to stay highlighted, got %d", sb.SelectedFile())
+	}
+	if view := sb.View(); !strings.Contains(view, "▸ Spec at ~/spec.pdf") {
+		t.Errorf("Expected the second file highlighted, got:\n%s", view)
+	}
+	sb, _ = sb.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	if sb.SelectedFile() != 0 {
+		t.Errorf("Expected the first file highlighted, got %d", sb.SelectedFile())
+	}
+}
+
+func TestSidebarContentCache(t *testing.T) {
+	sb := NewSidebar(80, 10, defaultSidebarStyles())
+	sb.SetTask(&core.Task{
+		Description: "Cached task",
+		Annotations: []core.Annotation{{Entry: time.Now(), Description: "First note"}},
+	})
+
+	sb.View()
+	cached := sb.cache.content
+	if cached == nil {
+		t.Fatal("Expected View to cache the main content")
+	}
+
+	// Scrolling reuses the content rendered by View
+	sb.scrollDown(1)
+	if sb.cache.content != cached {
+		t.Error("Expected scrolling to reuse the cached content")
+	}
+
+	// A new task drops the cached content
+	sb.SetTask(&core.Task{
+		Description: "Other task",
+		Annotations: []core.Annotation{{Entry: time.Now(), Description: "Second note"}},
+	})
+	if sb.cache.content != nil {
+		t.Error("Expected SetTask to invalidate the cache")
+	}
+	if view := sb.View(); !strings.Contains(view, "Second note") || strings.Contains(view, "First note") {
+		t.Errorf("Expected the new task's annotations, got:\n%s", view)
+	}
+
+	// A new size drops the cached content
+	sb.SetSize(60, 10)
+	if sb.cache.content != nil {
+		t.Error("Expected SetSize to invalidate the cache")
+	}
+	sb.View()
+	if sb.cache.content == nil || sb.cache.content.width == cached.width {
+		t.Error("Expected the content to be rendered again for the new width")
 	}
 }
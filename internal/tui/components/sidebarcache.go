@@ -0,0 +1,38 @@
+package components
+
+import "strings"
+
+// sidebarContent is the main content of the sidebar rendered for a width
+type sidebarContent struct {
+	width      int      // Content width the lines were rendered for
+	fileCursor int      // Highlighted file when the lines were rendered
+	lines      []string // Rendered lines
+}
+
+// sidebarCache holds the last rendered main content. Sidebar values share it through
+// a pointer, so that View, which has a value receiver, can fill it.
+type sidebarCache struct {
+	content *sidebarContent
+}
+
+// invalidateContent drops the rendered main content. A new cache is created instead
+// of clearing the shared one, so copies of the sidebar keep their own content.
+func (s *Sidebar) invalidateContent() {
+	s.cache = &sidebarCache{}
+}
+
+// mainContentLines returns the lines of the main content for the width, rendering
+// them only when the task, size or highlighted file changed since the last call
+func (s Sidebar) mainContentLines(width int) []string {
+	if s.cache != nil {
+		if c := s.cache.content; c != nil && c.width == width && c.fileCursor == s.fileCursor {
+			return c.lines
+		}
+	}
+
+	lines := strings.Split(s.renderMainContent(width), "\n")
+	if s.cache != nil {
+		s.cache.content = &sidebarContent{width: width, fileCursor: s.fileCursor, lines: lines}
+	}
+	return lines
+}
@@ -0,0 +1,147 @@
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// maxGroupTopTags is the number of tags listed in the group details
+const maxGroupTopTags = 3
+
+// SetGroup shows the aggregate details of a project or tag group instead of a task.
+// A nil group clears the details.
+func (s *Sidebar) SetGroup(group *core.TaskGroup) {
+	s.group = group
+	s.task = nil
+	s.offset = 0
+	s.files = nil
+	s.fileCursor = 0
+	s.invalidateContent()
+}
+
+// renderGroup renders the details of the group: task count, completed ratio,
+// most used tags, nearest due date and total urgency
+func (s Sidebar) renderGroup() string {
+	group := s.group
+
+	name := group.Name
+	if name == "" {
+		name = "-"
+	}
+	title := s.styles.Title.Render(truncate(name, s.width, s.ellipsis))
+	separator := s.styles.Dim.Render(strings.Repeat("─", s.width))
+
+	lines := []string{
+		title,
+		separator,
+		s.renderField(s.label("group_tasks"), fmt.Sprintf("%d", group.Count)),
+		s.renderField(s.label("completed"), groupCompletion(*group)),
+	}
+
+	if tags := groupTopTags(*group, maxGroupTopTags); len(tags) > 0 {
+		tagStyle := lipgloss.NewStyle().Foreground(s.styles.Tag)
+		rendered := make([]string, len(tags))
+		for i, tag := range tags {
+			rendered[i] = tagStyle.Render("+" + tag)
+		}
+		lines = append(lines, s.renderField(s.label("top_tags"), strings.Join(rendered, " ")))
+	} else {
+		lines = append(lines, s.renderField(s.label("top_tags"), "-"))
+	}
+
+	due := "-"
+	if task := groupNearestDue(*group); task != nil {
+		style := lipgloss.NewStyle()
+		if task.IsOverdue() {
+			style = style.Foreground(s.styles.DueOverdue)
+		}
+		due = style.Render(s.formatDateWithRelative(*task.Due))
+	}
+	lines = append(lines, s.renderField(s.label("nearest_due"), due))
+	lines = append(lines, s.renderField(s.label("total_urgency"), fmt.Sprintf("%.2f", groupUrgency(*group))))
+
+	for len(lines) < s.height {
+		lines = append(lines, "")
+	}
+	if s.height > 0 && len(lines) > s.height {
+		lines = lines[:s.height]
+	}
+	return lipgloss.NewStyle().Padding(0, 1).Render(strings.Join(lines, "\n"))
+}
+
+// groupCompletion returns the completed ratio of the group, e.g. "2/5 (40%)".
+// Projects use the percentage reported by Taskwarrior, which also counts the
+// completed tasks that are not listed.
+func groupCompletion(group core.TaskGroup) string {
+	if group.Percentage >= 0 {
+		return fmt.Sprintf("%d%%", group.Percentage)
+	}
+	if len(group.Tasks) == 0 {
+		return "-"
+	}
+	completed := 0
+	for _, task := range group.Tasks {
+		if task.Status == "completed" {
+			completed++
+		}
+	}
+	return fmt.Sprintf("%d/%d (%d%%)", completed, len(group.Tasks), completed*100/len(group.Tasks))
+}
+
+// groupTopTags returns up to limit tags used most often by the tasks of the group,
+// most used first. The tag that names a Tags group is left out.
+func groupTopTags(group core.TaskGroup, limit int) []string {
+	counts := make(map[string]int)
+	for _, task := range group.Tasks {
+		for _, tag := range task.Tags {
+			if tag != group.Name {
+				counts[tag]++
+			}
+		}
+	}
+
+	tags := make([]string, 0, len(counts))
+	for tag := range counts {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if counts[tags[i]] != counts[tags[j]] {
+			return counts[tags[i]] > counts[tags[j]]
+		}
+		return tags[i] < tags[j]
+	})
+	if len(tags) > limit {
+		tags = tags[:limit]
+	}
+	return tags
+}
+
+// groupNearestDue returns the pending task of the group that is due first, or nil
+func groupNearestDue(group core.TaskGroup) *core.Task {
+	var nearest *core.Task
+	for i := range group.Tasks {
+		task := &group.Tasks[i]
+		if task.Due == nil || task.Status == "completed" || task.Status == "deleted" {
+			continue
+		}
+		if nearest == nil || task.Due.Before(*nearest.Due) {
+			nearest = task
+		}
+	}
+	return nearest
+}
+
+// groupUrgency returns the sum of the urgency of the pending tasks of the group
+func groupUrgency(group core.TaskGroup) float64 {
+	total := 0.0
+	for _, task := range group.Tasks {
+		if task.Status != "completed" && task.Status != "deleted" {
+			total += task.Urgency
+		}
+	}
+	return total
+}
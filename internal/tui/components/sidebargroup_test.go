@@ -0,0 +1,77 @@
+package components
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// sampleGroup returns a tag group with pending and completed tasks
+func sampleGroup() core.TaskGroup {
+	soon := time.Date(2026, 3, 12, 9, 0, 0, 0, time.Local)
+	later := time.Date(2026, 3, 20, 9, 0, 0, 0, time.Local)
+	done := time.Date(2026, 3, 1, 9, 0, 0, 0, time.Local)
+	tasks := []core.Task{
+		{UUID: "1", Description: "Plan trip", Status: "pending", Tags: []string{"travel", "home", "urgent"}, Due: &later, Urgency: 5.5},
+		{UUID: "2", Description: "Book hotel", Status: "pending", Tags: []string{"travel", "home"}, Due: &soon, Urgency: 3.25},
+		{UUID: "3", Description: "Renew passport", Status: "completed", Tags: []string{"travel", "admin"}, Due: &done, Urgency: 8},
+		{UUID: "4", Description: "Pack", Status: "pending", Tags: []string{"travel", "urgent", "list"}},
+	}
+	return core.TaskGroup{Name: "travel", Count: len(tasks), Tasks: tasks, Percentage: -1}
+}
+
+func TestSidebarGroupView(t *testing.T) {
+	sb := NewSidebar(80, 20, defaultSidebarStyles())
+	core.SetNowFunc(func() time.Time { return time.Date(2026, 3, 10, 9, 0, 0, 0, time.Local) })
+	defer core.SetNowFunc(nil)
+	group := sampleGroup()
+	sb.SetGroup(&group)
+
+	view := sb.View()
+	for _, expected := range []string{
+		"travel",
+		"Tasks: 4",
+		"Completed: 1/4 (25%)",
+		"Top tags: +home +urgent +admin",
+		"Next due: 2026-03-12 09:00 (in 2 days)",
+		"Total urgency: 8.75",
+	} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the group details, got:\n%s", expected, view)
+		}
+	}
+	if strings.Contains(view, "+travel") {
+		t.Error("Expected the tag naming the group to be left out of the top tags")
+	}
+}
+
+func TestSidebarGroupProjectPercentage(t *testing.T) {
+	sb := NewSidebar(80, 20, defaultSidebarStyles())
+	group := core.TaskGroup{Name: "Home", Count: 0, Percentage: 60}
+	sb.SetGroup(&group)
+
+	view := sb.View()
+	for _, expected := range []string{"Completed: 60%", "Top tags: -", "Next due: -", "Total urgency: 0.00"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the group details, got:\n%s", expected, view)
+		}
+	}
+}
+
+func TestSidebarSetTaskClearsGroup(t *testing.T) {
+	sb := NewSidebar(80, 20, defaultSidebarStyles())
+	group := sampleGroup()
+	sb.SetGroup(&group)
+	sb.SetTask(&core.Task{ID: 1, Description: "Plan trip", Status: "pending"})
+
+	if view := sb.View(); strings.Contains(view, "Total urgency") || !strings.Contains(view, "Plan trip") {
+		t.Errorf("Expected the task details after SetTask, got:\n%s", view)
+	}
+
+	sb.SetGroup(nil)
+	if view := sb.View(); !strings.Contains(view, "No task selected") {
+		t.Errorf("Expected the empty sidebar after clearing the group, got:\n%s", view)
+	}
+}
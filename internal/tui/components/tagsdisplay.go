@@ -0,0 +1,72 @@
+package components
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// Values of tui.tags_display
+const (
+	TagsDisplayList     = "list"     // Every tag, e.g. "+work, +docs" (default)
+	TagsDisplayCount    = "count"    // Tags that fit, then a count of the others, e.g. "+work, +2 tags"
+	TagsDisplayPriority = "priority" // Every tag, tui.priority_tags first
+)
+
+// formatTags words the tags column of a task in the tags display mode. In count
+// mode the tags that do not fit the width are replaced by their count.
+func (t TaskList) formatTags(task core.Task, width int) string {
+	if len(task.Tags) == 0 {
+		return "-"
+	}
+
+	tags := task.Tags
+	if t.tagsDisplay == TagsDisplayPriority {
+		tags = t.prioritizedTags(tags)
+	}
+	items := make([]string, len(tags))
+	for i, tag := range tags {
+		items[i] = "+" + tag
+	}
+
+	list := strings.Join(items, ", ")
+	if t.tagsDisplay != TagsDisplayCount || width <= 0 || lipgloss.Width(list) <= width {
+		return list
+	}
+
+	// Show as many tags as fit before the count of the hidden ones
+	for shown := len(items) - 1; shown > 0; shown-- {
+		value := strings.Join(append(items[:shown:shown], hiddenTagsCount(len(items)-shown)), ", ")
+		if lipgloss.Width(value) <= width {
+			return value
+		}
+	}
+	return hiddenTagsCount(len(items))
+}
+
+// prioritizedTags returns tags with the priority tags first, in their configured order
+func (t TaskList) prioritizedTags(tags []string) []string {
+	ordered := make([]string, 0, len(tags))
+	for _, tag := range t.priorityTags {
+		if slices.Contains(tags, tag) && !slices.Contains(ordered, tag) {
+			ordered = append(ordered, tag)
+		}
+	}
+	for _, tag := range tags {
+		if !slices.Contains(ordered, tag) {
+			ordered = append(ordered, tag)
+		}
+	}
+	return ordered
+}
+
+// hiddenTagsCount words the number of tags left out of the column
+func hiddenTagsCount(n int) string {
+	if n == 1 {
+		return "+1 tag"
+	}
+	return fmt.Sprintf("+%d tags", n)
+}
@@ -0,0 +1,57 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestFormatTags(t *testing.T) {
+	task := core.Task{Tags: []string{"work", "docs", "urgent", "review"}}
+	tests := []struct {
+		mode     string
+		width    int
+		expected string
+	}{
+		{"", 15, "+work, +docs, +urgent, +review"},
+		{TagsDisplayList, 15, "+work, +docs, +urgent, +review"},
+		{TagsDisplayCount, 40, "+work, +docs, +urgent, +review"},
+		{TagsDisplayCount, 21, "+work, +docs, +2 tags"},
+		{TagsDisplayCount, 15, "+work, +3 tags"},
+		{TagsDisplayCount, 10, "+4 tags"},
+		{TagsDisplayPriority, 15, "+urgent, +review, +work, +docs"},
+	}
+
+	for _, tt := range tests {
+		tl := NewTaskList(100, 10, testColumns("id", "tags", "description"), config.Columns{}, defaultTaskListStyles())
+		tl.SetTagsDisplay(tt.mode, []string{"urgent", "missing", "review"})
+		if got := tl.formatTags(task, tt.width); got != tt.expected {
+			t.Errorf("formatTags in %q mode at width %d = %q, expected %q", tt.mode, tt.width, got, tt.expected)
+		}
+	}
+
+	tl := NewTaskList(100, 10, testColumns("id", "tags", "description"), config.Columns{}, defaultTaskListStyles())
+	tl.SetTagsDisplay(TagsDisplayCount, nil)
+	if got := tl.formatTags(core.Task{}, 15); got != "-" {
+		t.Errorf("Expected placeholder for a task without tags, got %q", got)
+	}
+	if got := tl.formatTags(core.Task{Tags: []string{"a", "b", "c", "d", "e", "f"}}, 15); got != "+a, +b, +4 tags" {
+		t.Errorf("Expected the tags that fit before the count, got %q", got)
+	}
+}
+
+func TestTagsDisplayCountInRow(t *testing.T) {
+	tl := NewTaskList(100, 10, testColumns("id", "tags", "description"), config.Columns{}, defaultTaskListStyles())
+	tl.SetTagsDisplay(TagsDisplayCount, nil)
+	tl.SetTasks([]core.Task{{ID: 1, UUID: "uuid-1", Description: "Task", Tags: []string{"work", "docs", "urgent", "review"}}})
+
+	view := tl.renderTaskList()
+	if !strings.Contains(view, "+work, +3 tags") {
+		t.Errorf("Expected the counted tags in the row, got:\n%s", view)
+	}
+	if got := len(tl.rowHeights); got != 1 || tl.rowHeights[0] != 1 {
+		t.Errorf("Expected the counted tags to fit one line, got heights %v", tl.rowHeights)
+	}
+}
@@ -1,11 +1,13 @@
 package components
 
 import (
+	"cmp"
 	"fmt"
 	"log"
 	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -22,6 +24,11 @@ const (
 	DisplayModeGroups
 )
 
+const (
+	defaultUUIDLength = 13 // UUID prefix length in long UUID mode (e.g. "a1b2c3d4-e5f6")
+	fullUUIDLength    = 36
+)
+
 // TaskListStyles holds the styles needed for rendering the task list
 type TaskListStyles struct {
 	Header          lipgloss.Style
@@ -53,14 +60,32 @@ type TaskList struct {
 	narrowViewLabels  map[string]string // Map of field name to display label for narrow view
 	narrowViewLengths map[string]int    // Map of field name to custom max length for narrow view
 	relativeDates     bool              // Show dates as relative (e.g., "2 weeks ago") instead of absolute
+	dueDisplay        string            // DueDisplayAbsolute or DueDisplayRelative
+	tagsDisplay       string            // TagsDisplayList, TagsDisplayCount or TagsDisplayPriority
+	priorityTags      []string          // Tags listed first in TagsDisplayPriority mode
+	dimFuture         bool              // Dim tasks with a future wait or scheduled date
+	projectDisplay    string            // How nested project names are shown ("full", "leaf" or "abbreviated")
+	completedSort     string            // Ordering of the completed partition ("end", "none" or a sort method)
+	completedInPlace  bool              // Keep completed tasks in the service/section order instead of moving them to the bottom
+	sortKey           string            // Column sort chosen in the list, replacing the section sort (empty: none)
+	sortDescending    bool              // Reverse the column sort
 	forceSmallScreen  bool              // Force small screen mode (set by model when width < 80 or config)
+	scrollbar         bool              // Reserve the rightmost column for a vertical scrollbar
+	longUUIDs         bool              // Show a longer UUID prefix in the id and uuid columns
+	uuidLength        int               // Length of the UUID prefix shown when longUUIDs is set
+	ellipsis          string            // Marker appended to truncated values
+	columnSeparator   string            // Text between columns (default: a space)
+	columnAlign       map[string]string // Alignment by column name: "left" or "right"
 	offset            int               // Scroll offset for viewport (in lines, not tasks)
 	scrollBuffer      int               // Number of tasks to keep visible above/below cursor
+	navWrap           bool              // Move from the last row to the first and back instead of stopping
 	styles            TaskListStyles
 	emptyMessage      string // Custom message to show when list is empty
 	rowHeights        []int  // Cached height (in lines) of each rendered row
 	rowHeightsWidth   int    // Width used when calculating row heights (invalidate on resize)
 	groupTitle        string // Column header label shown in group list (e.g. "PROJECT" or "TAG")
+	jumpActive        bool   // Jump labels are drawn next to the visible rows
+	jumpBuffer        string // Keys of a jump label typed so far
 }
 
 // NewTaskList creates a new task list component
@@ -124,6 +149,9 @@ func NewTaskList(width, height int, columns config.Columns, narrowViewFields con
 		offset:            0,
 		scrollBuffer:      1, // Default: keep 1 task visible above/below cursor
 		styles:            styles,
+		completedSort:     "end", // Default: most recently completed first
+		ellipsis:          defaultEllipsis,
+		columnSeparator:   defaultColumnSeparator,
 	}
 }
 
@@ -143,15 +171,24 @@ func (t *TaskList) SetTasksWithSort(tasks []core.Task, sortMethod string, revers
 		taskI := sortedTasks[i]
 		taskJ := sortedTasks[j]
 
-		// First priority: Completed tasks should come after non-completed tasks
-		isCompletedI := taskI.Status == "completed"
-		isCompletedJ := taskJ.Status == "completed"
+		// First priority: Completed tasks should come after non-completed tasks,
+		// unless they are kept in place
+		isCompletedI := taskI.Status == "completed" && !t.completedInPlace
+		isCompletedJ := taskJ.Status == "completed" && !t.completedInPlace
 
 		if isCompletedI != isCompletedJ {
 			return !isCompletedI // true if i is not completed (i comes first)
 		}
 
-		// Second priority: Apply custom sorting if specified
+		// Completed tasks use their own ordering unless configured to follow the section sort
+		if isCompletedI && t.completedSort != "none" {
+			return compareCompletedTasks(taskI, taskJ, t.completedSort) < 0
+		}
+
+		// Second priority: Apply the column sort chosen in the list, or the custom sorting
+		if t.sortKey != "" {
+			return compareSortKey(taskI, taskJ, t.sortKey, t.sortDescending) < 0
+		}
 		if sortMethod != "" {
 			result := compareTasks(taskI, taskJ, sortMethod)
 			if result != 0 {
@@ -177,6 +214,52 @@ func (t *TaskList) SetTasksWithSort(tasks []core.Task, sortMethod string, revers
 	t.updateScroll()
 }
 
+// SetRankedTasks shows tasks in the given order, best first, without sorting them,
+// and selects the first one
+func (t *TaskList) SetRankedTasks(tasks []core.Task) {
+	t.tasks = tasks
+	t.displayMode = DisplayModeTasks
+	t.cursor = 0
+	t.offset = 0
+	t.rebuildRowHeights()
+	t.updateScroll()
+}
+
+// ToggleCompletedLast switches between moving completed tasks to the bottom of the
+// list and keeping them in the service order (or section sort). It applies from the
+// next SetTasks call.
+func (t *TaskList) ToggleCompletedLast() {
+	t.completedInPlace = !t.completedInPlace
+}
+
+// CompletedLast reports whether completed tasks are moved to the bottom of the list
+func (t TaskList) CompletedLast() bool {
+	return !t.completedInPlace
+}
+
+// SetCompletedSort sets the ordering of the completed tasks at the bottom of the list.
+// "end" shows the most recently completed first, "none" applies the section sort,
+// any other value is used as a sort method (see compareTasks). Empty means "end".
+func (t *TaskList) SetCompletedSort(method string) {
+	if method == "" {
+		method = "end"
+	}
+	t.completedSort = method
+}
+
+// compareCompletedTasks compares two completed tasks using the completed sort method
+// Returns: -1 if taskI < taskJ, 0 if equal, 1 if taskI > taskJ
+func compareCompletedTasks(taskI, taskJ core.Task, method string) int {
+	if method != "end" {
+		return compareTasks(taskI, taskJ, method)
+	}
+	// Most recent end date first, tasks without an end date last
+	if taskI.End == nil || taskJ.End == nil {
+		return compareDates(taskI.End, taskJ.End)
+	}
+	return compareDates(taskJ.End, taskI.End)
+}
+
 // compareDates compares two optional date pointers
 // Returns: -1 if a < b, 0 if equal, 1 if a > b
 // nil dates are considered greater (go last)
@@ -228,6 +311,18 @@ func compareTasks(taskI, taskJ core.Task, sortMethod string) int {
 		// Sort by modified date (tasks without modified date go last)
 		return compareDates(taskI.Modified, taskJ.Modified)
 
+	case "id":
+		// Sort by ID (completed and deleted tasks have ID 0)
+		return cmp.Compare(taskI.ID, taskJ.ID)
+
+	case "priority":
+		// Sort by priority (H, M, L, then no priority)
+		return cmp.Compare(priorityRank(taskI.Priority), priorityRank(taskJ.Priority))
+
+	case "project":
+		// Sort by project name (case-insensitive)
+		return strings.Compare(strings.ToLower(taskI.Project), strings.ToLower(taskJ.Project))
+
 	case "urgency":
 		// Sort by urgency (higher urgency first)
 		if taskI.Urgency > taskJ.Urgency {
@@ -262,6 +357,9 @@ func (t *TaskList) SetGroups(groups []core.TaskGroup) {
 // SetSize updates the component dimensions
 func (t *TaskList) SetSize(width, height int) {
 	oldWidth := t.width
+	if t.scrollbar {
+		width -= scrollbarWidth
+	}
 	t.width = width
 	t.height = height
 	// Rebuild row heights if width changed (wrapping may change)
@@ -292,6 +390,145 @@ func (t *TaskList) SetRelativeDates(enabled bool) {
 	t.relativeDates = enabled
 }
 
+// SetDueDisplay sets how the due column is shown: DueDisplayAbsolute or DueDisplayRelative
+func (t *TaskList) SetDueDisplay(mode string) {
+	t.dueDisplay = mode
+	if t.displayMode == DisplayModeTasks {
+		t.rebuildRowHeights()
+	}
+}
+
+// SetTagsDisplay sets how the tags column is shown: TagsDisplayList, TagsDisplayCount
+// or TagsDisplayPriority, which lists priorityTags first
+func (t *TaskList) SetTagsDisplay(mode string, priorityTags []string) {
+	t.tagsDisplay = mode
+	t.priorityTags = priorityTags
+	if t.displayMode == DisplayModeTasks {
+		t.rebuildRowHeights()
+	}
+}
+
+// SetDimFuture enables or disables dimming of tasks that are not actionable yet
+func (t *TaskList) SetDimFuture(enabled bool) {
+	t.dimFuture = enabled
+}
+
+// SetScrollbar enables or disables the vertical scrollbar. When enabled, the
+// rightmost column is reserved for it; when disabled, content uses the full width.
+func (t *TaskList) SetScrollbar(enabled bool) {
+	if t.scrollbar == enabled {
+		return
+	}
+	width := t.width
+	if t.scrollbar {
+		width += scrollbarWidth
+	}
+	t.scrollbar = enabled
+	t.SetSize(width, t.height)
+}
+
+// SetProjectDisplay sets how nested project names are shown in the project column
+func (t *TaskList) SetProjectDisplay(mode string) {
+	t.projectDisplay = mode
+	if t.displayMode == DisplayModeTasks {
+		t.rebuildRowHeights()
+	}
+}
+
+// SetEllipsis sets the marker appended to truncated values
+func (t *TaskList) SetEllipsis(ellipsis string) {
+	if ellipsis == "" {
+		ellipsis = defaultEllipsis
+	}
+	t.ellipsis = ellipsis
+}
+
+// SetColumnSeparator sets the text drawn between columns, e.g. " │ "
+func (t *TaskList) SetColumnSeparator(separator string) {
+	if separator == "" {
+		separator = defaultColumnSeparator
+	}
+	t.columnSeparator = separator
+	if t.displayMode == DisplayModeTasks {
+		t.rebuildRowHeights()
+	}
+}
+
+// SetColumnAlign sets the alignment of columns by name ("left" or "right").
+// Columns not listed keep their default alignment.
+func (t *TaskList) SetColumnAlign(align map[string]string) {
+	t.columnAlign = align
+}
+
+// rightAligned reports whether a column's values are aligned to the right.
+// Numeric columns are by default.
+func (t TaskList) rightAligned(col string) bool {
+	switch t.columnAlign[col] {
+	case ColumnAlignRight:
+		return true
+	case ColumnAlignLeft:
+		return false
+	}
+	return col == "id" || col == "urgency"
+}
+
+// padColumn pads a value to the column width on the side given by its alignment
+func (t TaskList) padColumn(col, value string, width int) string {
+	if t.rightAligned(col) {
+		return fmt.Sprintf("%*s", width, value)
+	}
+	return fmt.Sprintf("%-*s", width, value)
+}
+
+// SetUUIDLength sets the UUID prefix length shown when long UUIDs are toggled on
+func (t *TaskList) SetUUIDLength(length int) {
+	if length <= 0 {
+		length = defaultUUIDLength
+	}
+	t.uuidLength = length
+	if t.longUUIDs && t.displayMode == DisplayModeTasks {
+		t.rebuildRowHeights()
+	}
+}
+
+// ToggleLongUUIDs switches between short and long UUID prefixes. Tasks without
+// an ID show the prefix in the id column, which widens to fit it.
+func (t *TaskList) ToggleLongUUIDs() {
+	t.longUUIDs = !t.longUUIDs
+	if t.displayMode == DisplayModeTasks {
+		t.rebuildRowHeights()
+	}
+	t.updateScroll()
+}
+
+// LongUUIDs reports whether long UUID prefixes are shown
+func (t TaskList) LongUUIDs() bool {
+	return t.longUUIDs
+}
+
+// effectiveUUIDLength returns the UUID prefix length used in long UUID mode
+func (t TaskList) effectiveUUIDLength() int {
+	if t.uuidLength <= 0 {
+		return defaultUUIDLength
+	}
+	return min(t.uuidLength, fullUUIDLength)
+}
+
+// uuidPrefix returns the UUID prefix shown in long UUID mode
+func (t TaskList) uuidPrefix(task core.Task) string {
+	length := t.effectiveUUIDLength()
+	if len(task.UUID) > length {
+		return task.UUID[:length]
+	}
+	return task.UUID
+}
+
+// isDimmed reports whether a task should be dimmed because it is waiting or scheduled
+// in the future. Overdue tasks are never dimmed so their highlighting stays visible.
+func (t TaskList) isDimmed(task core.Task) bool {
+	return t.dimFuture && task.IsDeferred() && !task.IsOverdue()
+}
+
 // SetForceSmallScreen forces the task list into small screen rendering mode.
 // This is set by the model when the terminal width is below the threshold or
 // when the user has configured force_small_screen in the config.
@@ -301,7 +538,14 @@ func (t *TaskList) SetForceSmallScreen(force bool) {
 
 // getTaskValue returns the display value for a task property.
 // When relativeDates is enabled, date columns return relative strings.
+// The relative due display takes precedence for the due column.
 func (t TaskList) getTaskValue(task core.Task, col string) (string, bool) {
+	if col == "due" && t.dueDisplay == DueDisplayRelative {
+		if task.Due == nil {
+			return "-", true
+		}
+		return formatRelativeDue(*task.Due), true
+	}
 	if t.relativeDates && isDateColumn(col) {
 		dateVal := task.GetDateValue(col)
 		if dateVal == nil {
@@ -309,18 +553,27 @@ func (t TaskList) getTaskValue(task core.Task, col string) (string, bool) {
 		}
 		return core.FormatRelativeDate(dateVal), true
 	}
+	if t.longUUIDs && (col == "uuid" || (col == "id" && task.ID == 0)) {
+		return t.uuidPrefix(task), true
+	}
+	if col == "project" && task.Project != "" {
+		return core.FormatProjectName(task.Project, t.projectDisplay), true
+	}
+	if col == "tags" {
+		return t.formatTags(task, 0), true
+	}
 	return task.GetProperty(col)
 }
 
-// calculateRowHeight renders a task row and returns its height in lines
-func (t *TaskList) calculateRowHeight(task core.Task) int {
+// calculateRowHeight renders a task row with the given column widths and returns its height in lines
+func (t *TaskList) calculateRowHeight(task core.Task, cols columnWidths) int {
 	// In small screen mode, height is fixed at 2 lines per task (description + narrow fields)
 	if t.needsSmallScreenMode() {
 		return 1 + len(t.narrowViewFields) // 1 for description line + narrow view fields
 	}
 
 	// Render the task row and count newlines
-	rendered := t.renderTaskRow(task, false, false, "")
+	rendered := t.renderTaskRow(task, cols, false, false, "")
 	return strings.Count(rendered, "\n") + 1
 }
 
@@ -333,8 +586,9 @@ func (t *TaskList) rebuildRowHeights() {
 	t.rowHeights = make([]int, len(t.tasks))
 	t.rowHeightsWidth = t.width
 
+	cols := t.calculateColumnWidths()
 	for i, task := range t.tasks {
-		t.rowHeights[i] = t.calculateRowHeight(task)
+		t.rowHeights[i] = t.calculateRowHeight(task, cols)
 	}
 }
 
@@ -413,6 +667,16 @@ func (t TaskList) Update(msg tea.Msg) (TaskList, tea.Cmd) {
 
 // handleKey processes keyboard input
 func (t TaskList) handleKey(msg tea.KeyMsg) TaskList {
+	// While jump labels are shown, keys spell a label
+	if t.jumpActive {
+		if msg.String() == "esc" {
+			t.CancelJump()
+		} else {
+			t.JumpKey(msg.String())
+		}
+		return t
+	}
+
 	switch msg.String() {
 	case "j", "down":
 		t.moveDown()
@@ -422,6 +686,14 @@ func (t TaskList) handleKey(msg tea.KeyMsg) TaskList {
 		t.moveToStart()
 	case "G":
 		t.moveToEnd()
+	case "ctrl+d":
+		t.HalfPageDown()
+	case "ctrl+u":
+		t.HalfPageUp()
+	case "ctrl+f", "pgdown":
+		t.PageDown()
+	case "ctrl+b", "pgup":
+		t.PageUp()
 	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
 		// Quick jump to visible task by number
 		t.quickJump(msg.String())
@@ -429,7 +701,7 @@ func (t TaskList) handleKey(msg tea.KeyMsg) TaskList {
 	return t
 }
 
-// moveDown moves cursor down one position
+// moveDown moves cursor down one position, wrapping to the first row when enabled
 func (t *TaskList) moveDown() {
 	itemCount := t.itemCount()
 	if itemCount == 0 {
@@ -438,17 +710,26 @@ func (t *TaskList) moveDown() {
 	if t.cursor < itemCount-1 {
 		t.cursor++
 		t.updateScroll()
+	} else if t.navWrap {
+		t.moveToStart()
 	}
 }
 
-// moveUp moves cursor up one position
+// moveUp moves cursor up one position, wrapping to the last row when enabled
 func (t *TaskList) moveUp() {
 	if t.cursor > 0 {
 		t.cursor--
 		t.updateScroll()
+	} else if t.navWrap {
+		t.moveToEnd()
 	}
 }
 
+// SetNavWrap sets whether moving past the last row goes to the first one and back
+func (t *TaskList) SetNavWrap(enabled bool) {
+	t.navWrap = enabled
+}
+
 // MoveCursorUp moves the cursor to the previous task (public, for external callers)
 func (t *TaskList) MoveCursorUp() {
 	t.moveUp()
@@ -459,6 +740,52 @@ func (t *TaskList) MoveCursorDown() {
 	t.moveDown()
 }
 
+// MoveToStart jumps to the first task or group (public, for external callers)
+func (t *TaskList) MoveToStart() {
+	t.moveToStart()
+}
+
+// MoveToEnd jumps to the last task or group (public, for external callers)
+func (t *TaskList) MoveToEnd() {
+	t.moveToEnd()
+}
+
+// HalfPageDown moves the cursor down by half the visible rows
+func (t *TaskList) HalfPageDown() {
+	t.moveBy(max(t.pageRows()/2, 1))
+}
+
+// HalfPageUp moves the cursor up by half the visible rows
+func (t *TaskList) HalfPageUp() {
+	t.moveBy(-max(t.pageRows()/2, 1))
+}
+
+// PageDown moves the cursor down by the visible rows
+func (t *TaskList) PageDown() {
+	t.moveBy(t.pageRows())
+}
+
+// PageUp moves the cursor up by the visible rows
+func (t *TaskList) PageUp() {
+	t.moveBy(-t.pageRows())
+}
+
+// pageRows returns the number of rows a page move covers
+func (t TaskList) pageRows() int {
+	_, visible := t.scrollExtent()
+	return visible
+}
+
+// moveBy moves the cursor by delta rows, stopping at the first and last row
+func (t *TaskList) moveBy(delta int) {
+	itemCount := t.itemCount()
+	if itemCount == 0 {
+		return
+	}
+	t.cursor = min(max(t.cursor+delta, 0), itemCount-1)
+	t.updateScroll()
+}
+
 // moveToStart jumps to first task
 func (t *TaskList) moveToStart() {
 	if t.itemCount() > 0 {
@@ -494,6 +821,48 @@ func (t TaskList) itemCount() int {
 	return len(t.tasks)
 }
 
+// scrollExtent returns the total content size and the viewport size, in the same
+// units as the scroll offset (items for groups and small screens, lines otherwise)
+func (t TaskList) scrollExtent() (total, visible int) {
+	if t.displayMode == DisplayModeGroups || t.needsSmallScreenMode() {
+		// Mirrors updateScrollSimple
+		if t.needsSmallScreenMode() {
+			visible = t.height / t.smallScreenLinesPerTask()
+		} else {
+			visible = t.height - 2 // header + separator
+		}
+		return t.itemCount(), max(visible, 1)
+	}
+	return t.getTotalContentHeight(), max(t.height-2, 1)
+}
+
+// ScrollPosition describes how far the viewport is scrolled, like an editor ruler:
+// "All" when everything fits, "Top" or "Bot" at either end, a percentage otherwise
+func (t TaskList) ScrollPosition() string {
+	total, visible := t.scrollExtent()
+	maxOffset := total - visible
+	switch {
+	case maxOffset <= 0:
+		return "All"
+	case t.offset <= 0:
+		return "Top"
+	case t.offset >= maxOffset:
+		return "Bot"
+	default:
+		return fmt.Sprintf("%d%%", t.offset*100/maxOffset)
+	}
+}
+
+// PositionIndicator returns the cursor position and scroll position (e.g. "12/230 Top"),
+// or an empty string when the list is empty
+func (t TaskList) PositionIndicator() string {
+	count := t.itemCount()
+	if count == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d %s", t.cursor+1, count, t.ScrollPosition())
+}
+
 // updateScroll adjusts the scroll offset to keep cursor visible with a configurable buffer.
 //
 // The scroll buffer (scrollBuffer) determines how many tasks remain visible above and below
@@ -665,10 +1034,47 @@ func (t *TaskList) updateScrollSimple() {
 
 // View renders the task list or group list
 func (t TaskList) View() string {
+	var content string
 	if t.displayMode == DisplayModeGroups {
-		return t.renderGroupList()
+		content = t.renderGroupList()
+	} else {
+		content = t.renderTaskList()
+	}
+	if !t.scrollbar || t.itemCount() == 0 {
+		return content
+	}
+	return t.withScrollbar(content)
+}
+
+// withScrollbar appends the scrollbar column to the rendered list body.
+// Header lines (when shown) get a blank cell so the columns stay aligned.
+func (t TaskList) withScrollbar(content string) string {
+	lines := strings.Split(content, "\n")
+	headerHeight := 2
+	total, offset := 0, t.offset
+	switch {
+	case t.displayMode == DisplayModeGroups:
+		total = len(t.groups)
+	case t.needsSmallScreenMode():
+		// Small screen mode scrolls by tasks; convert to lines
+		headerHeight = 0
+		linesPerTask := t.smallScreenLinesPerTask()
+		total = len(t.tasks) * linesPerTask
+		offset *= linesPerTask
+	default:
+		total = t.getTotalContentHeight()
+	}
+	if headerHeight > len(lines) {
+		headerHeight = len(lines)
+	}
+
+	cells := make([]string, headerHeight, len(lines))
+	for i := range cells {
+		cells[i] = " "
 	}
-	return t.renderTaskList()
+	cells = append(cells, renderScrollbar(len(lines)-headerHeight, total, offset)...)
+	style := lipgloss.NewStyle().Foreground(t.styles.Separator.GetForeground())
+	return strings.Join(attachScrollbar(lines, t.width, cells, style), "\n")
 }
 
 // renderTaskList renders the task list
@@ -688,10 +1094,13 @@ func (t TaskList) renderTaskList() string {
 
 	var lines []string
 
+	// Column widths are computed once and shared by the header and every row
+	cols := t.calculateColumnWidths()
+
 	// Render column headers (returns 2 lines: header + separator)
 	// Skip headers in small screen mode to save space
 	if !isSmallScreen {
-		headerLines := strings.Split(t.renderHeader(), "\n")
+		headerLines := strings.Split(t.renderHeader(cols), "\n")
 		lines = append(lines, headerLines...)
 	}
 
@@ -715,7 +1124,7 @@ func (t TaskList) renderTaskList() string {
 			task := t.tasks[i]
 			isCursor := i == t.cursor
 			isMultiSelected := t.IsSelected(task.UUID)
-			taskLines := t.renderSmallScreenTaskLines(task, isCursor, isMultiSelected)
+			taskLines := t.renderSmallScreenTaskLines(task, isCursor, isMultiSelected, t.jumpLabelFor(i))
 			lines = append(lines, taskLines...)
 		}
 	} else {
@@ -730,15 +1139,15 @@ func (t TaskList) renderTaskList() string {
 			isCursor := i == t.cursor
 			isMultiSelected := t.IsSelected(task.UUID)
 
-			// Quick jump number (1-9 for visible tasks)
-			quickJump := ""
-			if visibleTaskNum < 9 {
+			// Quick jump number (1-9 for visible tasks), or the jump label
+			quickJump := t.jumpLabelFor(i)
+			if !t.jumpActive && visibleTaskNum < 9 {
 				quickJump = fmt.Sprintf("%d", visibleTaskNum+1)
 			}
 			visibleTaskNum++
 
 			// Render the task row with mini-table
-			rowContent := t.renderTaskRow(task, isCursor, isMultiSelected, quickJump)
+			rowContent := t.renderTaskRow(task, cols, isCursor, isMultiSelected, quickJump)
 
 			// Split row into lines (in case it wrapped)
 			rowLines := strings.Split(rowContent, "\n")
@@ -784,8 +1193,8 @@ func (t TaskList) renderGroupList() string {
 	for i := t.offset; i < endIdx; i++ {
 		group := t.groups[i]
 		isSelected := i == t.cursor
-		quickJump := ""
-		if i-t.offset < 9 {
+		quickJump := t.jumpLabelFor(i)
+		if !t.jumpActive && i-t.offset < 9 {
 			quickJump = fmt.Sprintf("%d", i-t.offset+1)
 		}
 		line := t.renderGroupLine(group, isSelected, quickJump)
@@ -801,29 +1210,39 @@ func (t TaskList) renderGroupList() string {
 }
 
 // renderHeader renders the column header row
-func (t TaskList) renderHeader() string {
-	cols := t.calculateColumnWidths()
+func (t TaskList) renderHeader(cols columnWidths) string {
+	sortColumn := t.sortHeaderColumn()
 
 	// Build header dynamically based on displayColumns
 	parts := []string{"   "} // Cursor (2) + padding (1) to match table
 
-	for _, col := range t.displayColumns {
+	for i, col := range t.displayColumns {
 		width := cols.widths[col]
 		// Use custom label from configuration, or uppercase column name as fallback
 		name := t.columnLabels[col]
 		if name == "" {
 			name = strings.ToUpper(col)
 		}
+		if col == sortColumn {
+			name = t.sortedLabel(name, width)
+		} else {
+			name = truncate(name, width, t.ellipsis)
+		}
 
-		// All columns use the same width formatting for consistency with table
-		parts = append(parts, fmt.Sprintf("%-*s ", width, truncate(name, width)))
+		// All columns use the same width formatting for consistency with table.
+		// The space after the last column is left blank.
+		gap := t.columnSeparator
+		if i == len(t.displayColumns)-1 {
+			gap = strings.Repeat(" ", lipgloss.Width(gap))
+		}
+		parts = append(parts, t.padColumn(col, name, width)+gap)
 	}
 
 	header := strings.Join(parts, "")
 
 	// Truncate header to width if necessary
-	if len(header) > t.width {
-		header = header[:t.width]
+	if utf8.RuneCountInString(header) > t.width {
+		header = truncate(header, t.width, "")
 	}
 
 	// Render header with exact width
@@ -831,23 +1250,38 @@ func (t TaskList) renderHeader() string {
 
 	// Separator should match the actual width
 	separatorWidth := t.width
-	if len(header) < t.width {
-		separatorWidth = len(header)
+	if n := utf8.RuneCountInString(header); n < t.width {
+		separatorWidth = n
 	}
 	separator := strings.Repeat("─", separatorWidth)
 
 	return styledHeader + "\n" + t.styles.Separator.Render(separator)
 }
 
-// truncate truncates a string to the given length
-func truncate(s string, length int) string {
-	if len(s) <= length {
+// defaultEllipsis marks truncated text when tui.ellipsis is not set
+const defaultEllipsis = "..."
+
+// Column alignments for tui.column_align
+const (
+	ColumnAlignLeft  = "left"
+	ColumnAlignRight = "right"
+)
+
+// defaultColumnSeparator is drawn between columns when tui.column_separator is not set
+const defaultColumnSeparator = " "
+
+// truncate shortens a string to the given length in characters, ending it with
+// ellipsis when there is room for it
+func truncate(s string, length int, ellipsis string) string {
+	runes := []rune(s)
+	if len(runes) <= length {
 		return s
 	}
-	if length <= 3 {
-		return s[:length]
+	tail := utf8.RuneCountInString(ellipsis)
+	if length <= tail {
+		return string(runes[:max(length, 0)])
 	}
-	return s[:length-3] + "..."
+	return string(runes[:length-tail]) + ellipsis
 }
 
 // columnWidths holds calculated column widths
@@ -875,15 +1309,31 @@ func isDateColumn(col string) bool {
 	}
 }
 
-// getEffectiveColumnWidth returns the column width, accounting for relativeDates setting
+// getEffectiveColumnWidth returns the column width, accounting for relative dates and long UUID settings
 func (t TaskList) getEffectiveColumnWidth(columnName string) (width int, isFixed bool) {
+	if t.longUUIDs && (columnName == "id" || columnName == "uuid") {
+		return t.effectiveUUIDLength() + 1, true
+	}
 	w, fixed := getColumnWidth(columnName)
+	if columnName == "due" && t.dueDisplay == DueDisplayRelative {
+		return relativeDueWidth, true
+	}
 	if fixed && isDateColumn(columnName) && t.relativeDates {
 		return 14, true // Relative dates are shorter (max ~13 chars)
 	}
 	return w, fixed
 }
 
+// customColumnLength returns the user-configured length for a column, if any.
+// Long UUID mode overrides custom lengths of the id and uuid columns.
+func (t TaskList) customColumnLength(columnName string) (int, bool) {
+	if t.longUUIDs && (columnName == "id" || columnName == "uuid") {
+		return 0, false
+	}
+	length, ok := t.columnLengths[columnName]
+	return length, ok && length > 0
+}
+
 // getColumnWidth returns the default width for a column type
 func getColumnWidth(columnName string) (width int, isFixed bool) {
 	switch columnName {
@@ -936,7 +1386,7 @@ func (t TaskList) needsSmallScreenMode() bool {
 	for _, col := range t.displayColumns {
 		if col == "description" {
 			requiredWidth += minDescriptionWidth + spacing
-		} else if customLength, hasCustom := t.columnLengths[col]; hasCustom && customLength > 0 {
+		} else if customLength, hasCustom := t.customColumnLength(col); hasCustom {
 			requiredWidth += customLength + spacing
 		} else {
 			w, _ := t.getEffectiveColumnWidth(col)
@@ -954,20 +1404,18 @@ func (t TaskList) smallScreenLinesPerTask() int {
 
 // calculateColumnWidths determines column widths based on available space
 func (t TaskList) calculateColumnWidths() columnWidths {
-	const (
-		cursorWidth = 2 // "■ " or "  "
-		spacing     = 1 // Space between columns
-	)
+	const cursorWidth = 2                        // "■ " or "  "
+	spacing := lipgloss.Width(t.columnSeparator) // Separator after each column
 
 	widths := make(map[string]int)
 
 	// Calculate fixed width and identify flexible columns
-	fixedWidth := cursorWidth
+	fixedWidth := cursorWidth + 1 // Cursor and its padding
 	var flexibleColumns []string
 
 	for _, col := range t.displayColumns {
 		// Check if user specified a custom length for this column
-		if customLength, hasCustom := t.columnLengths[col]; hasCustom && customLength > 0 {
+		if customLength, hasCustom := t.customColumnLength(col); hasCustom {
 			// Use custom length specified by user
 			widths[col] = customLength
 			fixedWidth += customLength + spacing
@@ -983,8 +1431,8 @@ func (t TaskList) calculateColumnWidths() columnWidths {
 		}
 	}
 
-	// Calculate remaining width for flexible columns
-	remainingWidth := t.width - fixedWidth
+	// Calculate remaining width for flexible columns, after their separators
+	remainingWidth := t.width - fixedWidth - spacing*len(flexibleColumns)
 	if remainingWidth < 0 {
 		remainingWidth = 0
 	}
@@ -1042,9 +1490,7 @@ func (t TaskList) calculateColumnWidths() columnWidths {
 }
 
 // renderTaskLine renders a single task row
-func (t TaskList) renderTaskLine(task core.Task, isCursor bool, isMultiSelected bool, quickJump string) string {
-	cols := t.calculateColumnWidths()
-
+func (t TaskList) renderTaskLine(task core.Task, cols columnWidths, isCursor bool, isMultiSelected bool, quickJump string) string {
 	// First column: cursor and multi-select indicator
 	// Cursor (current line): "■", Multi-selected: "✓", Both: "◆", Neither: " "
 	cursor := " "
@@ -1069,6 +1515,11 @@ func (t TaskList) renderTaskLine(task core.Task, isCursor bool, isMultiSelected
 			value = "-"
 		}
 
+		// Count tags that do not fit the column
+		if col == "tags" {
+			value = t.formatTags(task, cols.widths[col])
+		}
+
 		// Handle description separately (add status icons)
 		if col == "description" {
 			// Add status icon prefix for description
@@ -1086,16 +1537,13 @@ func (t TaskList) renderTaskLine(task core.Task, isCursor bool, isMultiSelected
 		isDateColumn := col == "due" || col == "scheduled" || col == "wait" ||
 			col == "start" || col == "entry" || col == "modified" || col == "end"
 
-		if len(value) > width {
-			if isDateColumn {
-				// Hard truncate date columns without ellipses
-				value = value[:width]
-			} else if width > 3 {
-				// Add ellipses for other columns
-				value = value[:width-3] + "..."
-			} else {
+		if isDateColumn {
+			// Hard truncate date columns without ellipses
+			if len(value) > width {
 				value = value[:width]
 			}
+		} else {
+			value = truncate(value, width, t.ellipsis)
 		}
 
 		// Pad value to column width BEFORE applying styling
@@ -1104,7 +1552,7 @@ func (t TaskList) renderTaskLine(task core.Task, isCursor bool, isMultiSelected
 			// These will be handled with styling below, just add space
 		} else {
 			// Pad to width for consistent column alignment
-			value = fmt.Sprintf("%-*s", width, value)
+			value = t.padColumn(col, value, width)
 		}
 
 		// Apply styling for specific columns AFTER padding
@@ -1123,7 +1571,7 @@ func (t TaskList) renderTaskLine(task core.Task, isCursor bool, isMultiSelected
 				}
 				value = priorityStyle.Render(value)
 			}
-			parts = append(parts, value+" ")
+			parts = append(parts, value+t.columnSeparator)
 			continue
 
 		case "due":
@@ -1134,8 +1582,8 @@ func (t TaskList) renderTaskLine(task core.Task, isCursor bool, isMultiSelected
 			}
 		}
 
-		// Add to parts with trailing space
-		parts = append(parts, value+" ")
+		// Add to parts with the column separator
+		parts = append(parts, value+t.columnSeparator)
 	}
 
 	line := strings.Join(parts, "")
@@ -1173,6 +1621,11 @@ func (t TaskList) renderTaskLine(task core.Task, isCursor bool, isMultiSelected
 				lineStyle = lineStyle.
 					Foreground(t.styles.StatusCompleted).
 					Strikethrough(true)
+			default:
+				// Dim tasks that are not actionable yet
+				if t.isDimmed(task) {
+					lineStyle = lineStyle.Foreground(t.styles.StatusWaiting)
+				}
 			}
 		}
 	}
@@ -1182,9 +1635,7 @@ func (t TaskList) renderTaskLine(task core.Task, isCursor bool, isMultiSelected
 
 // renderTaskRow renders a single task row using a mini-table for proper cell wrapping
 // This enables long descriptions to wrap within their cell rather than breaking layout
-func (t TaskList) renderTaskRow(task core.Task, isCursor bool, isMultiSelected bool, quickJump string) string {
-	cols := t.calculateColumnWidths()
-
+func (t TaskList) renderTaskRow(task core.Task, cols columnWidths, isCursor bool, isMultiSelected bool, quickJump string) string {
 	// Build row data array from task properties
 	var rowData []string
 
@@ -1198,6 +1649,9 @@ func (t TaskList) renderTaskRow(task core.Task, isCursor bool, isMultiSelected b
 	} else if isMultiSelected {
 		cursor = "*"
 	}
+	if t.jumpActive && quickJump != "" {
+		cursor = quickJump
+	}
 	rowData = append(rowData, cursor)
 
 	// Add task property values for each column
@@ -1208,6 +1662,11 @@ func (t TaskList) renderTaskRow(task core.Task, isCursor bool, isMultiSelected b
 			value = "-"
 		}
 
+		// Count tags that do not fit the column
+		if col == "tags" {
+			value = t.formatTags(task, cols.widths[col])
+		}
+
 		// Handle description separately (add status icons)
 		if col == "description" {
 			statusIcon := ""
@@ -1249,8 +1708,43 @@ func (t TaskList) renderTaskRow(task core.Task, isCursor bool, isMultiSelected b
 				rowStyle = rowStyle.Foreground(t.styles.StatusWaiting).Italic(true)
 			case "deleted":
 				rowStyle = rowStyle.Foreground(t.styles.StatusCompleted).Strikethrough(true)
+			default:
+				if t.isDimmed(task) {
+					rowStyle = rowStyle.Foreground(t.styles.StatusWaiting)
+				}
+			}
+		}
+	}
+
+	// Style of a task column: priority and overdue coloring when not selected
+	columnStyle := func(colName string) lipgloss.Style {
+		cellStyle := rowStyle
+
+		// Apply special styling for priority column when not selected
+		if colName == "priority" && !isCursor && !isMultiSelected && task.Priority != "" {
+			switch task.Priority {
+			case "H":
+				cellStyle = cellStyle.Foreground(t.styles.PriorityHigh)
+			case "M":
+				cellStyle = cellStyle.Foreground(t.styles.PriorityMedium)
+			case "L":
+				cellStyle = cellStyle.Foreground(t.styles.PriorityLow)
 			}
 		}
+
+		// Apply overdue styling for due column when not selected
+		if colName == "due" && !isCursor && !isMultiSelected && task.IsOverdue() {
+			cellStyle = cellStyle.Foreground(t.styles.DueOverdue)
+		}
+
+		if t.rightAligned(colName) {
+			cellStyle = cellStyle.Align(lipgloss.Right)
+		}
+		return cellStyle
+	}
+
+	if t.columnSeparator != defaultColumnSeparator {
+		return t.renderSeparatedRow(rowData, cols, rowStyle, columnStyle, isCursor || isMultiSelected)
 	}
 
 	// Create single-row table with wrapping enabled for description column
@@ -1266,30 +1760,9 @@ func (t TaskList) renderTaskRow(task core.Task, isCursor bool, isMultiSelected b
 		BorderRow(false).
 		BorderColumn(false).
 		StyleFunc(func(row, col int) lipgloss.Style {
-			// Apply priority coloring for priority column
 			if col > 0 && col <= len(t.displayColumns) {
-				colName := t.displayColumns[col-1]
-				cellStyle := rowStyle
-
-				// Apply special styling for priority column when not selected
-				if colName == "priority" && !isCursor && !isMultiSelected && task.Priority != "" {
-					switch task.Priority {
-					case "H":
-						cellStyle = cellStyle.Foreground(t.styles.PriorityHigh)
-					case "M":
-						cellStyle = cellStyle.Foreground(t.styles.PriorityMedium)
-					case "L":
-						cellStyle = cellStyle.Foreground(t.styles.PriorityLow)
-					}
-				}
-
-				// Apply overdue styling for due column when not selected
-				if colName == "due" && !isCursor && !isMultiSelected && task.IsOverdue() {
-					cellStyle = cellStyle.Foreground(t.styles.DueOverdue)
-				}
-
 				// Set column width with padding for spacing between columns
-				return cellStyle.Width(tableWidths[col]).PaddingRight(1)
+				return columnStyle(t.displayColumns[col-1]).Width(tableWidths[col]).PaddingRight(1)
 			}
 
 			// Cursor column style
@@ -1299,11 +1772,63 @@ func (t TaskList) renderTaskRow(task core.Task, isCursor bool, isMultiSelected b
 	return tbl.Render()
 }
 
+// renderSeparatedRow renders a task row like renderTaskRow, with the column separator
+// in a column of its own between the task columns. The separator is repeated on
+// every line of rows whose description wraps.
+func (t TaskList) renderSeparatedRow(rowData []string, cols columnWidths, rowStyle lipgloss.Style, columnStyle func(string) lipgloss.Style, selected bool) string {
+	separatorWidth := lipgloss.Width(t.columnSeparator)
+	separatorStyle := t.styles.Separator
+	if selected {
+		separatorStyle = t.styles.Selection
+	}
+
+	render := func(height int) string {
+		separator := strings.TrimSuffix(strings.Repeat(t.columnSeparator+"\n", height), "\n")
+
+		// Cursor, then each task column followed by a separator, except the last
+		cells := []string{rowData[0]}
+		styles := []lipgloss.Style{rowStyle.Width(3).PaddingRight(1)}
+		for i, col := range t.displayColumns {
+			width := cols.widths[col]
+			if i == len(t.displayColumns)-1 {
+				// Blank space after the last column, as in the header
+				cells = append(cells, rowData[i+1])
+				styles = append(styles, columnStyle(col).Width(width+separatorWidth).PaddingRight(separatorWidth))
+				break
+			}
+			cells = append(cells, rowData[i+1], separator)
+			styles = append(styles, columnStyle(col).Width(width), separatorStyle.Width(separatorWidth))
+		}
+
+		return table.New().
+			Row(cells...).
+			Width(t.width).
+			Wrap(true).
+			Border(lipgloss.Border{}).
+			BorderTop(false).
+			BorderBottom(false).
+			BorderLeft(false).
+			BorderRight(false).
+			BorderRow(false).
+			BorderColumn(false).
+			StyleFunc(func(row, col int) lipgloss.Style {
+				return styles[col]
+			}).
+			Render()
+	}
+
+	rendered := render(1)
+	if height := lipgloss.Height(rendered); height > 1 {
+		rendered = render(height)
+	}
+	return rendered
+}
+
 // renderSmallScreenTaskLines renders a task as multiple lines for small screens
 // Line 1: Cursor (1) + Space (1) + Description
 // Line 2+: Configured fields (2 space indent to align with description)
-func (t TaskList) renderSmallScreenTaskLines(task core.Task, isCursor bool, isMultiSelected bool) []string {
-	// Cursor indicator
+func (t TaskList) renderSmallScreenTaskLines(task core.Task, isCursor bool, isMultiSelected bool, jumpLabel string) []string {
+	// Cursor indicator, replaced by the jump label while labels are shown
 	cursor := " "
 	if isCursor && isMultiSelected {
 		cursor = "+"
@@ -1312,6 +1837,9 @@ func (t TaskList) renderSmallScreenTaskLines(task core.Task, isCursor bool, isMu
 	} else if isMultiSelected {
 		cursor = "*"
 	}
+	if jumpLabel != "" {
+		cursor = jumpLabel
+	}
 
 	// Status icon prefix for description
 	statusIcon := ""
@@ -1342,6 +1870,10 @@ func (t TaskList) renderSmallScreenTaskLines(task core.Task, isCursor bool, isMu
 				lineStyle = lineStyle.Foreground(t.styles.StatusWaiting).Italic(true)
 			case "deleted":
 				lineStyle = lineStyle.Foreground(t.styles.StatusCompleted).Strikethrough(true)
+			default:
+				if t.isDimmed(task) {
+					lineStyle = lineStyle.Foreground(t.styles.StatusWaiting)
+				}
 			}
 		}
 	}
@@ -1372,12 +1904,8 @@ func (t TaskList) renderSmallScreenTaskLines(task core.Task, isCursor bool, isMu
 
 		// Apply length limit if configured
 		maxLength := t.narrowViewLengths[fieldName]
-		if maxLength > 0 && len(fieldLine) > maxLength {
-			if maxLength > 3 {
-				fieldLine = fieldLine[:maxLength-3] + "..."
-			} else {
-				fieldLine = fieldLine[:maxLength]
-			}
+		if maxLength > 0 {
+			fieldLine = truncate(fieldLine, maxLength, t.ellipsis)
 		}
 
 		lines = append(lines, lineStyle.Width(t.width).Render(fieldLine))
@@ -1392,7 +1920,12 @@ func (t TaskList) renderGroupHeader() string {
 	if title == "" {
 		title = "PROJECT"
 	}
-	header := fmt.Sprintf("  %-50s TASK COUNT", title)
+	// Keep the count column aligned with group lines on narrow widths
+	nameWidth := 50
+	if t.width-20 < nameWidth {
+		nameWidth = max(t.width-20, 1)
+	}
+	header := fmt.Sprintf("  %-*s TASK COUNT", nameWidth, title)
 
 	styledHeader := t.styles.Header.Width(t.width).Render(header)
 	separator := strings.Repeat("─", t.width)
@@ -1402,9 +1935,11 @@ func (t TaskList) renderGroupHeader() string {
 
 // renderGroupLine renders a single group row
 func (t TaskList) renderGroupLine(group core.TaskGroup, isSelected bool, quickJump string) string {
-	// Cursor or quick jump number
+	// Cursor or quick jump number; jump labels replace both
 	cursor := " "
-	if isSelected {
+	if t.jumpActive && quickJump != "" {
+		cursor = quickJump
+	} else if isSelected {
 		cursor = "■"
 	} else if quickJump != "" {
 		cursor = quickJump
@@ -1439,11 +1974,7 @@ func (t TaskList) renderGroupLine(group core.TaskGroup, isSelected bool, quickJu
 	}
 
 	// Truncate if too long
-	if len(nameWithPrefix) > maxNameWidth && maxNameWidth > 3 {
-		nameWithPrefix = nameWithPrefix[:maxNameWidth-3] + "..."
-	} else if len(nameWithPrefix) > maxNameWidth {
-		nameWithPrefix = nameWithPrefix[:maxNameWidth]
-	}
+	nameWithPrefix = truncate(nameWithPrefix, maxNameWidth, t.ellipsis)
 
 	// Task count (optional, can be removed if not needed)
 	countStr := ""
@@ -1493,6 +2024,11 @@ func (t TaskList) SelectedIndex() int {
 	return t.cursor
 }
 
+// Tasks returns the tasks shown in the list, in display order
+func (t TaskList) Tasks() []core.Task {
+	return t.tasks
+}
+
 // TaskCount returns the total number of tasks
 func (t TaskList) TaskCount() int {
 	return len(t.tasks)
@@ -1503,6 +2039,35 @@ func (t TaskList) Cursor() int {
 	return t.cursor
 }
 
+// SetCursor moves the cursor to the given index, clamped to the item range
+func (t *TaskList) SetCursor(index int) {
+	itemCount := t.itemCount()
+	if index >= itemCount {
+		index = itemCount - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	t.cursor = index
+	t.updateScroll()
+}
+
+// SelectTask moves the cursor to the task with the given UUID.
+// Returns false when the list does not show that task.
+func (t *TaskList) SelectTask(uuid string) bool {
+	if t.displayMode != DisplayModeTasks {
+		return false
+	}
+	for i, task := range t.tasks {
+		if task.UUID == uuid {
+			t.cursor = i
+			t.updateScroll()
+			return true
+		}
+	}
+	return false
+}
+
 // ToggleSelection toggles the selection state of the current task
 func (t *TaskList) ToggleSelection() {
 	if t.displayMode != DisplayModeTasks {
@@ -1,6 +1,7 @@
 package components
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -189,6 +190,48 @@ func TestNavigationJumpToEnd(t *testing.T) {
 	}
 }
 
+func TestFirstLastKeysBothDisplayModes(t *testing.T) {
+	tasks := NewTaskList(80, 7, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	taskItems := make([]core.Task, 10)
+	for i := range taskItems {
+		taskItems[i] = core.Task{ID: i + 1, Description: fmt.Sprintf("Task %d", i+1)}
+	}
+	tasks.SetTasks(taskItems)
+
+	groups := NewTaskList(80, 7, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	groupItems := make([]core.TaskGroup, 10)
+	for i := range groupItems {
+		groupItems[i] = core.TaskGroup{Name: fmt.Sprintf("Group %d", i+1), Percentage: -1}
+	}
+	groups.SetGroups(groupItems)
+
+	for name, tl := range map[string]TaskList{"tasks": tasks, "groups": groups} {
+		t.Run(name, func(t *testing.T) {
+			tl, _ = tl.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+			if tl.Cursor() != 9 {
+				t.Errorf("Expected G to move to the last item, got %d", tl.Cursor())
+			}
+			if tl.offset == 0 {
+				t.Error("Expected G to scroll the last item into view")
+			}
+
+			tl, _ = tl.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+			if tl.Cursor() != 0 || tl.offset != 0 {
+				t.Errorf("Expected g to move to the first item, got cursor %d offset %d", tl.Cursor(), tl.offset)
+			}
+
+			tl.MoveToEnd()
+			if tl.Cursor() != 9 {
+				t.Errorf("Expected MoveToEnd to move to the last item, got %d", tl.Cursor())
+			}
+			tl.MoveToStart()
+			if tl.Cursor() != 0 {
+				t.Errorf("Expected MoveToStart to move to the first item, got %d", tl.Cursor())
+			}
+		})
+	}
+}
+
 func TestKeyboardNavigation(t *testing.T) {
 	tl := NewTaskList(80, 24, testColumns("id", "project", "description", "due", "priority"), config.Columns{}, defaultTaskListStyles())
 	tl.SetTasks([]core.Task{
@@ -363,6 +406,40 @@ func TestDueDateColorCoding(t *testing.T) {
 	}
 }
 
+func TestDimFutureTasks(t *testing.T) {
+	future := time.Now().Add(48 * time.Hour)
+	past := time.Now().Add(-48 * time.Hour)
+
+	scheduled := core.Task{ID: 1, Description: "Scheduled later", Scheduled: &future, Status: "pending"}
+	waiting := core.Task{ID: 2, Description: "Parked", Wait: &future, Status: "pending"}
+	ready := core.Task{ID: 3, Description: "Ready", Scheduled: &past, Status: "pending"}
+	overdue := core.Task{ID: 4, Description: "Overdue", Scheduled: &future, Due: &past, Status: "pending"}
+
+	tl := NewTaskList(80, 10, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	if tl.isDimmed(scheduled) {
+		t.Error("Expected no dimming when dim_future is disabled")
+	}
+
+	tl.SetDimFuture(true)
+	if !tl.isDimmed(scheduled) {
+		t.Error("Expected future-scheduled task to be dimmed")
+	}
+	if !tl.isDimmed(waiting) {
+		t.Error("Expected task with a future wait date to be dimmed")
+	}
+	if tl.isDimmed(ready) {
+		t.Error("Expected ready task not to be dimmed")
+	}
+	if tl.isDimmed(overdue) {
+		t.Error("Expected overdue styling to take precedence over dimming")
+	}
+
+	tl.SetTasks([]core.Task{scheduled, ready})
+	if view := tl.View(); !strings.Contains(view, "Scheduled later") || !strings.Contains(view, "Ready") {
+		t.Error("Expected dimmed and ready tasks to be rendered")
+	}
+}
+
 func TestTaskCount(t *testing.T) {
 	tl := NewTaskList(80, 24, testColumns("id", "project", "description", "due", "priority"), config.Columns{}, defaultTaskListStyles())
 	tl.SetTasks([]core.Task{
@@ -431,3 +508,555 @@ func TestCompletedTasksSortedToBottom(t *testing.T) {
 		t.Errorf("Expected 5 tasks, got %d", len(tl.tasks))
 	}
 }
+
+func TestPageNavigation(t *testing.T) {
+	tl := NewTaskList(80, 22, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	tasks := make([]core.Task, 100)
+	for i := range tasks {
+		tasks[i] = core.Task{ID: i + 1, UUID: fmt.Sprintf("uuid-%d", i+1), Description: fmt.Sprintf("Task %d", i+1), Status: "pending"}
+	}
+	tl.SetTasks(tasks)
+
+	// 20 rows are visible below the header
+	steps := []struct {
+		key    tea.KeyType
+		cursor int
+		offset int
+	}{
+		{tea.KeyCtrlD, 10, 0},
+		{tea.KeyCtrlF, 30, 12},
+		{tea.KeyPgDown, 50, 32},
+		{tea.KeyCtrlB, 30, 29},
+		{tea.KeyCtrlU, 20, 19},
+		{tea.KeyPgUp, 0, 0},
+		{tea.KeyCtrlU, 0, 0},
+	}
+	for _, step := range steps {
+		tl, _ = tl.Update(tea.KeyMsg{Type: step.key})
+		if tl.cursor != step.cursor || tl.offset != step.offset {
+			t.Errorf("After %v expected cursor %d offset %d, got cursor %d offset %d",
+				step.key, step.cursor, step.offset, tl.cursor, tl.offset)
+		}
+	}
+
+	// Page moves stop at the last task
+	tl.MoveToEnd()
+	tl, _ = tl.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	if tl.cursor != 99 || tl.offset != 80 {
+		t.Errorf("Expected the last task at the bottom, got cursor %d offset %d", tl.cursor, tl.offset)
+	}
+}
+
+func TestNavWrap(t *testing.T) {
+	tl := NewTaskList(80, 22, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	tasks := make([]core.Task, 50)
+	for i := range tasks {
+		tasks[i] = core.Task{ID: i + 1, UUID: fmt.Sprintf("uuid-%d", i+1), Description: fmt.Sprintf("Task %d", i+1), Status: "pending"}
+	}
+	tl.SetTasks(tasks)
+
+	// Clamped by default
+	tl.MoveCursorUp()
+	if tl.cursor != 0 || tl.offset != 0 {
+		t.Errorf("Expected the cursor to stay on the first row, got cursor %d offset %d", tl.cursor, tl.offset)
+	}
+	tl.MoveToEnd()
+	tl.MoveCursorDown()
+	if tl.cursor != 49 || tl.offset != 30 {
+		t.Errorf("Expected the cursor to stay on the last row, got cursor %d offset %d", tl.cursor, tl.offset)
+	}
+
+	tl.SetNavWrap(true)
+	tl, _ = tl.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if tl.cursor != 0 || tl.offset != 0 {
+		t.Errorf("Expected j on the last row to wrap to the top, got cursor %d offset %d", tl.cursor, tl.offset)
+	}
+	tl, _ = tl.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	if tl.cursor != 49 || tl.offset != 30 {
+		t.Errorf("Expected k on the first row to wrap to the bottom, got cursor %d offset %d", tl.cursor, tl.offset)
+	}
+
+	// Rows in between move as usual
+	tl, _ = tl.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	if tl.cursor != 48 {
+		t.Errorf("Expected k to move up one row, got cursor %d", tl.cursor)
+	}
+}
+
+func TestToggleCompletedLast(t *testing.T) {
+	tl := NewTaskList(80, 24, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	tasks := []core.Task{
+		{ID: 1, UUID: "uuid-1", Description: "Pending 1", Status: "pending"},
+		{UUID: "uuid-2", Description: "Completed 1", Status: "completed"},
+		{ID: 3, UUID: "uuid-3", Description: "Pending 2", Status: "pending"},
+		{UUID: "uuid-4", Description: "Completed 2", Status: "completed"},
+	}
+
+	if !tl.CompletedLast() {
+		t.Fatal("Expected completed tasks at the bottom by default")
+	}
+
+	tl.ToggleCompletedLast()
+	if tl.CompletedLast() {
+		t.Fatal("Expected the toggle to keep completed tasks in place")
+	}
+	tl.SetTasks(tasks)
+	for i, task := range tl.tasks {
+		if task.UUID != tasks[i].UUID {
+			t.Errorf("Expected the service order at %d (%s), got %s", i, tasks[i].UUID, task.UUID)
+		}
+	}
+
+	// Section sorting still applies with completed tasks in place
+	tl.SetTasksWithSort(tasks, "alphabetic", false)
+	expected := []string{"uuid-2", "uuid-4", "uuid-1", "uuid-3"}
+	for i, task := range tl.tasks {
+		if task.UUID != expected[i] {
+			t.Errorf("Expected %s at %d, got %s", expected[i], i, task.UUID)
+		}
+	}
+
+	tl.ToggleCompletedLast()
+	tl.SetTasks(tasks)
+	if tl.tasks[2].Status != "completed" || tl.tasks[3].Status != "completed" {
+		t.Errorf("Expected completed tasks back at the bottom, got %+v", tl.tasks)
+	}
+}
+
+func TestCompletedTasksSortedByEnd(t *testing.T) {
+	base := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	at := func(days int) *time.Time {
+		d := base.AddDate(0, 0, days)
+		return &d
+	}
+
+	tasks := []core.Task{
+		{UUID: "done-old", Status: "completed", End: at(1)},
+		{UUID: "pending-late", Status: "pending", Due: at(5)},
+		{UUID: "done-new", Status: "completed", End: at(3)},
+		{UUID: "done-none", Status: "completed"},
+		{UUID: "pending-soon", Status: "pending", Due: at(2)},
+		{UUID: "done-mid", Status: "completed", End: at(2)},
+	}
+
+	order := func(tl TaskList) []string {
+		var uuids []string
+		for _, task := range tl.tasks {
+			uuids = append(uuids, task.UUID)
+		}
+		return uuids
+	}
+	assertOrder := func(t *testing.T, got, expected []string) {
+		t.Helper()
+		if strings.Join(got, ",") != strings.Join(expected, ",") {
+			t.Errorf("Expected order %v, got %v", expected, got)
+		}
+	}
+
+	tl := NewTaskList(80, 24, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+
+	// Default: pending keep their input order, completed by end date descending
+	tl.SetTasks(tasks)
+	assertOrder(t, order(tl), []string{"pending-late", "pending-soon", "done-new", "done-mid", "done-old", "done-none"})
+
+	// Section sort applies to pending tasks only
+	tl.SetTasksWithSort(tasks, "due", false)
+	assertOrder(t, order(tl), []string{"pending-soon", "pending-late", "done-new", "done-mid", "done-old", "done-none"})
+
+	// "none" keeps completed tasks in the section order
+	tl.SetCompletedSort("none")
+	tl.SetTasks(tasks)
+	assertOrder(t, order(tl), []string{"pending-late", "pending-soon", "done-old", "done-new", "done-none", "done-mid"})
+
+	// Empty restores the default
+	tl.SetCompletedSort("")
+	tl.SetTasks(tasks)
+	assertOrder(t, order(tl), []string{"pending-late", "pending-soon", "done-new", "done-mid", "done-old", "done-none"})
+}
+
+func TestPositionIndicator(t *testing.T) {
+	tl := NewTaskList(80, 12, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	if got := tl.PositionIndicator(); got != "" {
+		t.Errorf("Expected no indicator for an empty list, got %q", got)
+	}
+
+	tasks := make([]core.Task, 30)
+	for i := range tasks {
+		tasks[i] = core.Task{ID: i + 1, UUID: fmt.Sprintf("uuid-%d", i+1), Description: "Task", Status: "pending"}
+	}
+	tl.SetTasks(tasks)
+
+	// 30 one-line rows in a 10-line viewport: offsets range from 0 to 20
+	tests := []struct {
+		cursor   int
+		offset   int
+		expected string
+	}{
+		{0, 0, "1/30 Top"},
+		{11, 5, "12/30 25%"},
+		{19, 10, "20/30 50%"},
+		{24, 19, "25/30 95%"},
+		{29, 20, "30/30 Bot"},
+	}
+	for _, tt := range tests {
+		tl.cursor = tt.cursor
+		tl.offset = tt.offset
+		if got := tl.PositionIndicator(); got != tt.expected {
+			t.Errorf("cursor=%d offset=%d: expected %q, got %q", tt.cursor, tt.offset, tt.expected, got)
+		}
+	}
+
+	// Navigation keeps the indicator in sync with the scroll offset
+	tl.cursor = 0
+	tl.offset = 0
+	tl.moveToEnd()
+	if got := tl.PositionIndicator(); got != "30/30 Bot" {
+		t.Errorf("Expected bottom position after moving to the last task, got %q", got)
+	}
+
+	// Everything fits
+	tl.SetTasks(tasks[:5])
+	tl.cursor = 2
+	if got := tl.PositionIndicator(); got != "3/5 All" {
+		t.Errorf("Expected %q, got %q", "3/5 All", got)
+	}
+}
+
+func TestPositionIndicatorGroups(t *testing.T) {
+	tl := NewTaskList(80, 7, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	groups := make([]core.TaskGroup, 10)
+	for i := range groups {
+		groups[i] = core.TaskGroup{Name: fmt.Sprintf("Group %d", i+1), Percentage: -1}
+	}
+	tl.SetGroups(groups)
+
+	// 10 groups in a 5-row viewport: offsets range from 0 to 5
+	tl.cursor = 6
+	tl.offset = 2
+	if got := tl.PositionIndicator(); got != "7/10 40%" {
+		t.Errorf("Expected %q, got %q", "7/10 40%", got)
+	}
+}
+
+func TestProjectDisplayModes(t *testing.T) {
+	task := core.Task{ID: 1, UUID: "uuid-1", Description: "Task", Project: "Work.company1", Status: "pending"}
+	tests := []struct {
+		mode     string
+		expected string
+	}{
+		{"full", "Work.company1"},
+		{"leaf", "company1"},
+		{"abbreviated", "W.company1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			tl := NewTaskList(100, 10, testColumns("id", "project", "description"), config.Columns{}, defaultTaskListStyles())
+			tl.SetProjectDisplay(tt.mode)
+			tl.SetTasks([]core.Task{task})
+
+			if got, _ := tl.getTaskValue(task, "project"); got != tt.expected {
+				t.Errorf("Expected project value %q, got %q", tt.expected, got)
+			}
+			if view := tl.View(); !strings.Contains(view, tt.expected) {
+				t.Errorf("Expected %q in the rendered row, got:\n%s", tt.expected, view)
+			}
+			if tt.mode != "full" && strings.Contains(tl.View(), "Work.company1") {
+				t.Error("Expected the full project name to be shortened")
+			}
+		})
+	}
+
+	tl := NewTaskList(100, 10, testColumns("id", "project", "description"), config.Columns{}, defaultTaskListStyles())
+	tl.SetProjectDisplay("leaf")
+	if got, _ := tl.getTaskValue(core.Task{UUID: "uuid-2"}, "project"); got != "-" {
+		t.Errorf("Expected placeholder for a task without a project, got %q", got)
+	}
+}
+
+func TestLongUUIDColumnWidths(t *testing.T) {
+	tl := NewTaskList(100, 10, testColumns("id", "uuid", "description"), config.Columns{}, defaultTaskListStyles())
+	task := core.Task{ID: 0, UUID: "a1b2c3d4-e5f6-7890-abcd-ef1234567890", Description: "Completed task", Status: "completed"}
+	tl.SetTasks([]core.Task{task})
+
+	// Short mode: default widths
+	cols := tl.calculateColumnWidths()
+	if cols.widths["id"] != 4 || cols.widths["uuid"] != 9 {
+		t.Errorf("Expected short widths id=4 uuid=9, got id=%d uuid=%d", cols.widths["id"], cols.widths["uuid"])
+	}
+	shortDescription := cols.widths["description"]
+	if got, _ := tl.getTaskValue(task, "id"); got != "X" {
+		t.Errorf("Expected placeholder id in short mode, got %q", got)
+	}
+
+	tl.ToggleLongUUIDs()
+	if !tl.LongUUIDs() {
+		t.Fatal("Expected long UUIDs after toggle")
+	}
+	cols = tl.calculateColumnWidths()
+	if cols.widths["id"] != 14 || cols.widths["uuid"] != 14 {
+		t.Errorf("Expected long widths id=14 uuid=14, got id=%d uuid=%d", cols.widths["id"], cols.widths["uuid"])
+	}
+	if cols.widths["description"] != shortDescription-15 {
+		t.Errorf("Expected description to shrink by 15, got %d (was %d)", cols.widths["description"], shortDescription)
+	}
+	if got, _ := tl.getTaskValue(task, "id"); got != "a1b2c3d4-e5f6" {
+		t.Errorf("Expected UUID prefix in id column, got %q", got)
+	}
+	if !strings.Contains(tl.View(), "a1b2c3d4-e5f6") {
+		t.Error("Expected the UUID prefix in the rendered row")
+	}
+
+	// Tasks with an ID keep showing it
+	if got, _ := tl.getTaskValue(core.Task{ID: 7, UUID: task.UUID}, "id"); got != "7" {
+		t.Errorf("Expected numeric id for a pending task, got %q", got)
+	}
+
+	// Configured length, overriding a custom column length
+	tl = NewTaskList(100, 10, config.Columns{{Name: "id", Length: 3}, {Name: "description"}}, config.Columns{}, defaultTaskListStyles())
+	tl.SetUUIDLength(8)
+	tl.ToggleLongUUIDs()
+	if got := tl.calculateColumnWidths().widths["id"]; got != 9 {
+		t.Errorf("Expected id width 9 for an 8-char prefix, got %d", got)
+	}
+	tl.ToggleLongUUIDs()
+	if got := tl.calculateColumnWidths().widths["id"]; got != 3 {
+		t.Errorf("Expected custom id width 3 in short mode, got %d", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s, ellipsis string
+		length      int
+		expected    string
+	}{
+		{"short", "...", 10, "short"},
+		{"exactly ten", "...", 11, "exactly ten"},
+		{"a long description", "...", 10, "a long ..."},
+		{"a long description", "…", 10, "a long de…"},
+		{"a long description", "…", 1, "a"},
+		{"a long description", "...", 2, "a "},
+		{"déjà vu all over again", "…", 6, "déjà …"},
+	}
+
+	for _, tt := range tests {
+		got := truncate(tt.s, tt.length, tt.ellipsis)
+		if got != tt.expected {
+			t.Errorf("truncate(%q, %d, %q) = %q, expected %q", tt.s, tt.length, tt.ellipsis, got, tt.expected)
+		}
+		if n := len([]rune(got)); n > tt.length && len([]rune(tt.s)) > tt.length {
+			t.Errorf("truncate(%q, %d, %q) is %d characters long", tt.s, tt.length, tt.ellipsis, n)
+		}
+	}
+}
+
+func TestEllipsisInNarrowView(t *testing.T) {
+	task := core.Task{ID: 1, UUID: "uuid-1", Description: "Task", Project: "Home.renovation.kitchen.cabinets", Status: "pending"}
+	narrowFields := config.Columns{{Name: "project", Label: "Project", Length: 20}}
+
+	for _, ellipsis := range []string{"", "…", "~"} {
+		tl := NewTaskList(40, 10, testColumns("id", "project", "description"), narrowFields, defaultTaskListStyles())
+		tl.SetForceSmallScreen(true)
+		tl.SetEllipsis(ellipsis)
+		tl.SetTasks([]core.Task{task})
+
+		expected := ellipsis
+		if expected == "" {
+			expected = defaultEllipsis
+		}
+		lines := tl.renderSmallScreenTaskLines(task, false, false, "")
+		var field string
+		for _, line := range lines {
+			if strings.Contains(line, "Project:") {
+				field = strings.TrimRight(line, " ")
+			}
+		}
+		if !strings.HasSuffix(field, expected) {
+			t.Errorf("Expected the project field to end with %q, got %q", expected, field)
+		}
+		if n := len([]rune(field)); n != 20 {
+			t.Errorf("Expected the project field to be cut to 20 characters with %q, got %d: %q", expected, n, field)
+		}
+	}
+}
+
+func TestDueDisplayModes(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.Local)
+	core.SetNowFunc(func() time.Time { return now })
+	t.Cleanup(func() { core.SetNowFunc(nil) })
+
+	due := time.Date(2026, 10, 17, 12, 0, 0, 0, time.Local)
+	task := core.Task{ID: 1, UUID: "uuid-1", Description: "Task", Due: &due, Status: "pending"}
+	tests := []struct {
+		mode     string
+		expected string
+		width    int
+	}{
+		{"", "2026-10-17 12:00", 17},
+		{DueDisplayAbsolute, "2026-10-17 12:00", 17},
+		{DueDisplayRelative, "in 2d", relativeDueWidth},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			tl := NewTaskList(100, 10, testColumns("id", "due", "description"), config.Columns{}, defaultTaskListStyles())
+			tl.SetDueDisplay(tt.mode)
+			tl.SetTasks([]core.Task{task})
+
+			if got, _ := tl.getTaskValue(task, "due"); got != tt.expected {
+				t.Errorf("Expected due value %q, got %q", tt.expected, got)
+			}
+			if got := tl.calculateColumnWidths().widths["due"]; got != tt.width {
+				t.Errorf("Expected due column width %d, got %d", tt.width, got)
+			}
+			if view := tl.View(); !strings.Contains(view, tt.expected) {
+				t.Errorf("Expected %q in the rendered row, got:\n%s", tt.expected, view)
+			}
+		})
+	}
+
+	tl := NewTaskList(100, 10, testColumns("id", "due", "description"), config.Columns{}, defaultTaskListStyles())
+	tl.SetDueDisplay(DueDisplayRelative)
+	if got, _ := tl.getTaskValue(core.Task{UUID: "uuid-2"}, "due"); got != "-" {
+		t.Errorf("Expected placeholder for a task without a due date, got %q", got)
+	}
+}
+
+func TestFormatRelativeDue(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.Local)
+	core.SetNowFunc(func() time.Time { return now })
+	t.Cleanup(func() { core.SetNowFunc(nil) })
+
+	tests := []struct {
+		offset   time.Duration
+		expected string
+	}{
+		{30 * time.Second, "now"},
+		{59 * time.Minute, "in 59min"},
+		{-59 * time.Minute, "59min ago"},
+		{2 * 24 * time.Hour, "in 2d"},
+		{-3 * 24 * time.Hour, "3d ago"},
+		{13 * 24 * time.Hour, "in 13d"},
+		{-7 * 24 * time.Hour, "7d ago"},
+		{14 * 24 * time.Hour, "in 2w"},
+		{21 * 24 * time.Hour, "in 3w"},
+		{-11 * 30 * 24 * time.Hour, "11mo ago"},
+	}
+	for _, tt := range tests {
+		got := formatRelativeDue(now.Add(tt.offset))
+		if got != tt.expected {
+			t.Errorf("formatRelativeDue(%v) = %q, expected %q", tt.offset, got, tt.expected)
+		}
+		if len(got)+1 > relativeDueWidth {
+			t.Errorf("Expected %q to fit the relative due column", got)
+		}
+	}
+}
+
+func TestHeaderAndRowsShareColumnWidths(t *testing.T) {
+	tl := NewTaskList(100, 10, testColumns("id", "project", "description", "priority"), config.Columns{}, defaultTaskListStyles())
+	tl.SetTasks([]core.Task{
+		{ID: 1, UUID: "uuid-1", Project: "Alpha", Description: "First task", Priority: "H"},
+		{ID: 22, UUID: "uuid-2", Project: "Alpha", Description: "Second task with a longer description"},
+	})
+
+	lines := strings.Split(tl.renderTaskList(), "\n")
+	column := strings.Index(lines[0], "PROJECT")
+	if column < 0 {
+		t.Fatalf("Expected the PROJECT header, got:\n%s", lines[0])
+	}
+	for _, line := range lines[2:4] {
+		if got := strings.Index(line, "Alpha"); got != column {
+			t.Errorf("Expected the project at column %d like the header, got %d in %q", column, got, line)
+		}
+	}
+}
+
+func BenchmarkRenderTaskList(b *testing.B) {
+	tasks := make([]core.Task, 500)
+	for i := range tasks {
+		tasks[i] = core.Task{
+			ID:          i + 1,
+			UUID:        fmt.Sprintf("uuid-%d", i),
+			Project:     "Work.code",
+			Description: fmt.Sprintf("Task number %d with a description", i),
+			Priority:    "M",
+			Tags:        []string{"bench"},
+		}
+	}
+	tl := NewTaskList(120, 100, testColumns("id", "project", "priority", "tags", "description", "due"), config.Columns{}, defaultTaskListStyles())
+	tl.SetTasks(tasks)
+
+	b.ResetTimer()
+	for range b.N {
+		tl.View()
+	}
+}
+
+func TestColumnSeparator(t *testing.T) {
+	tl := NewTaskList(60, 20, testColumns("id", "project", "priority", "description"), config.Columns{}, defaultTaskListStyles())
+	tl.SetColumnSeparator(" │ ")
+	tl.SetTasks([]core.Task{
+		{ID: 1, UUID: "uuid-1", Project: "Alpha", Description: "First task", Priority: "H"},
+		{ID: 22, UUID: "uuid-2", Project: "Alpha", Description: "Second task with a description long enough to wrap on a narrow screen"},
+	})
+
+	// separatorColumns returns the display columns of the separators in a line
+	separatorColumns := func(line string) []int {
+		var columns []int
+		for i, r := range []rune(line) {
+			if r == '│' {
+				columns = append(columns, i)
+			}
+		}
+		return columns
+	}
+
+	lines := strings.Split(tl.renderTaskList(), "\n")
+	expected := separatorColumns(lines[0])
+	if len(expected) != 3 {
+		t.Fatalf("Expected 3 separators in the header, got %d: %q", len(expected), lines[0])
+	}
+
+	rows := 0
+	for _, line := range lines[2:] {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		rows++
+		if got := separatorColumns(line); fmt.Sprint(got) != fmt.Sprint(expected) {
+			t.Errorf("Expected separators at %v like the header, got %v in %q", expected, got, line)
+		}
+	}
+	if rows < 3 {
+		t.Errorf("Expected the long description to wrap, got %d row lines:\n%s", rows, strings.Join(lines, "\n"))
+	}
+}
+
+func TestColumnAlign(t *testing.T) {
+	tasks := []core.Task{
+		{ID: 1, UUID: "uuid-1", Description: "First task"},
+		{ID: 22, UUID: "uuid-2", Description: "Second task"},
+	}
+	tests := []struct {
+		name     string
+		align    map[string]string
+		expected []string // Header and row prefixes, after the cursor column
+	}{
+		{"numeric columns default to right", nil, []string{"  ID ", "   1 ", "  22 "}},
+		{"left", map[string]string{"id": ColumnAlignLeft}, []string{"ID   ", "1    ", "22   "}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl := NewTaskList(60, 10, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+			tl.SetColumnAlign(tt.align)
+			tl.SetTasks(tasks)
+
+			lines := strings.Split(tl.renderTaskList(), "\n")
+			got := []string{lines[0][3:8], lines[2][3:8], lines[3][3:8]}
+			if fmt.Sprint(got) != fmt.Sprint(tt.expected) {
+				t.Errorf("Expected id column %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package components
+
+import (
+	"unicode/utf8"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// SortKeys lists the column sorts that can be chosen in the task list, in cycle order
+var SortKeys = []string{"id", "due", "priority", "project", "urgency", "description"}
+
+// SetSortKey sorts the tasks by a column (one of SortKeys), replacing the section sort.
+// Ascending shows the most important first for priority and urgency. Tasks without a
+// due date, priority or project sort last in both directions, and completed tasks stay
+// at the bottom. An empty key goes back to the section sort from the next SetTasks call.
+func (t *TaskList) SetSortKey(key string, descending bool) {
+	t.sortKey = key
+	t.sortDescending = descending
+	if key != "" && t.displayMode == DisplayModeTasks {
+		t.SetTasksWithSort(t.tasks, "", false)
+	}
+}
+
+// SortKey returns the column sort chosen in the list and whether it is reversed
+func (t TaskList) SortKey() (string, bool) {
+	return t.sortKey, t.sortDescending
+}
+
+// compareSortKey compares two tasks by a column sort key.
+// Returns: -1 if taskI comes first, 0 if equal, 1 if taskJ comes first
+func compareSortKey(taskI, taskJ core.Task, key string, descending bool) int {
+	missingI, missingJ := sortValueMissing(taskI, key), sortValueMissing(taskJ, key)
+	if missingI != missingJ {
+		if missingI {
+			return 1
+		}
+		return -1
+	}
+
+	result := compareTasks(taskI, taskJ, key)
+	if descending {
+		return -result
+	}
+	return result
+}
+
+// sortValueMissing reports whether the task has no value for the sort key
+func sortValueMissing(task core.Task, key string) bool {
+	switch key {
+	case "due":
+		return task.Due == nil
+	case "priority":
+		return task.Priority == ""
+	case "project":
+		return task.Project == ""
+	}
+	return false
+}
+
+// priorityRank orders priorities from the most important: H, M, L, then none
+func priorityRank(priority string) int {
+	switch priority {
+	case "H":
+		return 0
+	case "M":
+		return 1
+	case "L":
+		return 2
+	}
+	return 3
+}
+
+// sortHeaderColumn returns the column whose header shows the column sort: the sorted
+// column, or the description when the sorted column is not shown (e.g. urgency)
+func (t TaskList) sortHeaderColumn() string {
+	if t.sortKey == "" {
+		return ""
+	}
+	if t.hasColumn(t.sortKey) {
+		return t.sortKey
+	}
+	return "description"
+}
+
+// sortedLabel adds the sort direction to a header label of the given width, shortening
+// the label when needed. The description label also names the sort key when it is not
+// a shown column.
+func (t TaskList) sortedLabel(label string, width int) string {
+	indicator := "▲"
+	if t.sortDescending {
+		indicator = "▼"
+	}
+	if !t.hasColumn(t.sortKey) {
+		indicator = "(" + t.sortKey + " " + indicator + ")"
+	}
+
+	room := width - utf8.RuneCountInString(indicator) - 1
+	if room <= 0 {
+		return truncate(indicator, width, "")
+	}
+	return truncate(label, room, t.ellipsis) + " " + indicator
+}
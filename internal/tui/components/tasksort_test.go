@@ -0,0 +1,105 @@
+package components
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// sortedUUIDs returns the UUIDs of the tasks in list order
+func sortedUUIDs(tl TaskList) string {
+	uuids := make([]string, len(tl.tasks))
+	for i, task := range tl.tasks {
+		uuids[i] = task.UUID
+	}
+	return strings.Join(uuids, ",")
+}
+
+func TestSetSortKey(t *testing.T) {
+	early := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	tasks := []core.Task{
+		{UUID: "a", ID: 3, Description: "beta", Project: "Work", Priority: "L", Due: &late, Urgency: 2, Status: "pending"},
+		{UUID: "b", ID: 0, Description: "alpha", Priority: "H", Due: &early, Urgency: 9, Status: "completed"},
+		{UUID: "c", ID: 1, Description: "gamma", Project: "home", Urgency: 5, Status: "pending"},
+		{UUID: "d", ID: 2, Description: "Delta", Project: "Work", Priority: "H", Due: &early, Urgency: 1, Status: "waiting"},
+		{UUID: "e", ID: 4, Description: "alpha", Status: "pending"},
+	}
+
+	tests := []struct {
+		key        string
+		descending bool
+		expected   string
+	}{
+		{"id", false, "c,d,a,e,b"},
+		{"id", true, "e,a,d,c,b"},
+		// Tasks without a due date sort last in both directions, keeping their order
+		{"due", false, "d,a,c,e,b"},
+		{"due", true, "a,d,c,e,b"},
+		// Tasks without a priority sort last in both directions
+		{"priority", false, "d,a,c,e,b"},
+		{"priority", true, "a,d,c,e,b"},
+		{"project", false, "c,a,d,e,b"},
+		{"urgency", false, "c,a,d,e,b"},
+		{"description", false, "e,a,d,c,b"},
+	}
+
+	for _, tt := range tests {
+		tl := NewTaskList(80, 24, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+		tl.SetTasks(tasks)
+		tl.SetSortKey(tt.key, tt.descending)
+		// Completed tasks stay at the bottom whatever the sort
+		if got := sortedUUIDs(tl); got != tt.expected {
+			t.Errorf("SetSortKey(%q, %v) = %s, expected %s", tt.key, tt.descending, got, tt.expected)
+		}
+
+		// The sort also applies to tasks loaded later, replacing the section sort
+		tl.SetTasksWithSort(tasks, "alphabetic", true)
+		if got := sortedUUIDs(tl); got != tt.expected {
+			t.Errorf("Expected %q to replace the section sort, got %s", tt.key, got)
+		}
+	}
+}
+
+func TestSortKeyCleared(t *testing.T) {
+	tl := NewTaskList(80, 24, testColumns("id", "description"), config.Columns{}, defaultTaskListStyles())
+	tasks := []core.Task{{UUID: "a", ID: 2}, {UUID: "b", ID: 1}}
+	tl.SetTasks(tasks)
+
+	tl.SetSortKey("id", false)
+	tl.SetSortKey("", false)
+	tl.SetTasks(tasks)
+	if got := sortedUUIDs(tl); got != "a,b" {
+		t.Errorf("Expected the service order without a sort key, got %s", got)
+	}
+}
+
+func TestSortKeyHeader(t *testing.T) {
+	tl := NewTaskList(80, 24, testColumns("id", "priority", "description"), config.Columns{}, defaultTaskListStyles())
+	tl.SetTasks([]core.Task{{UUID: "a", ID: 1, Description: "Task"}})
+
+	if header := tl.renderHeader(tl.calculateColumnWidths()); strings.ContainsAny(header, "▲▼") {
+		t.Errorf("Expected no sort indicator without a sort key, got %q", header)
+	}
+
+	tl.SetSortKey("id", false)
+	if header := tl.renderHeader(tl.calculateColumnWidths()); !strings.Contains(header, "ID ▲") {
+		t.Errorf("Expected the id column to show the ascending sort, got %q", header)
+	}
+
+	// A single character column only shows the indicator
+	tl.SetSortKey("priority", true)
+	header := tl.renderHeader(tl.calculateColumnWidths())
+	if !strings.Contains(header, "▼") || strings.Contains(header, "ID ▲") {
+		t.Errorf("Expected the priority column to show the descending sort, got %q", header)
+	}
+
+	// A sort key without a column is named in the description header
+	tl.SetSortKey("urgency", true)
+	if header := tl.renderHeader(tl.calculateColumnWidths()); !strings.Contains(header, "DESCRIPTION (urgency ▼)") {
+		t.Errorf("Expected the description header to name the urgency sort, got %q", header)
+	}
+}
@@ -3,10 +3,10 @@ package components
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/clobrano/wui/internal/core"
 )
 
 // TimePicker is a time selection component for HH:MM format
@@ -20,7 +20,7 @@ type TimePicker struct {
 
 // NewTimePicker creates a new time picker with default to current hour, minute 00
 func NewTimePicker() TimePicker {
-	now := time.Now()
+	now := core.Now()
 	return TimePicker{
 		hour:   now.Hour(),
 		minute: 0, // Always default to :00
@@ -67,7 +67,7 @@ func (t TimePicker) Update(msg tea.Msg) (TimePicker, tea.Cmd) {
 
 		case "n":
 			// Set to current time (now)
-			now := time.Now()
+			now := core.Now()
 			t.hour = now.Hour()
 			t.minute = 0 // Still round to :00
 			return t, nil
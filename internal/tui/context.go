@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// noContextItem is the context picker entry that clears the active context
+const noContextItem = "(none)"
+
+// loadActiveContextCmd creates a command to load the active context shown in the footer
+func loadActiveContextCmd(service core.TaskService) tea.Cmd {
+	return func() tea.Msg {
+		name, err := service.GetContext()
+		if err != nil {
+			slog.Debug("Failed to load the active context", "error", err)
+		}
+		return ActiveContextMsg{Name: name, Err: err}
+	}
+}
+
+// loadContextsCmd creates a command to load the contexts offered by the context picker
+func loadContextsCmd(service core.TaskService) tea.Cmd {
+	return func() tea.Msg {
+		contexts, err := service.ListContexts()
+		if err != nil {
+			return ContextsLoadedMsg{Err: err}
+		}
+		active, err := service.GetContext()
+		return ContextsLoadedMsg{Contexts: contexts, Active: active, Err: err}
+	}
+}
+
+// setContextCmd creates a command to activate a context, or clear it when name is ""
+func setContextCmd(service core.TaskService, name string) tea.Cmd {
+	return func() tea.Msg {
+		return ContextChangedMsg{Name: name, Err: service.SetContext(name)}
+	}
+}
+
+// openContextPicker lists the loaded contexts, after the entry clearing the active one
+func (m Model) openContextPicker(msg ContextsLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.Err != nil {
+		m.errorMessage = m.text(msgErrLoadContexts, msg.Err)
+		return m, nil
+	}
+	if len(msg.Contexts) == 0 {
+		m.statusMessage = m.text(msgNoContexts)
+		return m, nil
+	}
+
+	m.activeContext = msg.Active
+	title := "Contexts"
+	if msg.Active != "" {
+		title = fmt.Sprintf("Contexts (active: %s)", msg.Active)
+	}
+	m.contextPicker = components.NewListPicker(title, slices.Concat([]string{noContextItem}, msg.Contexts), "")
+	m.contextPickerActive = true
+	m.state = StateContextPicker
+	return m, nil
+}
+
+// deactivateContextPicker closes the context picker
+func (m *Model) deactivateContextPicker() {
+	m.contextPickerActive = false
+	m.state = StateNormal
+}
+
+// handleContextPickerKeys handles input while the context picker is shown
+func (m Model) handleContextPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "enter":
+		selected := m.contextPicker.SelectedItem()
+		m.deactivateContextPicker()
+		if selected == "" {
+			return m, nil
+		}
+		if selected == noContextItem {
+			selected = ""
+		}
+		if selected == m.activeContext {
+			return m, nil
+		}
+		return m, setContextCmd(m.service, selected)
+
+	case "esc":
+		m.deactivateContextPicker()
+		return m, nil
+
+	default:
+		m.contextPicker, cmd = m.contextPicker.Update(msg)
+		return m, cmd
+	}
+}
+
+// contextChanged shows the new context and reloads the tasks it filters
+func (m Model) contextChanged(msg ContextChangedMsg) (tea.Model, tea.Cmd) {
+	if msg.Err != nil {
+		m.errorMessage = m.text(msgErrSetContext, msg.Err)
+		return m, nil
+	}
+
+	m.activeContext = msg.Name
+	if msg.Name == "" {
+		m.statusMessage = m.text(msgContextCleared)
+	} else {
+		m.statusMessage = m.text(msgContextSet, msg.Name)
+	}
+	m.isLoading = true
+	isSearchTab := m.currentSection != nil && m.currentSection.Name == "Search"
+	return m, loadTasksCmd(m.service, m.activeFilter, isSearchTab, m.searchAnnotations())
+}
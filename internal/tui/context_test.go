@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// createContextModel returns a model whose service lists contexts, with active
+// as the active one, and records the contexts set
+func createContextModel(contexts []string, active string) (Model, *[]string) {
+	var set []string
+	service := &core.MockTaskService{
+		ListContextsFunc: func() ([]string, error) { return contexts, nil },
+		GetContextFunc:   func() (string, error) { return active, nil },
+		SetContextFunc: func(name string) error {
+			set = append(set, name)
+			return nil
+		},
+		ExportFunc: func(filter string) ([]core.Task, error) { return nil, nil },
+	}
+	return createTestModel(service), &set
+}
+
+// openContexts presses the context key and applies the loaded contexts
+func openContexts(t *testing.T, model Model) Model {
+	t.Helper()
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlX})
+	if cmd == nil {
+		t.Fatal("Expected a command loading the contexts")
+	}
+	updated, _ = updated.(Model).Update(cmd())
+	return updated.(Model)
+}
+
+func TestContextPickerSetsContext(t *testing.T) {
+	model, set := createContextModel([]string{"home", "work"}, "")
+
+	model = openContexts(t, model)
+	if !model.contextPickerActive || model.state != StateContextPicker {
+		t.Fatal("Expected the context picker to open")
+	}
+
+	// (none) comes first, then the contexts
+	for range 2 {
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+		model = updated.(Model)
+	}
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.contextPickerActive || cmd == nil {
+		t.Fatal("Expected enter to close the picker and set the context")
+	}
+	updated, reload := model.Update(cmd())
+	model = updated.(Model)
+
+	if len(*set) != 1 || (*set)[0] != "work" {
+		t.Errorf("Expected the work context to be set, got %v", *set)
+	}
+	if model.activeContext != "work" || model.statusMessage != "Context set to work" {
+		t.Errorf("Expected the work context to be shown, got %q (%q)", model.activeContext, model.statusMessage)
+	}
+	if !model.isLoading || reload == nil {
+		t.Error("Expected the tasks to be reloaded")
+	}
+	if !strings.Contains(model.renderFooter(), "Context: work") {
+		t.Errorf("Expected the active context in the footer, got %q", model.renderFooter())
+	}
+}
+
+func TestContextPickerClearsContext(t *testing.T) {
+	model, set := createContextModel([]string{"home"}, "home")
+
+	model = openContexts(t, model)
+	if model.activeContext != "home" {
+		t.Errorf("Expected the active context home, got %q", model.activeContext)
+	}
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.(Model).Update(cmd())
+	model = updated.(Model)
+
+	if len(*set) != 1 || (*set)[0] != "" {
+		t.Errorf("Expected the context to be cleared, got %v", *set)
+	}
+	if model.activeContext != "" || strings.Contains(model.renderFooter(), "Context:") {
+		t.Errorf("Expected no active context, got %q", model.activeContext)
+	}
+}
+
+func TestContextPickerWithoutContexts(t *testing.T) {
+	model, _ := createContextModel(nil, "")
+
+	model = openContexts(t, model)
+	if model.contextPickerActive {
+		t.Error("Expected no picker without contexts")
+	}
+	if model.statusMessage != model.text(msgNoContexts) {
+		t.Errorf("Expected the no contexts message, got %q", model.statusMessage)
+	}
+}
+
+func TestContextChangeError(t *testing.T) {
+	model, _ := createContextModel([]string{"home"}, "")
+
+	updated, _ := model.Update(ContextChangedMsg{Name: "home", Err: errors.New("context not defined")})
+	model = updated.(Model)
+	if model.activeContext != "" || !strings.Contains(model.errorMessage, "context not defined") {
+		t.Errorf("Expected the error to be shown, got %q", model.errorMessage)
+	}
+}
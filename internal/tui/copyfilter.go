@@ -0,0 +1,41 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/taskwarrior"
+)
+
+// filterCommand returns the taskwarrior command line that lists the tasks wui
+// loads for a filter, including the Search tab's status.any: prepend. The filter
+// is split into arguments like the client does, and each one is quoted for the shell.
+func filterCommand(filter string, isSearchTab bool, searchAnnotations bool) string {
+	return taskwarrior.CommandLine("task", taskwarrior.SplitFilter(taskFilter(filter, isSearchTab, searchAnnotations)))
+}
+
+// copyFilter copies the command line for the active filter to the clipboard
+func (m Model) copyFilter() (tea.Model, tea.Cmd) {
+	if m.activeFilter == "" {
+		m.statusMessage = m.text(msgNoFilterToCopy)
+		return m, nil
+	}
+	// The two-pane Projects view loads tasks without the Search tab composition
+	isSearchTab := m.viewMode != ViewModeProjectPanes && m.currentSection != nil && m.currentSection.Name == "Search"
+	return m, copyFilterCmd(m.language(), m.clipboard, filterCommand(m.activeFilter, isSearchTab, m.searchAnnotations()))
+}
+
+// copyFilterCmd copies a command line or other text to the clipboard
+func copyFilterCmd(language string, cb Clipboard, command string) tea.Cmd {
+	return func() tea.Msg {
+		if err := cb.WriteText(command); err != nil {
+			return StatusMsg{
+				Message: translate(language, msgErrCopyClipboard, command),
+				IsError: true,
+			}
+		}
+
+		return StatusMsg{
+			Message: translate(language, msgCopied, command),
+			IsError: false,
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package tui
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/taskwarrior"
+)
+
+func TestFilterCommandMatchesLoadTasksCmd(t *testing.T) {
+	var exported string
+	service := &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			exported = filter
+			return []core.Task{}, nil
+		},
+	}
+
+	tests := []struct {
+		name              string
+		filter            string
+		isSearchTab       bool
+		searchAnnotations bool
+		expected          string
+	}{
+		{"tab filter", "status:pending +next", false, false, "task status:pending +next"},
+		{"search", "project:home bug", true, false, "task status.any: project:home bug"},
+		{"search with status", "status:completed bug", true, false, "task status:completed bug"},
+		{"search annotations", "bug", true, true, "task status.any: '(' description '~' bug or annotations '~' bug ')'"},
+		{"quoted project", `project:"Home office" +next`, false, false, `task 'project:"Home office"' +next`},
+		{"shell characters", "due.before:eom urgency>5 project:it's", false, false, `task due.before:eom 'urgency>5' 'project:it'\''s'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterCommand(tt.filter, tt.isSearchTab, tt.searchAnnotations)
+			if got != tt.expected {
+				t.Errorf("filterCommand(%q) = %q, expected %q", tt.filter, got, tt.expected)
+			}
+
+			loadTasksCmd(service, tt.filter, tt.isSearchTab, tt.searchAnnotations)()
+			if got != taskwarrior.CommandLine("task", taskwarrior.SplitFilter(exported)) {
+				t.Errorf("Expected %q to run the exported filter %q", got, exported)
+			}
+
+			// The shell reads the line back as the words of the exported filter
+			out, err := exec.Command("sh", "-c", "set -- "+got+`; printf '%s\n' "$@"`).Output()
+			if err != nil {
+				t.Fatalf("Expected valid shell syntax in %q, got %v", got, err)
+			}
+			words := taskwarrior.SplitFilter("task " + exported)
+			if strings.Join(words, "\n")+"\n" != string(out) {
+				t.Errorf("Expected the shell to read %q, got %q", words, out)
+			}
+		})
+	}
+}
+
+func TestCopyFilterWithoutFilter(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.activeFilter = ""
+
+	updated, cmd := model.copyFilter()
+	if cmd != nil {
+		t.Error("Expected no copy command without a filter")
+	}
+	if got := updated.(Model).statusMessage; got != "No filter to copy" {
+		t.Errorf("Expected no-filter status, got %q", got)
+	}
+}
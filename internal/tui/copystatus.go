@@ -0,0 +1,29 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// statusSummary describes a tab's tasks for sharing, e.g.
+// "Next: 12 tasks (status:pending -WAITING)"
+func statusSummary(section string, count int, filter string) string {
+	noun := "tasks"
+	if count == 1 {
+		noun = "task"
+	}
+	summary := fmt.Sprintf("%s: %d %s", section, count, noun)
+	if filter != "" {
+		summary += " (" + filter + ")"
+	}
+	return summary
+}
+
+// copyStatus copies the current tab's name, task count and filter to the clipboard
+func (m Model) copyStatus() (tea.Model, tea.Cmd) {
+	if m.currentSection == nil {
+		return m, nil
+	}
+	return m, copyFilterCmd(m.language(), m.clipboard, statusSummary(m.currentSection.Name, len(m.tasks), m.activeFilter))
+}
@@ -0,0 +1,36 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestStatusSummary(t *testing.T) {
+	tests := []struct {
+		section  string
+		count    int
+		filter   string
+		expected string
+	}{
+		{"Next", 12, "status:pending -WAITING", "Next: 12 tasks (status:pending -WAITING)"},
+		{"Today", 1, "due:today", "Today: 1 task (due:today)"},
+		{"Waiting", 0, "status:waiting", "Waiting: 0 tasks (status:waiting)"},
+		{"Search", 3, "", "Search: 3 tasks"},
+	}
+
+	for _, tt := range tests {
+		if got := statusSummary(tt.section, tt.count, tt.filter); got != tt.expected {
+			t.Errorf("statusSummary(%q, %d, %q) = %q, expected %q", tt.section, tt.count, tt.filter, got, tt.expected)
+		}
+	}
+}
+
+func TestCopyStatusWithoutSection(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.currentSection = nil
+
+	if _, cmd := model.copyStatus(); cmd != nil {
+		t.Error("Expected no copy command without a tab")
+	}
+}
@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// counterValue returns the numeric value of a UDA, treating empty or non-numeric values as zero
+func counterValue(task core.Task, uda string) float64 {
+	value, err := strconv.ParseFloat(strings.TrimSpace(task.GetUDA(uda)), 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// counterModification returns the modify arguments that add delta to the task's UDA value.
+// The sum is rounded to the decimals of the value and delta, so that 0.2 plus a 0.1
+// step gives 0.3 rather than 0.30000000000000004.
+func counterModification(task core.Task, uda string, delta float64) string {
+	current := counterValue(task, uda)
+	precision := max(decimalPlaces(current), decimalPlaces(delta))
+	value, _ := strconv.ParseFloat(strconv.FormatFloat(current+delta, 'f', precision, 64), 64)
+	return uda + ":" + strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// decimalPlaces returns the number of decimals in the shortest representation of value
+func decimalPlaces(value float64) int {
+	formatted := strconv.FormatFloat(value, 'f', -1, 64)
+	if i := strings.IndexByte(formatted, '.'); i >= 0 {
+		return len(formatted) - i - 1
+	}
+	return 0
+}
+
+// counterStep returns the configured counter step, defaulting to 1
+func (m Model) counterStep() float64 {
+	if m.config.TUI != nil && m.config.TUI.CounterStep > 0 {
+		return m.config.TUI.CounterStep
+	}
+	return 1
+}
+
+// adjustCounter adds delta to the counter UDA of the selected task(s)
+func (m Model) adjustCounter(delta float64) (tea.Model, tea.Cmd) {
+	var uda string
+	if m.config.TUI != nil {
+		uda = m.config.TUI.CounterUDA
+	}
+	if uda == "" {
+		m.statusMessage = m.text(msgCounterNotConfigured)
+		return m, nil
+	}
+
+	selectedTasks := m.taskList.GetSelectedTasks()
+	if len(selectedTasks) == 0 {
+		return m, nil
+	}
+	m.taskList.ClearSelection()
+	return m, adjustCounterCmd(m.service, selectedTasks, uda, delta)
+}
+
+// adjustCounterCmd creates a command that adds delta to a UDA on each task, based on its own current value
+func adjustCounterCmd(service core.TaskService, tasks []core.Task, uda string, delta float64) tea.Cmd {
+	return func() tea.Msg {
+		var firstErr error
+		for _, task := range tasks {
+			err := service.Modify(task.UUID, counterModification(task, uda, delta))
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		return TaskModifiedMsg{
+			Err: firstErr,
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestCounterModification(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		delta    float64
+		expected string
+	}{
+		{"increment integer", "3", 1, "estimate:4"},
+		{"decrement integer", "3", -1, "estimate:2"},
+		{"fractional step", "1.5", 0.5, "estimate:2"},
+		{"decimal step", "0.2", 0.1, "estimate:0.3"},
+		{"decimal step down", "0.3", -0.1, "estimate:0.2"},
+		{"more decimals in the value", "1.25", 0.1, "estimate:1.35"},
+		{"below zero", "0", -2, "estimate:-2"},
+		{"empty starts from zero", "", 1, "estimate:1"},
+		{"non-numeric starts from zero", "lots", 2, "estimate:2"},
+		{"surrounding spaces", " 4 ", 1, "estimate:5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := core.Task{UDAs: map[string]string{"estimate": tt.value}}
+			if got := counterModification(task, "estimate", tt.delta); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	// Tasks without any UDAs start from zero too
+	if got := counterModification(core.Task{}, "points", 3); got != "points:3" {
+		t.Errorf("Expected points:3, got %q", got)
+	}
+}
+
+func TestCounterKeysModifyEachTask(t *testing.T) {
+	modified := map[string]string{}
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, modifications string) error {
+			modified[uuid] = modifications
+			return nil
+		},
+	}
+	model := createTestModel(service)
+	model.config.TUI.CounterUDA = "estimate"
+	model.config.TUI.CounterStep = 2
+	model.tasks[0].UDAs = map[string]string{"estimate": "5"}
+	model.taskList.SetTasks(model.tasks)
+
+	// Select the first two tasks; the second has no estimate yet
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	if cmd == nil {
+		t.Fatal("Expected modify command")
+	}
+	if _, ok := cmd().(TaskModifiedMsg); !ok {
+		t.Fatal("Expected TaskModifiedMsg")
+	}
+	if modified["test-uuid-1"] != "estimate:7" {
+		t.Errorf("Expected estimate:7, got %q", modified["test-uuid-1"])
+	}
+	if modified["test-uuid-2"] != "estimate:2" {
+		t.Errorf("Expected estimate:2, got %q", modified["test-uuid-2"])
+	}
+
+	// Decrement applies to the task under the cursor
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'['}})
+	if cmd == nil {
+		t.Fatal("Expected modify command")
+	}
+	cmd()
+	if modified["test-uuid-2"] != "estimate:-2" {
+		t.Errorf("Expected estimate:-2, got %q", modified["test-uuid-2"])
+	}
+}
+
+func TestCounterKeysWithoutUDA(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	model = updated.(Model)
+	if cmd != nil {
+		t.Error("Expected no command without a configured counter UDA")
+	}
+	if model.statusMessage != model.text(msgCounterNotConfigured) {
+		t.Errorf("Expected not configured message, got %q", model.statusMessage)
+	}
+}
@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// createCustomCommandsModel returns a model with two custom commands writing
+// their name and task UUIDs to out
+func createCustomCommandsModel(out string, refresh bool) Model {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.CustomCommands = map[string]config.CustomCommand{
+		"X": {Name: "First", Command: `sh -c "echo first $WUI_TASK_UUIDS > ` + out + `"`, Refresh: refresh},
+		"Z": {Name: "Second", Command: `sh -c "echo second $WUI_TASK_UUID > ` + out + `"`},
+	}
+	return model
+}
+
+func TestCustomCommandDispatch(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+	model := createCustomCommandsModel(out, false)
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Z")})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a command for the custom key")
+	}
+	msg := cmd()
+	if status, ok := msg.(StatusMsg); !ok || status.IsError || status.Message != "Executed: Second" {
+		t.Fatalf("Expected the second command to succeed, got %+v", msg)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("Expected the command output, got %v", err)
+	}
+	if got, expected := strings.TrimSpace(string(data)), "second "+model.taskList.SelectedTask().UUID; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestCustomCommandSelectedUUIDs(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+	model := createCustomCommandsModel(out, true)
+
+	// Select the first two tasks
+	for _, key := range []string{" ", "j", " "} {
+		model = pressKey(t, model, key)
+	}
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("X")})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a command for the custom key")
+	}
+
+	// A refreshing command reports its status and reloads the tasks
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("Expected a status and a refresh, got %+v", batch)
+	}
+	if _, ok := batch[1]().(RefreshMsg); !ok {
+		t.Error("Expected the task list to be refreshed")
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("Expected the command output, got %v", err)
+	}
+	var uuids []string
+	for _, task := range model.taskList.GetSelectedTasks() {
+		uuids = append(uuids, task.UUID)
+	}
+	if got, expected := strings.TrimSpace(string(data)), "first "+strings.Join(uuids, " "); got != expected || len(uuids) != 2 {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestCustomCommandFailureSkipsRefresh(t *testing.T) {
+	cmd := config.CustomCommand{Name: "Fail", Command: `sh -c "exit 3"`, Refresh: true}
+
+	msg := executeCustomCommand(defaultLanguage, cmd, []core.Task{{UUID: "a"}})()
+	if status, ok := msg.(StatusMsg); !ok || !status.IsError || !strings.Contains(status.Message, "exit code 3") {
+		t.Errorf("Expected a failure status without refresh, got %+v", msg)
+	}
+}
+
+func TestCustomCommandStatusFollowsLanguage(t *testing.T) {
+	cmd := config.CustomCommand{Name: "Fail", Command: `sh -c "exit 3"`}
+
+	msg := executeCustomCommand("it", cmd, []core.Task{{UUID: "a"}})()
+	if status, ok := msg.(StatusMsg); !ok || status.Message != "Comando 'Fail' non riuscito (codice di uscita 3)" {
+		t.Errorf("Expected the failure status in Italian, got %+v", msg)
+	}
+}
+
+func TestCustomCommandReplacesBuiltinKey(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+	model := createTestModel(&core.MockTaskService{
+		DuplicateFunc: func(uuid string) (string, error) {
+			t.Error("Expected the custom command instead of the built-in duplicate")
+			return "", nil
+		},
+	})
+	model.config.TUI.CustomCommands = map[string]config.CustomCommand{
+		"c": {Name: "Git Clone", Command: `sh -c "echo $WUI_TASK_UUID > ` + out + `"`},
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	if cmd == nil {
+		t.Fatal("Expected the custom command to run")
+	}
+	if status, ok := cmd().(StatusMsg); !ok || status.IsError {
+		t.Fatalf("Expected the custom command to succeed, got %+v", status)
+	}
+	if data, err := os.ReadFile(out); err != nil || strings.TrimSpace(string(data)) != "test-uuid-1" {
+		t.Errorf("Expected the custom command to run on the task, got %q (%v)", data, err)
+	}
+}
+
+func TestCustomCommandShortcutWarnings(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TUI.CustomCommands = map[string]config.CustomCommand{
+		"c": {Name: "Git Clone", Command: "true"},
+		"q": {Name: "Query", Command: "true"},
+	}
+	model := NewModel(&core.MockTaskService{}, cfg)
+
+	warnings := strings.Join(model.shortcutWarnings, "\n")
+	if !strings.Contains(warnings, "Custom command 'Git Clone' (key: c) replaces the internal shortcut for 'duplicate task'") {
+		t.Errorf("Expected a warning for the replaced shortcut, got %q", warnings)
+	}
+	if !strings.Contains(warnings, "Custom command 'Query' (key: q) never runs: the key quits wui") {
+		t.Errorf("Expected a warning for the quit key, got %q", warnings)
+	}
+}
@@ -0,0 +1,51 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// activateDatePicker opens the calendar to choose the due date of the given tasks.
+// The calendar starts on the due date of the first task, or today when it has none.
+func (m *Model) activateDatePicker(tasks []core.Task) {
+	initial := core.Now()
+	if due := tasks[0].Due; due != nil {
+		initial = due.Local()
+	}
+
+	m.calendar = components.NewCalendar(initial)
+	m.calendarActive = true
+	m.calendarFieldType = "due"
+	m.datePickerTasks = tasks
+	m.state = StateDatePicker
+}
+
+// deactivateDatePicker closes the due date picker
+func (m *Model) deactivateDatePicker() {
+	m.deactivateCalendar()
+	m.datePickerTasks = nil
+	m.state = StateNormal
+}
+
+// handleDatePickerKeys handles input while the due date picker is shown
+func (m Model) handleDatePickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "enter":
+		due := m.calendar.GetSelectedDate().Format("2006-01-02")
+		tasks := m.datePickerTasks
+		m.deactivateDatePicker()
+		m.taskList.ClearSelection()
+		return m, modifyTasksCmd(m.service, tasks, "due:"+due)
+
+	case "esc":
+		m.deactivateDatePicker()
+		return m, nil
+
+	default:
+		m.calendar, cmd = m.calendar.Update(msg)
+		return m, cmd
+	}
+}
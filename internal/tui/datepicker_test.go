@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestDatePickerAppliesToSelection(t *testing.T) {
+	modified := map[string]string{}
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, modifications string) error {
+			modified[uuid] = modifications
+			return nil
+		},
+	}
+	model := createTestModel(service)
+	due := time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local)
+	model.tasks[0].Due = &due
+	model.taskList.SetTasks(model.tasks)
+
+	// Select two tasks
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+
+	model = pressKey(t, model, "D")
+	if model.state != StateDatePicker || !model.calendarActive {
+		t.Fatalf("Expected the date picker to be open, got state %v", model.state)
+	}
+	if got := model.calendar.GetSelectedDate().Format("2006-01-02"); got != "2026-03-10" {
+		t.Errorf("Expected the calendar to start on the current due date, got %s", got)
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model = updated.(Model)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.state != StateNormal || model.calendarActive {
+		t.Errorf("Expected the date picker to close, got state %v", model.state)
+	}
+	if cmd == nil {
+		t.Fatal("Expected modify command")
+	}
+	cmd()
+
+	if len(modified) != 2 {
+		t.Fatalf("Expected 2 tasks modified, got %d", len(modified))
+	}
+	for uuid, modification := range modified {
+		if modification != "due:2026-03-11" {
+			t.Errorf("Expected %s to get due:2026-03-11, got %q", uuid, modification)
+		}
+	}
+}
+
+func TestDatePickerEscCancels(t *testing.T) {
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, modifications string) error {
+			t.Errorf("Expected no modification, got %q on %s", modifications, uuid)
+			return nil
+		},
+	}
+	model := createTestModel(service)
+
+	model = pressKey(t, model, "D")
+	if got, today := model.calendar.GetSelectedDate().Format("2006-01-02"), core.Now().Format("2006-01-02"); got != today {
+		t.Errorf("Expected the calendar to start today for a task without due date, got %s", got)
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if model.state != StateNormal || model.calendarActive || model.datePickerTasks != nil {
+		t.Errorf("Expected the date picker to close, got state %v", model.state)
+	}
+	if cmd != nil {
+		t.Error("Expected no command on esc")
+	}
+}
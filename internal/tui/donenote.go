@@ -0,0 +1,63 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// Values of tui.annotate_on_done
+const (
+	annotateOnDoneOff    = "off"    // Mark tasks done right away (default)
+	annotateOnDonePrompt = "prompt" // Ask for a completion note first
+)
+
+// completeTasks marks the tasks done, first asking for a completion note
+// when tui.annotate_on_done is "prompt"
+func (m Model) completeTasks(tasks []core.Task) (tea.Model, tea.Cmd) {
+	if m.config.TUI.AnnotateOnDone == annotateOnDonePrompt {
+		m.doneNoteTasks = tasks
+		m.state = StateAnnotateInput
+		m.annotateInput.SetValue("")
+		m.updateComponentSizes()
+		return m, m.annotateInput.Focus()
+	}
+
+	m.taskList.ClearSelection()
+	return m, markTasksDoneCmd(m.service, tasks, m.autoAnnotations())
+}
+
+// finishDoneNote marks the tasks waiting for a completion note done, annotating
+// them with the note first. An empty note just marks them done.
+func (m Model) finishDoneNote(note string) (tea.Model, tea.Cmd) {
+	tasks := m.doneNoteTasks
+	m.doneNoteTasks = nil
+	m.taskList.ClearSelection()
+
+	if isEmptyInput(note) {
+		return m, markTasksDoneCmd(m.service, tasks, m.autoAnnotations())
+	}
+	return m, annotateAndDoneCmd(m.service, tasks, note, m.autoAnnotations())
+}
+
+// annotateAndDoneCmd creates a command that annotates each task with the note and
+// then marks it done. A task whose annotation fails is not marked done.
+func annotateAndDoneCmd(service core.TaskService, tasks []core.Task, note string, annotations map[string]string) tea.Cmd {
+	return func() tea.Msg {
+		var firstErr error
+		for _, task := range tasks {
+			err := service.Annotate(task.UUID, note)
+			if err == nil {
+				err = service.Done(task.UUID)
+			}
+			if err == nil {
+				err = autoAnnotate(service, task, "done", annotations)
+			}
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		return TaskModifiedMsg{
+			Err: firstErr,
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// doneNoteService returns a mock that records annotate and done calls in order
+func doneNoteService(calls *[]string) *core.MockTaskService {
+	return &core.MockTaskService{
+		AnnotateFunc: func(uuid, text string) error {
+			*calls = append(*calls, "annotate "+uuid+" "+text)
+			return nil
+		},
+		DoneFunc: func(uuid string) error {
+			*calls = append(*calls, "done "+uuid)
+			return nil
+		},
+	}
+}
+
+// createDoneNoteModel returns a test model that asks for a completion note
+func createDoneNoteModel(service core.TaskService) Model {
+	model := createTestModel(service)
+	model.config.TUI.AnnotateOnDone = annotateOnDonePrompt
+	return model
+}
+
+func TestDoneNoteAnnotatesThenCompletes(t *testing.T) {
+	var calls []string
+	model := createDoneNoteModel(doneNoteService(&calls))
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	model = updated.(Model)
+	if model.state != StateAnnotateInput || model.doneNoteTasks == nil {
+		t.Fatalf("Expected the completion note input, got state %v", model.state)
+	}
+	if cmd == nil || len(calls) != 0 {
+		t.Errorf("Expected only the input to open, got calls %v", calls)
+	}
+
+	model.annotateInput.SetValue("Shipped in v2")
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.state != StateNormal || model.doneNoteTasks != nil {
+		t.Errorf("Expected the flow to end, got state %v", model.state)
+	}
+	msg := runCmd(t, cmd)
+	if modified, ok := msg.(TaskModifiedMsg); !ok || modified.Err != nil {
+		t.Errorf("Expected a successful modification, got %+v", msg)
+	}
+	expected := []string{"annotate test-uuid-1 Shipped in v2", "done test-uuid-1"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
+
+func TestDoneNoteEmptyJustCompletes(t *testing.T) {
+	var calls []string
+	model := createDoneNoteModel(doneNoteService(&calls))
+	model.config.TUI.EmptyInputAction = map[string]string{emptyInputAnnotate: emptyInputStay}
+
+	model = pressKey(t, model, "d")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.state != StateNormal {
+		t.Errorf("Expected an empty note to close the input, got state %v", model.state)
+	}
+	runCmd(t, cmd)
+	if expected := []string{"done test-uuid-1"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
+
+func TestDoneNoteEscCancelsCompletion(t *testing.T) {
+	var calls []string
+	model := createDoneNoteModel(doneNoteService(&calls))
+
+	model = pressKey(t, model, "d")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if cmd != nil || len(calls) != 0 {
+		t.Errorf("Expected nothing to run on esc, got calls %v", calls)
+	}
+	if model.doneNoteTasks != nil || model.statusMessage != "Task completion cancelled" {
+		t.Errorf("Expected the completion to be cancelled, got status %q", model.statusMessage)
+	}
+
+	// A plain annotation afterwards does not complete the task
+	model = pressKey(t, model, "a")
+	model.annotateInput.SetValue("Just a note")
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runCmd(t, cmd)
+	if expected := []string{"annotate test-uuid-1 Just a note"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
+
+func TestDoneWithoutNotePrompt(t *testing.T) {
+	var calls []string
+	model := createTestModel(doneNoteService(&calls))
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if state := updated.(Model).state; state != StateNormal {
+		t.Errorf("Expected done to run right away, got state %v", state)
+	}
+	runCmd(t, cmd)
+	if expected := []string{"done test-uuid-1"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
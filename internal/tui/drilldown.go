@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// projectFilterToken returns the filter matching the tasks in the project of task,
+// or the tasks without a project when it has none. Names with spaces are quoted
+// so that they stay one filter argument.
+func projectFilterToken(task core.Task) string {
+	if strings.ContainsAny(task.Project, " \t") {
+		return `project:"` + task.Project + `"`
+	}
+	return "project:" + task.Project
+}
+
+// tagFilterToken returns the filter matching the tasks with the first tag of task,
+// or the tasks without tags when it has none
+func tagFilterToken(task core.Task) string {
+	if len(task.Tags) == 0 {
+		return "tags.none:"
+	}
+	return "+" + task.Tags[0]
+}
+
+// filterBySelected narrows the configured filter of the current tab with the
+// token built from the task under the cursor, to list its sibling tasks
+func (m Model) filterBySelected(token func(core.Task) string) (tea.Model, tea.Cmd) {
+	task := m.taskList.SelectedTask()
+	if task == nil {
+		m.statusMessage = m.text(msgNoTaskSelected)
+		return m, nil
+	}
+
+	filter := token(*task)
+	if m.currentSection != nil && m.currentSection.Filter != "" {
+		filter = "( " + m.currentSection.Filter + " ) " + filter
+	}
+	m.activeFilter = filter
+	m.isLoading = true
+	m.filter.AddToHistory(filter)
+	m.rememberTabFilter(filter)
+
+	isSearchTab := m.currentSection != nil && m.currentSection.Name == "Search"
+	return m, loadTasksCmd(m.service, filter, isSearchTab, m.searchAnnotations())
+}
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestProjectFilterToken(t *testing.T) {
+	tests := []struct {
+		project  string
+		expected string
+	}{
+		{"home", "project:home"},
+		{"work.reports", "project:work.reports"},
+		{"", "project:"},
+		{"Home office", `project:"Home office"`},
+	}
+
+	for _, tt := range tests {
+		if got := projectFilterToken(core.Task{Project: tt.project}); got != tt.expected {
+			t.Errorf("projectFilterToken(%q) = %q, expected %q", tt.project, got, tt.expected)
+		}
+	}
+}
+
+func TestTagFilterToken(t *testing.T) {
+	tests := []struct {
+		tags     []string
+		expected string
+	}{
+		{[]string{"urgent"}, "+urgent"},
+		{[]string{"work", "docs"}, "+work"},
+		{nil, "tags.none:"},
+	}
+
+	for _, tt := range tests {
+		if got := tagFilterToken(core.Task{Tags: tt.tags}); got != tt.expected {
+			t.Errorf("tagFilterToken(%v) = %q, expected %q", tt.tags, got, tt.expected)
+		}
+	}
+}
+
+func TestFilterBySelectedTask(t *testing.T) {
+	var exported []string
+	service := &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			exported = append(exported, filter)
+			return nil, nil
+		},
+	}
+	model := createTestModel(service)
+	model, _ = loadTasks(model, []core.Task{
+		{UUID: "uuid-1", ID: 1, Description: "Write report", Project: "work", Tags: []string{"docs"}, Status: "pending"},
+	})
+
+	model.currentSection.Filter = "status:pending or status:waiting"
+
+	// The token narrows the tab's configured filter instead of replacing it
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("=")})
+	model = updated.(Model)
+	expected := "( status:pending or status:waiting ) project:work"
+	if model.activeFilter != expected {
+		t.Errorf("Expected filter %q, got %q", expected, model.activeFilter)
+	}
+	if got := model.tabFilters[model.currentSection.Name]; got != expected {
+		t.Errorf("Expected the tab to remember %q, got %q", expected, got)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a command reloading the tasks")
+	}
+	cmd()
+	if len(exported) != 1 || exported[0] != expected {
+		t.Errorf("Expected the tasks to be loaded with %q, got %v", expected, exported)
+	}
+
+	// Pressing another drill-down key starts again from the configured filter
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")})
+	model = updated.(Model)
+	if expected := "( status:pending or status:waiting ) +docs"; model.activeFilter != expected {
+		t.Errorf("Expected filter %q, got %q", expected, model.activeFilter)
+	}
+}
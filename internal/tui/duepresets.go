@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// duePreset is a named shortcut for a common due date
+type duePreset struct {
+	Label string                        // Text shown in the preset menu
+	Due   string                        // Taskwarrior date expression (empty clears the due date)
+	Date  func(now time.Time) time.Time // Date computed by wui instead of Due, when set
+}
+
+// duePresets lists the presets in menu order
+var duePresets = []duePreset{
+	{Label: "Today", Due: "today"},
+	{Label: "Tomorrow", Due: "tomorrow"},
+	{Label: "This Weekend", Date: thisWeekend},
+	{Label: "Next Week", Due: "monday"},
+	{Label: "Someday (remove due date)", Due: ""},
+}
+
+// Modification returns the modify arguments that apply the preset.
+// An empty date expression clears the due date.
+func (p duePreset) Modification() string {
+	if p.Date != nil {
+		return "due:" + p.Date(core.Now()).Format("2006-01-02")
+	}
+	if p.Due == "" {
+		return clearAttribute("due")
+	}
+	return "due:" + p.Due
+}
+
+// thisWeekend returns the day of the current weekend: today on a Saturday or Sunday,
+// otherwise the coming Saturday. Taskwarrior's "saturday" would skip to the next
+// week when today is Saturday.
+func thisWeekend(now time.Time) time.Time {
+	days := 0
+	if now.Weekday() != time.Sunday {
+		days = int(time.Saturday - now.Weekday())
+	}
+	return now.AddDate(0, 0, days)
+}
+
+// clearAttribute returns the modify arguments that remove an attribute from a task.
+// Taskwarrior clears an attribute when it is assigned an empty value (e.g. "due:").
+func clearAttribute(name string) string {
+	return name + ":"
+}
+
+// activateDuePresetPicker opens the due presets menu for the given tasks
+func (m *Model) activateDuePresetPicker(tasks []core.Task) {
+	items := make([]string, len(duePresets))
+	for i, preset := range duePresets {
+		items[i] = preset.Label
+	}
+
+	m.duePresetPicker = components.NewListPicker("Set due date", items, "")
+	m.duePresetPickerActive = true
+	m.duePresetTasks = tasks
+	m.state = StateDuePresetPicker
+}
+
+// deactivateDuePresetPicker closes the due presets menu
+func (m *Model) deactivateDuePresetPicker() {
+	m.duePresetPickerActive = false
+	m.duePresetTasks = nil
+	m.state = StateNormal
+}
+
+// handleDuePresetPickerKeys handles input while the due presets menu is shown
+func (m Model) handleDuePresetPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "enter":
+		selected := m.duePresetPicker.SelectedItem()
+		tasks := m.duePresetTasks
+		m.deactivateDuePresetPicker()
+		for _, preset := range duePresets {
+			if preset.Label == selected {
+				m.taskList.ClearSelection()
+				return m, modifyTasksCmd(m.service, tasks, preset.Modification())
+			}
+		}
+		return m, nil
+
+	case "esc":
+		m.deactivateDuePresetPicker()
+		return m, nil
+
+	default:
+		m.duePresetPicker, cmd = m.duePresetPicker.Update(msg)
+		return m, cmd
+	}
+}
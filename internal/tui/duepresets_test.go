@@ -0,0 +1,184 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestDuePresetModification(t *testing.T) {
+	core.SetNowFunc(func() time.Time { return time.Date(2026, 10, 14, 9, 0, 0, 0, time.Local) }) // Wednesday
+	defer core.SetNowFunc(nil)
+
+	tests := []struct {
+		label    string
+		expected string
+	}{
+		{"Today", "due:today"},
+		{"Tomorrow", "due:tomorrow"},
+		{"This Weekend", "due:2026-10-17"},
+		{"Next Week", "due:monday"},
+		{"Someday (remove due date)", "due:"},
+	}
+
+	if len(duePresets) != len(tests) {
+		t.Fatalf("Expected %d presets, got %d", len(tests), len(duePresets))
+	}
+	for i, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			preset := duePresets[i]
+			if preset.Label != tt.label {
+				t.Errorf("Expected preset %d to be %q, got %q", i, tt.label, preset.Label)
+			}
+			if got := preset.Modification(); got != tt.expected {
+				t.Errorf("Expected modification %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestThisWeekend(t *testing.T) {
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected string
+	}{
+		{"monday", time.Date(2026, 10, 12, 9, 0, 0, 0, time.Local), "2026-10-17"},
+		{"friday evening", time.Date(2026, 10, 16, 23, 0, 0, 0, time.Local), "2026-10-17"},
+		{"saturday counts as this weekend", time.Date(2026, 10, 17, 9, 0, 0, 0, time.Local), "2026-10-17"},
+		{"sunday counts as this weekend", time.Date(2026, 10, 18, 9, 0, 0, 0, time.Local), "2026-10-18"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := thisWeekend(tt.now).Format("2006-01-02"); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDuePresetPickerAppliesToSelection(t *testing.T) {
+	for i, preset := range duePresets {
+		t.Run(preset.Label, func(t *testing.T) {
+			modified := map[string]string{}
+			service := &core.MockTaskService{
+				ModifyFunc: func(uuid, modifications string) error {
+					modified[uuid] = modifications
+					return nil
+				},
+			}
+			model := createTestModel(service)
+
+			// Select two tasks
+			model.taskList.ToggleSelection()
+			model.taskList.MoveCursorDown()
+			model.taskList.ToggleSelection()
+
+			updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+			model = updated.(Model)
+			if model.state != StateDuePresetPicker || !model.duePresetPickerActive {
+				t.Fatalf("Expected due preset picker to be open, got state %v", model.state)
+			}
+
+			for j := 0; j < i; j++ {
+				updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+				model = updated.(Model)
+			}
+
+			updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			model = updated.(Model)
+			if model.state != StateNormal || model.duePresetPickerActive {
+				t.Errorf("Expected picker to close, got state %v", model.state)
+			}
+			if cmd == nil {
+				t.Fatal("Expected modify command")
+			}
+			msg := cmd()
+			if modifiedMsg, ok := msg.(TaskModifiedMsg); !ok || modifiedMsg.Err != nil {
+				t.Fatalf("Expected successful TaskModifiedMsg, got %#v", msg)
+			}
+
+			if len(modified) != 2 {
+				t.Fatalf("Expected 2 tasks modified, got %d", len(modified))
+			}
+			for _, uuid := range []string{"test-uuid-1", "test-uuid-2"} {
+				if modified[uuid] != preset.Modification() {
+					t.Errorf("Expected %s modified with %q, got %q", uuid, preset.Modification(), modified[uuid])
+				}
+			}
+		})
+	}
+}
+
+func TestDuePresetPickerCancel(t *testing.T) {
+	called := false
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, modifications string) error {
+			called = true
+			return nil
+		},
+	}
+	model := createTestModel(service)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	model = updated.(Model)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+
+	if model.state != StateNormal || model.duePresetPickerActive {
+		t.Errorf("Expected picker to close on esc, got state %v", model.state)
+	}
+	if cmd != nil {
+		cmd()
+	}
+	if called {
+		t.Error("Expected no modification on cancel")
+	}
+}
+
+func TestClearAttribute(t *testing.T) {
+	if got := clearAttribute("due"); got != "due:" {
+		t.Errorf("Expected %q, got %q", "due:", got)
+	}
+}
+
+func TestClearDueAppliesToSelection(t *testing.T) {
+	modified := map[string]string{}
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, modifications string) error {
+			modified[uuid] = modifications
+			return nil
+		},
+	}
+	model := createTestModel(service)
+
+	// Select the first and third task
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'W'}})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected modify command")
+	}
+	if len(model.taskList.GetSelectedTasks()) > 1 {
+		t.Error("Expected selection to be cleared")
+	}
+
+	if _, ok := cmd().(TaskModifiedMsg); !ok {
+		t.Fatal("Expected TaskModifiedMsg")
+	}
+	if len(modified) != 2 {
+		t.Fatalf("Expected 2 tasks modified, got %d", len(modified))
+	}
+	for _, uuid := range []string{"test-uuid-1", "test-uuid-3"} {
+		if modified[uuid] != "due:" {
+			t.Errorf("Expected %s modified with %q, got %q", uuid, "due:", modified[uuid])
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// duplicateSelected copies the selected task(s)
+func (m Model) duplicateSelected() (tea.Model, tea.Cmd) {
+	selectedTasks := m.taskList.GetSelectedTasks()
+	if len(selectedTasks) == 0 {
+		return m, nil
+	}
+	m.taskList.ClearSelection()
+	return m, duplicateTasksCmd(m.service, selectedTasks)
+}
+
+// duplicateTasksCmd creates a command to copy tasks. Every task is copied even when
+// an earlier copy fails; the first error is reported.
+func duplicateTasksCmd(service core.TaskService, tasks []core.Task) tea.Cmd {
+	return func() tea.Msg {
+		var firstErr error
+		for _, task := range tasks {
+			if _, err := service.Duplicate(task.UUID); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		return TaskModifiedMsg{
+			Err: firstErr,
+		}
+	}
+}
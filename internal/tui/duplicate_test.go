@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// createDuplicateModel returns a model whose service records the duplicated task
+// UUIDs and fails for the ones in failing
+func createDuplicateModel(failing ...string) (Model, *[]string) {
+	var duplicated []string
+	service := &core.MockTaskService{
+		DuplicateFunc: func(uuid string) (string, error) {
+			duplicated = append(duplicated, uuid)
+			if slices.Contains(failing, uuid) {
+				return "", errors.New("task duplicate failed")
+			}
+			return "copy-of-" + uuid, nil
+		},
+	}
+	return createTestModel(service), &duplicated
+}
+
+// runDuplicate presses the duplicate key and applies the resulting message
+func runDuplicate(t *testing.T, model Model) Model {
+	t.Helper()
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a command duplicating the tasks")
+	}
+	updated, _ = model.Update(cmd())
+	return updated.(Model)
+}
+
+func TestDuplicateTask(t *testing.T) {
+	model, duplicated := createDuplicateModel()
+
+	model = runDuplicate(t, model)
+	if !slices.Equal(*duplicated, []string{"test-uuid-1"}) {
+		t.Errorf("Expected the task under the cursor to be duplicated, got %v", *duplicated)
+	}
+	if model.errorMessage != "" || !model.isLoading {
+		t.Errorf("Expected the list to refresh without error, got %q", model.errorMessage)
+	}
+}
+
+func TestDuplicateSelectedTasks(t *testing.T) {
+	model, duplicated := createDuplicateModel()
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+
+	model = runDuplicate(t, model)
+	if !slices.Equal(*duplicated, []string{"test-uuid-1", "test-uuid-3"}) {
+		t.Errorf("Expected the selected tasks to be duplicated, got %v", *duplicated)
+	}
+	if len(model.taskList.GetSelectedTasks()) != 1 {
+		t.Error("Expected the selection to be cleared")
+	}
+}
+
+func TestDuplicateTasksError(t *testing.T) {
+	model, duplicated := createDuplicateModel("test-uuid-1")
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+
+	model = runDuplicate(t, model)
+	if !slices.Equal(*duplicated, []string{"test-uuid-1", "test-uuid-2"}) {
+		t.Errorf("Expected every task to be tried after a failure, got %v", *duplicated)
+	}
+	if !strings.Contains(model.errorMessage, "task duplicate failed") {
+		t.Errorf("Expected the error to be shown, got %q", model.errorMessage)
+	}
+}
@@ -0,0 +1,31 @@
+package tui
+
+import "strings"
+
+// Values of tui.empty_input_action
+const (
+	emptyInputCancel = "cancel" // Close the input and report that nothing was applied (default)
+	emptyInputStay   = "stay"   // Keep the input open
+)
+
+// Inputs configured by tui.empty_input_action
+const (
+	emptyInputModify   = "modify"
+	emptyInputAnnotate = "annotate"
+)
+
+// isEmptyInput returns true if an input value has nothing to apply
+func isEmptyInput(value string) bool {
+	return strings.TrimSpace(value) == ""
+}
+
+// handleEmptyInput runs the configured empty_input_action after enter is pressed
+// on an empty input, and returns true if the input stays open
+func (m *Model) handleEmptyInput(input string) bool {
+	if m.config.TUI.EmptyInputAction[input] == emptyInputStay {
+		m.statusMessage = m.text(msgEmptyInputKept)
+		return true
+	}
+	m.statusMessage = m.text(msgEmptyInputCancelled)
+	return false
+}
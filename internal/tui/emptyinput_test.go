@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestEmptyInputAction(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		input      string
+		action     string
+		inputState AppState
+		wantState  AppState
+		wantStatus string
+	}{
+		{"modify default", "m", emptyInputModify, "", StateModifyInput, StateNormal, "Input was empty: nothing was applied"},
+		{"modify cancel", "m", emptyInputModify, emptyInputCancel, StateModifyInput, StateNormal, "Input was empty: nothing was applied"},
+		{"modify stay", "m", emptyInputModify, emptyInputStay, StateModifyInput, StateModifyInput, "Input is empty: type a value or press esc to cancel"},
+		{"annotate default", "a", emptyInputAnnotate, "", StateAnnotateInput, StateNormal, "Input was empty: nothing was applied"},
+		{"annotate stay", "a", emptyInputAnnotate, emptyInputStay, StateAnnotateInput, StateAnnotateInput, "Input is empty: type a value or press esc to cancel"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied := false
+			service := &core.MockTaskService{
+				ModifyFunc: func(uuid, modifications string) error {
+					applied = true
+					return nil
+				},
+				AnnotateFunc: func(uuid, text string) error {
+					applied = true
+					return nil
+				},
+			}
+			model := createTestModel(service)
+			model.config.TUI.EmptyInputAction = map[string]string{tt.input: tt.action}
+
+			model = pressKey(t, model, tt.key)
+			if model.state != tt.inputState {
+				t.Fatalf("Expected state %v, got %v", tt.inputState, model.state)
+			}
+			// Whitespace counts as empty
+			model = pressKey(t, model, "  ")
+			updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			model = updated.(Model)
+
+			if model.state != tt.wantState {
+				t.Errorf("Expected state %v, got %v", tt.wantState, model.state)
+			}
+			if model.statusMessage != tt.wantStatus {
+				t.Errorf("Expected status %q, got %q", tt.wantStatus, model.statusMessage)
+			}
+			if cmd != nil {
+				cmd()
+			}
+			if applied {
+				t.Error("Expected nothing to be applied")
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// Actions accepted in tui.esc_behavior
+const (
+	escClearSelection = "clear_selection" // Clear the multi-selection
+	escCloseSidebar   = "close_sidebar"   // Close the sidebar of the split view
+	escGroupBack      = "group_back"      // Return from a drilled-into group to the Projects/Tags tab
+	escQuit           = "quit"            // Quit wui
+	escNone           = "none"            // Stop: do nothing
+)
+
+// resolveEscBehavior returns the esc actions named in the configuration, in order.
+// Unknown names are skipped; the default actions are used when none is left.
+func resolveEscBehavior(names []string) []string {
+	var actions []string
+	for _, name := range names {
+		action := strings.ToLower(strings.TrimSpace(name))
+		switch action {
+		case escClearSelection, escCloseSidebar, escGroupBack, escQuit, escNone:
+			actions = append(actions, action)
+		default:
+			slog.Warn("Ignoring unknown esc_behavior entry", "name", name)
+		}
+	}
+	if len(actions) == 0 {
+		return config.DefaultTUIConfig().EscBehavior
+	}
+	return actions
+}
+
+// handleEsc runs the first action in tui.esc_behavior that applies to the current view
+func (m Model) handleEsc() (tea.Model, tea.Cmd) {
+	for _, action := range m.escBehavior {
+		switch action {
+		case escClearSelection:
+			if m.taskList.HasSelections() {
+				m.taskList.ClearSelection()
+				return m, nil
+			}
+		case escCloseSidebar:
+			if m.viewMode == ViewModeListWithSidebar {
+				m.dismissSidebar()
+				m.viewMode = ViewModeList
+				m.updateComponentSizes()
+				return m, nil
+			}
+		case escGroupBack:
+			if m.groupOrigin > 0 && m.groupOrigin < len(m.sections.Items) && m.sections.ActiveIndex == 0 {
+				m.sections.ActiveIndex = m.groupOrigin
+				section := m.sections.Items[m.groupOrigin]
+				return m, func() tea.Msg { return components.SectionChangedMsg{Section: section} }
+			}
+		case escQuit:
+			return m.quit()
+		case escNone:
+			return m, nil
+		}
+	}
+	return m, nil
+}
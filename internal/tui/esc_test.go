@@ -0,0 +1,152 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// pressEsc sends the esc key to the model
+func pressEsc(model Model) (Model, tea.Cmd) {
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	return updated.(Model), cmd
+}
+
+// drillIntoGroup opens the first project group of the Projects tab in the Search tab
+func drillIntoGroup(t *testing.T, escBehavior []string) (Model, int) {
+	t.Helper()
+	model := createProjectsTabModel(&core.MockTaskService{})
+	model.escBehavior = resolveEscBehavior(escBehavior)
+	origin := model.sections.ActiveIndex
+	model.groups = []core.TaskGroup{{Name: "Home", Count: 1}}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.sections.ActiveIndex != 0 || model.activeFilter != "project:Home" {
+		t.Fatalf("Expected the group to open in the Search tab, got tab %d filter %q", model.sections.ActiveIndex, model.activeFilter)
+	}
+	model.tasks = []core.Task{{UUID: "home-1", Description: "Fix the sink", Project: "Home", Status: "pending"}}
+	model.taskList.SetTasks(model.tasks)
+	return model, origin
+}
+
+func TestResolveEscBehavior(t *testing.T) {
+	defaultEscBehavior := []string{escClearSelection, escCloseSidebar, escGroupBack}
+	tests := []struct {
+		name     string
+		names    []string
+		expected []string
+	}{
+		{"empty uses default", nil, defaultEscBehavior},
+		{"custom order", []string{"close_sidebar", "Quit"}, []string{escCloseSidebar, escQuit}},
+		{"unknown entries skipped", []string{"bogus", "none"}, []string{escNone}},
+		{"only unknown entries uses default", []string{"bogus"}, defaultEscBehavior},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveEscBehavior(tt.names); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEscDefaultOrder(t *testing.T) {
+	model, origin := drillIntoGroup(t, nil)
+	model.viewMode = ViewModeListWithSidebar
+	model.taskList.ToggleSelection()
+
+	// Selections are cleared first
+	model, _ = pressEsc(model)
+	if model.taskList.HasSelections() || model.viewMode != ViewModeListWithSidebar {
+		t.Fatalf("Expected only the selection to be cleared, got view %v", model.viewMode)
+	}
+
+	// Then the sidebar is closed
+	model, _ = pressEsc(model)
+	if model.viewMode != ViewModeList || model.sections.ActiveIndex != 0 {
+		t.Fatalf("Expected only the sidebar to close, got view %v tab %d", model.viewMode, model.sections.ActiveIndex)
+	}
+	if model.sidebarDismissedUUID != "home-1" {
+		t.Errorf("Expected the sidebar to stay dismissed for the task, got %q", model.sidebarDismissedUUID)
+	}
+
+	// Then the group list is shown again
+	model, cmd := pressEsc(model)
+	if model.sections.ActiveIndex != origin {
+		t.Fatalf("Expected to return to tab %d, got %d", origin, model.sections.ActiveIndex)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a section change command")
+	}
+	if msg, ok := cmd().(components.SectionChangedMsg); !ok || msg.Section.Name != "Projects" {
+		t.Errorf("Expected a change to the Projects tab, got %+v", msg)
+	}
+
+	// Nothing is left to do
+	model, cmd = pressEsc(model)
+	if cmd != nil {
+		t.Error("Expected esc to do nothing")
+	}
+}
+
+func TestEscGroupBackResetOnTabChange(t *testing.T) {
+	model, _ := drillIntoGroup(t, nil)
+
+	updated, _ := model.Update(components.SectionChangedMsg{Section: model.sections.Items[0]})
+	model = updated.(Model)
+	model, cmd := pressEsc(model)
+	if cmd != nil || model.sections.ActiveIndex != 0 {
+		t.Errorf("Expected esc not to return to the group list after a tab change, got tab %d", model.sections.ActiveIndex)
+	}
+}
+
+func TestEscCustomOrder(t *testing.T) {
+	model, origin := drillIntoGroup(t, []string{"group_back", "close_sidebar"})
+	model.viewMode = ViewModeListWithSidebar
+	model.taskList.ToggleSelection()
+
+	// The group list comes before the sidebar and the selection is kept
+	model, _ = pressEsc(model)
+	if model.sections.ActiveIndex != origin || model.viewMode != ViewModeListWithSidebar {
+		t.Errorf("Expected to return to the group list first, got tab %d view %v", model.sections.ActiveIndex, model.viewMode)
+	}
+	if !model.taskList.HasSelections() {
+		t.Error("Expected the selection to be kept")
+	}
+}
+
+func TestEscQuit(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.escBehavior = resolveEscBehavior([]string{"clear_selection", "quit"})
+
+	model.taskList.ToggleSelection()
+	model, cmd := pressEsc(model)
+	if cmd != nil || model.taskList.HasSelections() {
+		t.Fatal("Expected esc to clear the selection before quitting")
+	}
+
+	_, cmd = pressEsc(model)
+	if cmd == nil {
+		t.Fatal("Expected a quit command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("Expected esc to quit")
+	}
+}
+
+func TestEscNone(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.escBehavior = resolveEscBehavior([]string{"none", "quit"})
+	model.viewMode = ViewModeListWithSidebar
+	model.taskList.ToggleSelection()
+
+	model, cmd := pressEsc(model)
+	if cmd != nil || model.viewMode != ViewModeListWithSidebar || !model.taskList.HasSelections() {
+		t.Errorf("Expected esc to do nothing, got view %v", model.viewMode)
+	}
+}
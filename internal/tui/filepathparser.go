@@ -64,12 +64,28 @@ func extractFilePathsFromText(text string) []string {
 		}
 	}
 
+	// Byte ranges of the text already claimed by a previous pattern, so that
+	// the bare-path patterns don't match fragments of quoted or escaped paths
+	var consumed [][2]int
+	isConsumed := func(start, end int) bool {
+		for _, r := range consumed {
+			if start < r[1] && end > r[0] {
+				return true
+			}
+		}
+		return false
+	}
+	consume := func(start, end int) {
+		consumed = append(consumed, [2]int{start, end})
+	}
+
 	// Pattern 1: file:// URLs (may contain %20 for spaces)
 	fileURLRegex := regexp.MustCompile(`file://(/[^\s<>\[\]]+)`)
-	fileURLMatches := fileURLRegex.FindAllStringSubmatch(text, -1)
+	fileURLMatches := fileURLRegex.FindAllStringSubmatchIndex(text, -1)
 	for _, match := range fileURLMatches {
-		if len(match) >= 2 {
-			path := match[1]
+		if len(match) >= 4 {
+			consume(match[0], match[1])
+			path := text[match[2]:match[3]]
 			// Decode URL-encoded characters (like %20 for spaces)
 			if decoded, err := url.PathUnescape(path); err == nil {
 				path = decoded
@@ -81,33 +97,36 @@ func extractFilePathsFromText(text string) []string {
 
 	// Pattern 2: Double-quoted paths (supports spaces)
 	doubleQuotedRegex := regexp.MustCompile(`"((?:/|~/|\.\.?/)[^"]+)"`)
-	doubleQuotedMatches := doubleQuotedRegex.FindAllStringSubmatch(text, -1)
+	doubleQuotedMatches := doubleQuotedRegex.FindAllStringSubmatchIndex(text, -1)
 	for _, match := range doubleQuotedMatches {
-		if len(match) >= 2 {
-			path := match[1]
+		if len(match) >= 4 {
+			consume(match[0], match[1])
+			path := text[match[2]:match[3]]
 			addPath(path)
 		}
 	}
 
 	// Pattern 3: Single-quoted paths (supports spaces)
 	singleQuotedRegex := regexp.MustCompile(`'((?:/|~/|\.\.?/)[^']+)'`)
-	singleQuotedMatches := singleQuotedRegex.FindAllStringSubmatch(text, -1)
+	singleQuotedMatches := singleQuotedRegex.FindAllStringSubmatchIndex(text, -1)
 	for _, match := range singleQuotedMatches {
-		if len(match) >= 2 {
-			path := match[1]
+		if len(match) >= 4 {
+			consume(match[0], match[1])
+			path := text[match[2]:match[3]]
 			addPath(path)
 		}
 	}
 
 	// Pattern 4: Paths with escaped spaces (backslash before space)
 	// Match paths that contain \  (backslash-space) sequences
-	escapedSpaceRegex := regexp.MustCompile(`(?:^|[^a-zA-Z0-9"'])((?:/|~/|\.\.?/)(?:[^\s"'<>\[\]]|\\ )+)`)
-	escapedMatches := escapedSpaceRegex.FindAllStringSubmatch(text, -1)
+	escapedSpaceRegex := regexp.MustCompile(`(?:^|[^a-zA-Z0-9"'])((?:/|~/|\.\.?/)(?:\\ |[^\s"'<>\[\]\\])+)`)
+	escapedMatches := escapedSpaceRegex.FindAllStringSubmatchIndex(text, -1)
 	for _, match := range escapedMatches {
-		if len(match) >= 2 {
-			path := match[1]
+		if len(match) >= 4 {
+			path := text[match[2]:match[3]]
 			// Only process if it actually contains escaped spaces
-			if strings.Contains(path, `\ `) {
+			if strings.Contains(path, `\ `) && !isConsumed(match[2], match[3]) {
+				consume(match[2], match[3])
 				// Unescape the spaces
 				path = strings.ReplaceAll(path, `\ `, " ")
 				path = cleanPathTrailing(path)
@@ -118,11 +137,11 @@ func extractFilePathsFromText(text string) []string {
 
 	// Pattern 5: Absolute Unix paths without spaces (starting with /)
 	// Must start with / followed by a word character to avoid matching standalone /
-	absolutePathRegex := regexp.MustCompile(`(?:^|[^a-zA-Z0-9"'/\\])(/[a-zA-Z0-9_][^\s<>\[\]"']*[^\s<>\[\]"'.,;:!?)])`)
-	absoluteMatches := absolutePathRegex.FindAllStringSubmatch(text, -1)
+	absolutePathRegex := regexp.MustCompile(`(?:^|[^a-zA-Z0-9"'/\\~.)])(/[a-zA-Z0-9_][^\s<>\[\]"']*[^\s<>\[\]"'.,;:!?)])`)
+	absoluteMatches := absolutePathRegex.FindAllStringSubmatchIndex(text, -1)
 	for _, match := range absoluteMatches {
-		if len(match) >= 2 {
-			path := cleanPathTrailing(match[1])
+		if len(match) >= 4 && !isConsumed(match[2], match[3]) {
+			path := cleanPathTrailing(text[match[2]:match[3]])
 			// Skip if this looks like it was already captured as a quoted path
 			if !strings.Contains(path, `\ `) {
 				addPath(path)
@@ -132,20 +151,20 @@ func extractFilePathsFromText(text string) []string {
 
 	// Pattern 6: Home directory paths without spaces (starting with ~/)
 	homePathRegex := regexp.MustCompile(`(?:^|[^a-zA-Z0-9"'\\])(~/[^\s<>\[\]"']+)`)
-	homeMatches := homePathRegex.FindAllStringSubmatch(text, -1)
+	homeMatches := homePathRegex.FindAllStringSubmatchIndex(text, -1)
 	for _, match := range homeMatches {
-		if len(match) >= 2 {
-			path := cleanPathTrailing(match[1])
+		if len(match) >= 4 && !isConsumed(match[2], match[3]) {
+			path := cleanPathTrailing(text[match[2]:match[3]])
 			addPath(path)
 		}
 	}
 
 	// Pattern 7: Relative paths without spaces (starting with ./ or ../)
 	relativePathRegex := regexp.MustCompile(`(?:^|[^a-zA-Z0-9"'\\])(\.\.?/[^\s<>\[\]"']+)`)
-	relativeMatches := relativePathRegex.FindAllStringSubmatch(text, -1)
+	relativeMatches := relativePathRegex.FindAllStringSubmatchIndex(text, -1)
 	for _, match := range relativeMatches {
-		if len(match) >= 2 {
-			path := cleanPathTrailing(match[1])
+		if len(match) >= 4 && !isConsumed(match[2], match[3]) {
+			path := cleanPathTrailing(text[match[2]:match[3]])
 			addPath(path)
 		}
 	}
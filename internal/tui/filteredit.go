@@ -0,0 +1,23 @@
+package tui
+
+// Values of tui.filter_edit_mode
+const (
+	filterEditExtend  = "extend"  // Active filter followed by a space, ready to be extended (default)
+	filterEditEdit    = "edit"    // Active filter as is
+	filterEditReplace = "replace" // Empty input that replaces the active filter
+)
+
+// initialFilterValue returns the text the filter input opens with
+func initialFilterValue(mode, activeFilter string) string {
+	switch mode {
+	case filterEditReplace:
+		return ""
+	case filterEditEdit:
+		return activeFilter
+	default:
+		if activeFilter == "" {
+			return ""
+		}
+		return activeFilter + " "
+	}
+}
@@ -0,0 +1,35 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestFilterEditMode(t *testing.T) {
+	tests := []struct {
+		mode, activeFilter, expected string
+	}{
+		{"", "status:pending", "status:pending "},
+		{filterEditExtend, "status:pending", "status:pending "},
+		{filterEditExtend, "", ""},
+		{filterEditEdit, "status:pending", "status:pending"},
+		{filterEditReplace, "status:pending", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode+"/"+tt.activeFilter, func(t *testing.T) {
+			model := createTestModel(&core.MockTaskService{})
+			model.config.TUI.FilterEditMode = tt.mode
+			model.activeFilter = tt.activeFilter
+
+			model = pressKey(t, model, "/")
+			if model.state != StateFilterInput {
+				t.Fatalf("Expected the filter input, got state %v", model.state)
+			}
+			if got := model.filter.Value(); got != tt.expected {
+				t.Errorf("Expected the filter input to open with %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// toggleFocus narrows the list to the selected task and the tasks it depends on,
+// directly or through other dependencies, without running a new Taskwarrior query.
+// When the list is already focused, it shows all the loaded tasks again.
+func (m Model) toggleFocus() (tea.Model, tea.Cmd) {
+	if m.focusUUID != "" {
+		uuid := m.focusUUID
+		m.focusUUID = ""
+		m.resortTasks()
+		m.taskList.SelectTask(uuid)
+		m.updateSidebar()
+		return m, nil
+	}
+
+	// Project panes and the Home tab do not list the loaded tasks
+	if m.viewMode == ViewModeProjectPanes || m.isHomeView() {
+		return m, nil
+	}
+	task := m.taskList.SelectedTask()
+	if task == nil {
+		m.statusMessage = m.text(msgNoTaskSelected)
+		return m, nil
+	}
+	m.focusUUID = task.UUID
+	m.resortTasks()
+	m.taskList.SelectTask(task.UUID)
+	m.updateSidebar()
+	m.statusMessage = m.text(msgFocusTask, len(m.listedTasks())-1, m.actionKey("focus_task", "z"))
+	return m, nil
+}
+
+// focusedTask returns the loaded task the list is focused on, or nil when the
+// list is not focused or the task is no longer loaded
+func (m Model) focusedTask() *core.Task {
+	if m.focusUUID == "" {
+		return nil
+	}
+	for i := range m.tasks {
+		if m.tasks[i].UUID == m.focusUUID {
+			return &m.tasks[i]
+		}
+	}
+	return nil
+}
+
+// listedTasks returns the tasks shown in the list: the focused task with its
+// dependency chain, or all the loaded tasks. Dependencies outside the tab are
+// looked up in the dependency tasks loaded separately.
+func (m Model) listedTasks() []core.Task {
+	task := m.focusedTask()
+	if task == nil {
+		return m.tasks
+	}
+	return task.DependencyChain(m.checklistTasks())
+}
@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestFocusTask(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model, _ = loadTasks(model, []core.Task{
+		{UUID: "release", Description: "Ship release", Depends: []string{"tests"}},
+		{UUID: "tests", Description: "Fix tests", Depends: []string{"ci"}},
+		{UUID: "other", Description: "Unrelated"},
+	})
+	updated, _ := model.Update(DepTasksLoadedMsg{Tasks: []core.Task{{UUID: "ci", Description: "Set up CI", Status: "completed"}}})
+	model = updated.(Model)
+
+	model = pressKey(t, model, "z")
+	if got := shownUUIDs(model); !slices.Equal(got, []string{"release", "tests", "ci"}) {
+		t.Errorf("Expected the task and its dependency chain, got %v", got)
+	}
+	if !strings.Contains(model.renderHeader(), "Focus: Ship release") {
+		t.Errorf("Expected the focused task in the header, got %q", model.renderHeader())
+	}
+
+	// A refresh keeps the focus
+	model, _ = loadTasks(model, model.tasks)
+	if got := shownUUIDs(model); len(got) != 2 || got[0] != "release" {
+		t.Errorf("Expected the focus to be kept on a refresh, got %v", got)
+	}
+
+	model = pressKey(t, model, "z")
+	if model.focusUUID != "" || len(shownUUIDs(model)) != 3 {
+		t.Errorf("Expected all the loaded tasks again, got %v", shownUUIDs(model))
+	}
+}
+
+func TestFocusTaskDroppedWhenGone(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model = pressKey(t, model, "z")
+	if model.focusUUID != "test-uuid-1" {
+		t.Fatalf("Expected the list to focus on test-uuid-1, got %q", model.focusUUID)
+	}
+
+	model, _ = loadTasks(model, []core.Task{{UUID: "test-uuid-2"}, {UUID: "test-uuid-3"}})
+	if model.focusUUID != "" || len(shownUUIDs(model)) != 2 {
+		t.Errorf("Expected the focus to be dropped with its task, got %q", model.focusUUID)
+	}
+}
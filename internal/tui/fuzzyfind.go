@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// startFuzzyFind narrows the loaded tasks as the user types, without running a new
+// Taskwarrior query. Group lists have no tasks to narrow.
+func (m Model) startFuzzyFind() (tea.Model, tea.Cmd) {
+	if m.inGroupView || m.isHomeView() || len(m.tasks) == 0 {
+		return m, nil
+	}
+	m.fuzzyQuery = ""
+	m.fuzzyOrigin = ""
+	if task := m.taskList.SelectedTask(); task != nil {
+		m.fuzzyOrigin = task.UUID
+	}
+	m.state = StateFuzzyFind
+	return m, nil
+}
+
+// applyFuzzyFind shows the loaded tasks that match the query, best matches first
+func (m *Model) applyFuzzyFind() {
+	if m.fuzzyQuery == "" {
+		m.resortTasks()
+		return
+	}
+	m.taskList.SetRankedTasks(core.FuzzyFilterTasks(m.tasks, m.fuzzyQuery))
+	m.updateSidebar()
+}
+
+// closeFuzzyFind shows all the loaded tasks again with the task selected, if any
+func (m *Model) closeFuzzyFind(uuid string) {
+	m.state = StateNormal
+	m.fuzzyQuery = ""
+	m.resortTasks()
+	if uuid != "" {
+		m.taskList.SelectTask(uuid)
+	}
+	m.updateSidebar()
+}
+
+// handleFuzzyFindKeys handles input while the fuzzy finder is open: typing narrows
+// the list, enter keeps the selected task and esc goes back to where it was
+func (m Model) handleFuzzyFindKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.closeFuzzyFind(m.fuzzyOrigin)
+		return m, nil
+	case "enter":
+		uuid := m.fuzzyOrigin
+		if task := m.taskList.SelectedTask(); task != nil {
+			uuid = task.UUID
+		}
+		m.closeFuzzyFind(uuid)
+		return m, nil
+	case "up", "ctrl+p", "ctrl+k":
+		m.taskList.MoveCursorUp()
+		m.updateSidebar()
+		return m, nil
+	case "down", "ctrl+n", "ctrl+j":
+		m.taskList.MoveCursorDown()
+		m.updateSidebar()
+		return m, nil
+	case "backspace":
+		if query := []rune(m.fuzzyQuery); len(query) > 0 {
+			m.fuzzyQuery = string(query[:len(query)-1])
+			m.applyFuzzyFind()
+		}
+		return m, nil
+	case "ctrl+u":
+		m.fuzzyQuery = ""
+		m.applyFuzzyFind()
+		return m, nil
+	}
+
+	if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+		m.fuzzyQuery += string(msg.Runes)
+		m.applyFuzzyFind()
+	}
+	return m, nil
+}
+
+// fuzzyFindPrompt is shown in the footer while the fuzzy finder is open
+func (m Model) fuzzyFindPrompt() string {
+	return fmt.Sprintf("%s%s▏ (%d/%d)", m.styles.InputPrompt.Render("Find: "), m.fuzzyQuery, m.taskList.TaskCount(), len(m.tasks))
+}
@@ -0,0 +1,139 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// createFuzzyFindModel returns a model with tasks to narrow with the fuzzy finder
+func createFuzzyFindModel(jumpLabels bool) Model {
+	cfg := config.DefaultConfig()
+	cfg.TUI.JumpLabels = jumpLabels
+	model := NewModel(&core.MockTaskService{}, cfg)
+	model.width = 100
+	model.height = 30
+	model, _ = loadTasks(model, []core.Task{
+		{UUID: "uuid-1", Description: "Read the documentation about tents", Status: "pending"},
+		{UUID: "uuid-2", Description: "Pay rent", Project: "Home", Status: "pending"},
+		{UUID: "uuid-3", Description: "Call plumber", Tags: []string{"rental"}, Status: "pending"},
+		{UUID: "uuid-4", Description: "Buy milk", Status: "pending"},
+	})
+	return model
+}
+
+// typeFuzzyQuery opens the fuzzy finder and types query
+func typeFuzzyQuery(t *testing.T, model Model, query string) Model {
+	t.Helper()
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyCtrlK})
+	model = updated.(Model)
+	if model.state != StateFuzzyFind {
+		t.Fatalf("Expected the fuzzy finder to open, got state %v", model.state)
+	}
+	for _, r := range query {
+		model = pressKey(t, model, string(r))
+	}
+	return model
+}
+
+// shownUUIDs returns the UUIDs of the tasks in the list, in order
+func shownUUIDs(model Model) []string {
+	var uuids []string
+	for i := range model.taskList.TaskCount() {
+		model.taskList.SetCursor(i)
+		uuids = append(uuids, model.taskList.SelectedTask().UUID)
+	}
+	return uuids
+}
+
+func TestFuzzyFindNarrowsList(t *testing.T) {
+	model := createFuzzyFindModel(false)
+
+	model = typeFuzzyQuery(t, model, "rent")
+	if got := strings.Join(shownUUIDs(model), ","); got != "uuid-2,uuid-3,uuid-1" {
+		t.Errorf("Expected the matches best first, got %s", got)
+	}
+	if selected := model.taskList.SelectedTask(); selected == nil || selected.UUID != "uuid-2" {
+		t.Errorf("Expected the best match to be selected, got %v", selected)
+	}
+	if footer := model.renderFooter(); !strings.Contains(footer, "Find: rent") || !strings.Contains(footer, "(3/4)") {
+		t.Errorf("Expected the query and match count in the footer, got %q", footer)
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model = updated.(Model)
+	if model.fuzzyQuery != "ren" {
+		t.Errorf("Expected backspace to remove a character, got %q", model.fuzzyQuery)
+	}
+}
+
+func TestFuzzyFindEscRestoresList(t *testing.T) {
+	model := createFuzzyFindModel(false)
+	model.taskList.SetCursor(3)
+
+	model = typeFuzzyQuery(t, model, "rent")
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+
+	if model.state != StateNormal {
+		t.Errorf("Expected esc to close the finder, got state %v", model.state)
+	}
+	if model.taskList.TaskCount() != 4 {
+		t.Errorf("Expected the full list back, got %d tasks", model.taskList.TaskCount())
+	}
+	if selected := model.taskList.SelectedTask(); selected == nil || selected.UUID != "uuid-4" {
+		t.Errorf("Expected the previous selection back, got %v", selected)
+	}
+}
+
+func TestFuzzyFindEnterKeepsSelection(t *testing.T) {
+	model := createFuzzyFindModel(false)
+
+	model = typeFuzzyQuery(t, model, "rent")
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = updated.(Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+
+	if model.state != StateNormal || model.taskList.TaskCount() != 4 {
+		t.Errorf("Expected enter to close the finder with the full list, got state %v and %d tasks", model.state, model.taskList.TaskCount())
+	}
+	if selected := model.taskList.SelectedTask(); selected == nil || selected.UUID != "uuid-3" {
+		t.Errorf("Expected the chosen match to stay selected, got %v", selected)
+	}
+}
+
+func TestFuzzyFindDoesNotQueryTaskwarrior(t *testing.T) {
+	exports := 0
+	model := createFuzzyFindModel(false)
+	model.service = &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			exports++
+			return nil, nil
+		},
+	}
+
+	model = typeFuzzyQuery(t, model, "milk")
+	if exports != 0 {
+		t.Errorf("Expected no Taskwarrior export while typing, got %d", exports)
+	}
+	if got := shownUUIDs(model); len(got) != 1 || got[0] != "uuid-4" {
+		t.Errorf("Expected only the matching task, got %v", got)
+	}
+}
+
+func TestFuzzyFindKeyLeavesJumpAlone(t *testing.T) {
+	model := createFuzzyFindModel(false)
+	if model = pressKey(t, model, "f"); model.state != StateNormal {
+		t.Errorf("Expected the jump key not to open the finder, got state %v", model.state)
+	}
+
+	model = createFuzzyFindModel(false)
+	model.config.TUI.Keybindings["fuzzy_find"] = "b"
+	if model = pressKey(t, model, "b"); model.state != StateFuzzyFind {
+		t.Errorf("Expected the configured fuzzy_find key to open the finder, got state %v", model.state)
+	}
+}
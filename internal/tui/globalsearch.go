@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// startGlobalSearch opens the filter input to search all tasks without leaving the current tab
+func (m Model) startGlobalSearch() (tea.Model, tea.Cmd) {
+	m.globalSearchInput = true
+	m.state = StateFilterInput
+	m.filter.SetValue("")
+	m.updateComponentSizes()
+	return m, m.filter.Focus()
+}
+
+// globalSearchCmd creates a command that searches all tasks like the Search tab
+func globalSearchCmd(service core.TaskService, query string, searchAnnotations bool) tea.Cmd {
+	return func() tea.Msg {
+		tasks, err := service.Export(taskFilter(query, true, searchAnnotations))
+		return GlobalSearchResultsMsg{Query: query, Tasks: tasks, Err: err}
+	}
+}
+
+// globalSearchItem returns the entry shown for task in the results overlay
+func globalSearchItem(task core.Task) string {
+	if task.ID > 0 {
+		return fmt.Sprintf("#%d %s", task.ID, task.Description)
+	}
+	id := task.UUID
+	if len(id) > 8 {
+		id = id[:8]
+	}
+	return id + " " + task.Description
+}
+
+// showGlobalSearchResults opens the results overlay, or reports that nothing matched
+func (m *Model) showGlobalSearchResults(msg GlobalSearchResultsMsg) {
+	if msg.Err != nil {
+		m.errorMessage = m.text(msgErrLoadTasks, msg.Err)
+		return
+	}
+	if len(msg.Tasks) == 0 {
+		m.statusMessage = m.text(msgNoSearchResults, msg.Query)
+		return
+	}
+
+	items := make([]string, len(msg.Tasks))
+	for i, task := range msg.Tasks {
+		items[i] = globalSearchItem(task)
+	}
+	title := fmt.Sprintf("Search: %s (%d)", msg.Query, len(msg.Tasks))
+	m.globalSearchPicker = components.NewListPicker(title, items, "")
+	m.globalSearchResults = msg.Tasks
+	m.globalSearchActive = true
+	m.state = StateGlobalSearch
+}
+
+// closeGlobalSearch closes the results overlay
+func (m *Model) closeGlobalSearch() {
+	m.globalSearchActive = false
+	m.globalSearchResults = nil
+	m.state = StateNormal
+}
+
+// handleGlobalSearchKeys handles input while the search results overlay is shown
+func (m Model) handleGlobalSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "enter":
+		selected := m.globalSearchPicker.SelectedItem()
+		results := m.globalSearchResults
+		m.closeGlobalSearch()
+		for _, task := range results {
+			if globalSearchItem(task) == selected {
+				return m.jumpToTask(task)
+			}
+		}
+		return m, nil
+
+	case "esc":
+		m.closeGlobalSearch()
+		return m, nil
+
+	default:
+		m.globalSearchPicker, cmd = m.globalSearchPicker.Update(msg)
+		return m, cmd
+	}
+}
+
+// jumpToTask moves the cursor to task when the current tab lists it, and otherwise
+// shows it in the Search tab
+func (m Model) jumpToTask(task core.Task) (tea.Model, tea.Cmd) {
+	if !m.inGroupView && m.taskList.SelectTask(task.UUID) {
+		m.updateSidebar()
+		return m, nil
+	}
+	return m.openSearchTab("uuid:" + task.UUID)
+}
@@ -0,0 +1,135 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// searchResults are the tasks returned by the service in the global search tests
+var searchResults = []core.Task{
+	{ID: 7, UUID: "search-uuid-1", Description: "Renew passport", Status: "pending"},
+	{UUID: "test-uuid-2", Description: "Test task 2", Status: "completed"},
+}
+
+// runGlobalSearch types query in the global search input and returns the results message
+func runGlobalSearch(t *testing.T, model Model, query string) (Model, tea.Msg) {
+	t.Helper()
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+	model = updated.(Model)
+	if model.state != StateFilterInput || !model.globalSearchInput {
+		t.Fatalf("Expected the search input, got state %v", model.state)
+	}
+	model.filter.SetValue(query)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a search command")
+	}
+	return model, cmd()
+}
+
+func TestGlobalSearchPopulatesOverlay(t *testing.T) {
+	var exported string
+	model := createTestModel(&core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			exported = filter
+			return searchResults, nil
+		},
+	})
+	model.activeFilter = "status:pending"
+
+	model, msg := runGlobalSearch(t, model, "passport")
+	if exported != "status.any: passport" {
+		t.Errorf("Expected the search to cover all statuses, got %q", exported)
+	}
+	if model.activeFilter != "status:pending" || model.globalSearchInput {
+		t.Errorf("Expected the current tab filter to be kept, got %q", model.activeFilter)
+	}
+
+	updated, _ := model.Update(msg)
+	model = updated.(Model)
+	if model.state != StateGlobalSearch || !model.globalSearchActive {
+		t.Fatalf("Expected the results overlay, got state %v", model.state)
+	}
+	view := model.globalSearchPicker.View()
+	for _, expected := range []string{"passport (2)", "#7 Renew passport", "test-uui Test task 2"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the overlay, got:\n%s", expected, view)
+		}
+	}
+	if got := model.taskList.TaskCount(); got != 3 {
+		t.Errorf("Expected the tab's tasks to stay listed, got %d", got)
+	}
+}
+
+func TestGlobalSearchJumpToListedTask(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) { return searchResults, nil },
+	})
+
+	model, msg := runGlobalSearch(t, model, "task")
+	updated, _ := model.Update(msg)
+	model = updated.(Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = updated.(Model)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+
+	if model.globalSearchActive || model.state != StateNormal || cmd != nil {
+		t.Fatalf("Expected the overlay to close without reloading, got state %v", model.state)
+	}
+	if task := model.taskList.SelectedTask(); task == nil || task.UUID != "test-uuid-2" {
+		t.Errorf("Expected the cursor on the chosen task, got %+v", task)
+	}
+}
+
+func TestGlobalSearchJumpToOtherTask(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) { return searchResults, nil },
+	})
+
+	model, msg := runGlobalSearch(t, model, "passport")
+	updated, _ := model.Update(msg)
+	model = updated.(Model)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+
+	if cmd == nil || model.activeFilter != "uuid:search-uuid-1" || model.sections.ActiveIndex != 0 {
+		t.Errorf("Expected the task to open in the Search tab, got filter %q", model.activeFilter)
+	}
+}
+
+func TestGlobalSearchNoResults(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) { return nil, nil },
+	})
+
+	model, msg := runGlobalSearch(t, model, "nothing")
+	updated, _ := model.Update(msg)
+	model = updated.(Model)
+	if model.globalSearchActive || model.statusMessage != "No tasks match 'nothing'" {
+		t.Errorf("Expected a status message, got %q", model.statusMessage)
+	}
+
+	updated, _ = model.Update(GlobalSearchResultsMsg{Query: "x", Err: errors.New("boom")})
+	model = updated.(Model)
+	if model.globalSearchActive || !strings.Contains(model.errorMessage, "boom") {
+		t.Errorf("Expected an error message, got %q", model.errorMessage)
+	}
+}
+
+func TestGlobalSearchCancel(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+	model = updated.(Model)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if model.state != StateNormal || model.globalSearchInput || cmd != nil {
+		t.Errorf("Expected the search to be cancelled, got state %v", model.state)
+	}
+}
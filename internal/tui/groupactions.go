@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// Confirm actions that apply to all the tasks of the highlighted group
+const (
+	confirmGroupDone   = "group_done"
+	confirmGroupDelete = "group_delete"
+)
+
+// highlightedGroup returns the group under the cursor in the Projects/Tags group view
+func (m Model) highlightedGroup() *core.TaskGroup {
+	if !m.inGroupView {
+		return nil
+	}
+	index := m.taskList.Cursor()
+	if index < 0 || index >= len(m.groups) {
+		return nil
+	}
+	return &m.groups[index]
+}
+
+// actionTasks returns the tasks a task action applies to: all the tasks of the
+// highlighted group in the group view, otherwise the selected tasks
+func (m Model) actionTasks() []core.Task {
+	if m.inGroupView {
+		if group := m.highlightedGroup(); group != nil {
+			return group.Tasks
+		}
+		return nil
+	}
+	return m.taskList.GetSelectedTasks()
+}
+
+// startGroupAction asks to confirm a destructive action on all the tasks of the
+// highlighted group, or runs it directly when confirmations are disabled
+func (m Model) startGroupAction(action string) (tea.Model, tea.Cmd) {
+	if len(m.actionTasks()) == 0 {
+		return m, nil
+	}
+	if m.noConfirm {
+		return m.runGroupAction(action)
+	}
+	m.state = StateConfirm
+	m.confirmAction = action
+	return m, nil
+}
+
+// runGroupAction runs a group action on all the tasks of the highlighted group.
+// Marking them done goes through the same validations and completion note as
+// for selected tasks.
+func (m Model) runGroupAction(action string) (tea.Model, tea.Cmd) {
+	tasks := m.actionTasks()
+	if len(tasks) == 0 {
+		return m, nil
+	}
+	switch action {
+	case confirmGroupDone:
+		if m.holdForValidation(tasks) {
+			return m, nil
+		}
+		return m.completeTasks(tasks)
+	case confirmGroupDelete:
+		return m, deleteTasksCmd(m.service, tasks)
+	}
+	return m, nil
+}
+
+// expandGroupPlaceholders fills the {{.group}} and {{.count}} placeholders of a
+// group action prompt
+func (m Model) expandGroupPlaceholders(template string) string {
+	group := m.highlightedGroup()
+	if group == nil {
+		return template
+	}
+	return strings.NewReplacer(
+		"{{.group}}", group.Name,
+		"{{.count}}", strconv.Itoa(len(group.Tasks)),
+	).Replace(template)
+}
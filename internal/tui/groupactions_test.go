@@ -0,0 +1,178 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// groupActionService returns a mock that records done/delete/modify calls in order
+func groupActionService(calls *[]string) *core.MockTaskService {
+	return &core.MockTaskService{
+		DoneFunc: func(uuid string) error {
+			*calls = append(*calls, "done "+uuid)
+			return nil
+		},
+		DeleteFunc: func(uuid string) error {
+			*calls = append(*calls, "delete "+uuid)
+			return nil
+		},
+		ModifyFunc: func(uuid, modifications string) error {
+			*calls = append(*calls, "modify "+uuid+" "+modifications)
+			return nil
+		},
+	}
+}
+
+// createGroupModel returns a Projects tab model whose second group holds two tasks
+func createGroupModel(service core.TaskService) Model {
+	model := createProjectsTabModel(service)
+	model.groups = []core.TaskGroup{
+		{Name: "Home", Count: 1, Tasks: []core.Task{{UUID: "home-1"}}},
+		{Name: "Work", Count: 2, Tasks: []core.Task{{UUID: "work-1"}, {UUID: "work-2"}}},
+	}
+	model.taskList.SetGroups(model.groups)
+	model.taskList.SetCursor(1)
+	return model
+}
+
+// runCmd runs a command and returns its message
+func runCmd(t *testing.T, cmd tea.Cmd) tea.Msg {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("Expected a command")
+	}
+	return cmd()
+}
+
+func TestGroupDoneConfirmed(t *testing.T) {
+	var calls []string
+	model := createGroupModel(groupActionService(&calls))
+
+	model = pressKey(t, model, "d")
+	if model.state != StateConfirm || model.confirmAction != confirmGroupDone {
+		t.Fatalf("Expected a confirmation, got state %v action %q", model.state, model.confirmAction)
+	}
+	if prompt := model.confirmMessage(); !strings.Contains(prompt, "all 2 tasks in 'Work'") {
+		t.Errorf("Expected the prompt to name the group and count, got %q", prompt)
+	}
+	if len(calls) > 0 {
+		t.Fatal("Expected no task to be done before confirming")
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	model = updated.(Model)
+	runCmd(t, cmd)
+	if expected := []string{"done work-1", "done work-2"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
+
+func TestGroupDoneValidatesTasks(t *testing.T) {
+	var calls []string
+	model := createGroupModel(groupActionService(&calls))
+	model.noConfirm = true
+	model.groups[1].Tasks[1].Annotations = []core.Annotation{{Description: "TODO: write tests"}}
+	model.taskList.SetGroups(model.groups)
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	model = updated.(Model)
+	if cmd != nil || model.state != StateTaskValidation || len(calls) > 0 {
+		t.Fatalf("Expected the validation popup before marking the group done, got state %v calls %v", model.state, calls)
+	}
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	runCmd(t, cmd)
+	if expected := []string{"done work-1", "done work-2"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v after forcing the completion, got %v", expected, calls)
+	}
+}
+
+func TestGroupDoneAsksCompletionNote(t *testing.T) {
+	var calls []string
+	service := groupActionService(&calls)
+	service.AnnotateFunc = func(uuid, text string) error {
+		calls = append(calls, "annotate "+uuid+" "+text)
+		return nil
+	}
+	model := createGroupModel(service)
+	model.noConfirm = true
+	model.config.TUI.AnnotateOnDone = annotateOnDonePrompt
+
+	model = pressKey(t, model, "d")
+	if model.state != StateAnnotateInput || len(calls) > 0 {
+		t.Fatalf("Expected the completion note prompt, got state %v calls %v", model.state, calls)
+	}
+	model.annotateInput.SetValue("shipped")
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runCmd(t, cmd)
+	expected := []string{"annotate work-1 shipped", "done work-1", "annotate work-2 shipped", "done work-2"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
+
+func TestGroupDeleteCancelled(t *testing.T) {
+	var calls []string
+	model := createGroupModel(groupActionService(&calls))
+
+	model = pressKey(t, model, "x")
+	if model.state != StateConfirm || model.confirmAction != confirmGroupDelete {
+		t.Fatalf("Expected a confirmation, got state %v action %q", model.state, model.confirmAction)
+	}
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	model = updated.(Model)
+	if cmd != nil || model.state != StateNormal || len(calls) > 0 {
+		t.Errorf("Expected the group delete to be cancelled, got %v", calls)
+	}
+}
+
+func TestGroupDeleteWithoutConfirmation(t *testing.T) {
+	var calls []string
+	model := createGroupModel(groupActionService(&calls))
+	model.noConfirm = true
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	model = updated.(Model)
+	runCmd(t, cmd)
+	if expected := []string{"delete work-1", "delete work-2"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
+
+func TestGroupModify(t *testing.T) {
+	var calls []string
+	model := createGroupModel(groupActionService(&calls))
+
+	model = pressKey(t, model, "m")
+	if model.state != StateModifyInput {
+		t.Fatalf("Expected the modify input, got state %v", model.state)
+	}
+	model.modifyInput.SetValue("+review")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	runCmd(t, cmd)
+	if expected := []string{"modify work-1 +review", "modify work-2 +review"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
+
+func TestGroupViewSidebarShowsHighlightedGroup(t *testing.T) {
+	model := createGroupModel(&core.MockTaskService{})
+	if !model.viewModeAvailable(ViewModeListWithSidebar) {
+		t.Fatal("Expected the sidebar to be available in the group view")
+	}
+
+	model = pressKey(t, model, "k")
+	if view := model.sidebar.View(); !strings.Contains(view, "Home") || !strings.Contains(view, "Tasks: 1") {
+		t.Errorf("Expected the Home group details, got:\n%s", view)
+	}
+
+	model = pressKey(t, model, "j")
+	if view := model.sidebar.View(); !strings.Contains(view, "Work") || !strings.Contains(view, "Tasks: 2") {
+		t.Errorf("Expected the Work group details, got:\n%s", view)
+	}
+}
@@ -0,0 +1,184 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// homeTabName is the special tab name that shows the dashboard instead of a task list
+const homeTabName = "Home"
+
+// Widgets accepted in tui.home_widgets
+const (
+	homeWidgetTabCounts = "tab_counts" // Number of tasks in each tab
+	homeWidgetOverdue   = "overdue"    // Number of overdue tasks
+	homeWidgetAgenda    = "agenda"     // Tasks due today
+	homeWidgetRecent    = "recent"     // Tasks completed in the last week
+)
+
+// homeListLimit is the number of tasks listed by the agenda and recent widgets
+const homeListLimit = 5
+
+// homeRecentFilter selects the tasks shown by the recent widget
+const homeRecentFilter = "status:completed end.after:today-7d"
+
+// homeTabCount is the number of tasks matched by a tab's filter
+type homeTabCount struct {
+	Name  string
+	Count int
+}
+
+// homeData holds what the dashboard widgets show
+type homeData struct {
+	TabCounts []homeTabCount // In tab order
+	Overdue   int
+	Agenda    []core.Task // Due today, earliest first
+	Recent    []core.Task // Most recently completed first
+}
+
+// resolveHomeWidgets returns the known widgets in the configured order.
+// Unknown and repeated names are skipped.
+func resolveHomeWidgets(names []string) []string {
+	known := []string{homeWidgetTabCounts, homeWidgetOverdue, homeWidgetAgenda, homeWidgetRecent}
+	var widgets []string
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(known, name) {
+			slog.Warn("Ignoring unknown home_widgets entry", "name", name)
+			continue
+		}
+		if !slices.Contains(widgets, name) {
+			widgets = append(widgets, name)
+		}
+	}
+	return widgets
+}
+
+// isHomeView reports whether the dashboard is shown
+func (m Model) isHomeView() bool {
+	return m.currentSection != nil && m.currentSection.Name == homeTabName
+}
+
+// loadHomeCmd loads the data of the widgets that need more than the Home tab's own
+// tasks: the task count of every other tab and the recently completed tasks
+func loadHomeCmd(service core.TaskService, sections []core.Section, widgets []string) tea.Cmd {
+	return func() tea.Msg {
+		var msg HomeLoadedMsg
+		if slices.Contains(widgets, homeWidgetTabCounts) {
+			for _, section := range sections {
+				if section.Name == "Search" || section.Name == homeTabName || section.Filter == "" {
+					continue
+				}
+				tasks, err := service.Export(section.Filter)
+				if err != nil {
+					return HomeLoadedMsg{Err: err}
+				}
+				msg.TabCounts = append(msg.TabCounts, homeTabCount{Name: section.Name, Count: len(withoutRecurring(tasks))})
+			}
+		}
+		if slices.Contains(widgets, homeWidgetRecent) {
+			tasks, err := service.Export(homeRecentFilter)
+			if err != nil {
+				return HomeLoadedMsg{Err: err}
+			}
+			msg.Completed = tasks
+		}
+		return msg
+	}
+}
+
+// withoutRecurring drops recurring parent tasks, which tabs do not list
+func withoutRecurring(tasks []core.Task) []core.Task {
+	var filtered []core.Task
+	for _, task := range tasks {
+		if task.Status != "recurring" {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+// assembleHomeData computes the widget data from the Home tab's tasks, the tab counts
+// and the recently completed tasks
+func assembleHomeData(tasks []core.Task, tabCounts []homeTabCount, completed []core.Task) homeData {
+	data := homeData{TabCounts: tabCounts}
+
+	for _, task := range tasks {
+		if task.IsOverdue() {
+			data.Overdue++
+		}
+		if task.IsDueToday() {
+			data.Agenda = append(data.Agenda, task)
+		}
+	}
+	slices.SortStableFunc(data.Agenda, func(a, b core.Task) int {
+		return a.Due.Compare(*b.Due)
+	})
+
+	for _, task := range completed {
+		if task.End != nil {
+			data.Recent = append(data.Recent, task)
+		}
+	}
+	slices.SortStableFunc(data.Recent, func(a, b core.Task) int {
+		return b.End.Compare(*a.End)
+	})
+
+	data.Agenda = data.Agenda[:min(len(data.Agenda), homeListLimit)]
+	data.Recent = data.Recent[:min(len(data.Recent), homeListLimit)]
+	return data
+}
+
+// renderHome renders the configured dashboard widgets
+func (m Model) renderHome() string {
+	data := assembleHomeData(m.tasks, m.homeTabCounts, m.homeCompleted)
+
+	var widgets []string
+	for _, widget := range m.homeWidgets {
+		var lines []string
+		switch widget {
+		case homeWidgetTabCounts:
+			lines = append(lines, m.styles.Label.Render("Tabs"))
+			for _, tab := range data.TabCounts {
+				lines = append(lines, fmt.Sprintf("  %-16s %s", tab.Name, m.styles.Value.Render(fmt.Sprint(tab.Count))))
+			}
+		case homeWidgetOverdue:
+			count := m.styles.Value.Render("0")
+			if data.Overdue > 0 {
+				count = m.styles.Error.Render(fmt.Sprint(data.Overdue))
+			}
+			lines = append(lines, m.styles.Label.Render("Overdue")+"  "+count)
+		case homeWidgetAgenda:
+			lines = append(lines, m.styles.Label.Render("Today"))
+			lines = append(lines, m.homeTaskLines(data.Agenda, "Nothing due today")...)
+		case homeWidgetRecent:
+			lines = append(lines, m.styles.Label.Render("Recently completed"))
+			lines = append(lines, m.homeTaskLines(data.Recent, "Nothing completed this week")...)
+		}
+		widgets = append(widgets, strings.Join(lines, "\n"))
+	}
+
+	content := strings.Join(widgets, "\n\n")
+	if content == "" {
+		content = m.styles.Dim.Render("No widgets configured (see tui.home_widgets)")
+	}
+	return lipgloss.NewStyle().Padding(1, 2).MaxWidth(m.width).Height(max(m.contentHeight, 1)).Render(content)
+}
+
+// homeTaskLines lists tasks in a widget, or shows empty when there are none
+func (m Model) homeTaskLines(tasks []core.Task, empty string) []string {
+	if len(tasks) == 0 {
+		return []string{"  " + m.styles.Dim.Render(empty)}
+	}
+	lines := make([]string, len(tasks))
+	for i, task := range tasks {
+		lines[i] = "  • " + task.Description
+	}
+	return lines
+}
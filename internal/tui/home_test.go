@@ -0,0 +1,163 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// at returns a pointer to the time offset from now by d
+func at(now time.Time, d time.Duration) *time.Time {
+	t := now.Add(d)
+	return &t
+}
+
+func TestAssembleHomeData(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.Local)
+	core.SetNowFunc(func() time.Time { return now })
+	t.Cleanup(func() { core.SetNowFunc(nil) })
+
+	tasks := []core.Task{
+		{UUID: "1", Description: "Late report", Status: "pending", Due: at(now, -48*time.Hour)},
+		{UUID: "2", Description: "Evening call", Status: "pending", Due: at(now, 6*time.Hour)},
+		{UUID: "3", Description: "Lunch", Status: "pending", Due: at(now, -time.Hour)},
+		{UUID: "4", Description: "Next week", Status: "pending", Due: at(now, 7*24*time.Hour)},
+		{UUID: "5", Description: "No due", Status: "pending"},
+	}
+	var completed []core.Task
+	for i := range homeListLimit + 2 {
+		completed = append(completed, core.Task{
+			UUID:        "done",
+			Description: "Done " + string(rune('A'+i)),
+			Status:      "completed",
+			End:         at(now, -time.Duration(i)*time.Hour),
+		})
+	}
+	tabCounts := []homeTabCount{{Name: "Next", Count: 4}}
+
+	data := assembleHomeData(tasks, tabCounts, completed)
+
+	if data.Overdue != 2 {
+		t.Errorf("Expected 2 overdue tasks, got %d", data.Overdue)
+	}
+	var agenda []string
+	for _, task := range data.Agenda {
+		agenda = append(agenda, task.Description)
+	}
+	if expected := []string{"Lunch", "Evening call"}; !reflect.DeepEqual(agenda, expected) {
+		t.Errorf("Expected agenda %v, got %v", expected, agenda)
+	}
+	if len(data.Recent) != homeListLimit || data.Recent[0].Description != "Done A" {
+		t.Errorf("Expected the %d most recently completed tasks, got %+v", homeListLimit, data.Recent)
+	}
+	if !reflect.DeepEqual(data.TabCounts, tabCounts) {
+		t.Errorf("Expected tab counts %v, got %v", tabCounts, data.TabCounts)
+	}
+}
+
+func TestResolveHomeWidgets(t *testing.T) {
+	got := resolveHomeWidgets([]string{"recent", " Overdue ", "bogus", "recent"})
+	if expected := []string{"recent", "overdue"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestLoadHomeCmd(t *testing.T) {
+	var filters []string
+	service := &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			filters = append(filters, filter)
+			return []core.Task{{Status: "pending"}, {Status: "recurring"}}, nil
+		},
+	}
+	sections := []core.Section{
+		{Name: "Search"},
+		{Name: homeTabName, Filter: "status:pending"},
+		{Name: "Next", Filter: "+next"},
+		{Name: "Work", Filter: "+work"},
+	}
+
+	msg := loadHomeCmd(service, sections, []string{homeWidgetTabCounts, homeWidgetRecent})().(HomeLoadedMsg)
+	if msg.Err != nil {
+		t.Fatalf("Expected no error, got %v", msg.Err)
+	}
+	if expected := []homeTabCount{{"Next", 1}, {"Work", 1}}; !reflect.DeepEqual(msg.TabCounts, expected) {
+		t.Errorf("Expected tab counts %v, got %v", expected, msg.TabCounts)
+	}
+	if expected := []string{"+next", "+work", homeRecentFilter}; !reflect.DeepEqual(filters, expected) {
+		t.Errorf("Expected exports %v, got %v", expected, filters)
+	}
+
+	// Widgets that are not shown load nothing
+	filters = nil
+	loadHomeCmd(service, sections, []string{homeWidgetOverdue})()
+	if len(filters) != 0 {
+		t.Errorf("Expected no exports, got %v", filters)
+	}
+}
+
+func TestHomeTabRendersWidgets(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TUI.Tabs = []config.Tab{
+		{Name: homeTabName, Filter: "status:pending"},
+		{Name: "Next", Filter: "+next"},
+	}
+	cfg.TUI.HomeWidgets = []string{"tab_counts", "overdue"}
+	model := NewModel(&core.MockTaskService{}, cfg)
+	model.width = 100
+	model.height = 30
+
+	updated, _ := model.Update(components.SectionChangedMsg{Section: model.sections.Items[1]})
+	model = updated.(Model)
+	model, cmd := loadTasks(model, []core.Task{{UUID: "1", Description: "Task", Status: "pending"}})
+	if cmd == nil {
+		t.Fatal("Expected the widget data to be loaded")
+	}
+	updated, _ = model.Update(HomeLoadedMsg{TabCounts: []homeTabCount{{Name: "Next", Count: 3}}})
+	model = updated.(Model)
+
+	view := model.View()
+	for _, expected := range []string{"Tabs", "Next", "3", "Overdue"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q on the Home tab, got:\n%s", expected, view)
+		}
+	}
+	if strings.Contains(view, "Recently completed") {
+		t.Error("Expected widgets that are not configured to be hidden")
+	}
+}
+
+func TestHomeTabIgnoresTaskActions(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TUI.Tabs = []config.Tab{
+		{Name: homeTabName, Filter: "status:pending"},
+		{Name: "Next", Filter: "+next"},
+	}
+	cfg.TUI.Keybindings["refresh"] = "ctrl+l"
+	service := &core.MockTaskService{
+		DoneFunc: func(uuid string) error {
+			t.Errorf("Expected no task action on the Home tab, got done for %s", uuid)
+			return nil
+		},
+	}
+	model := NewModel(service, cfg)
+	model.width = 100
+	model.height = 30
+
+	updated, _ := model.Update(components.SectionChangedMsg{Section: model.sections.Items[1]})
+	model = updated.(Model)
+	model, _ = loadTasks(model, []core.Task{{UUID: "1", Description: "Task", Status: "pending"}})
+
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")}); cmd != nil {
+		cmd()
+	}
+	if footer := model.renderFooter(); !strings.Contains(footer, "ctrl+l: refresh") {
+		t.Errorf("Expected the remapped refresh key in the footer, got %q", footer)
+	}
+}
@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// maxMessageHistory bounds the number of messages kept in the message history
+const maxMessageHistory = 100
+
+// messageEntry is a status or error message shown in the footer, kept for review
+type messageEntry struct {
+	Time    time.Time
+	Message string
+	IsError bool
+	Output  bool // Captured command output, possibly spanning several lines
+}
+
+// recordMessages appends the status and error messages that changed since
+// prevStatus and prevError to the message history
+func (m *Model) recordMessages(prevStatus, prevError string) {
+	now := core.Now()
+	if m.statusMessage != "" && m.statusMessage != prevStatus {
+		m.appendMessage(messageEntry{Time: now, Message: m.statusMessage})
+	}
+	if m.errorMessage != "" && m.errorMessage != prevError {
+		m.appendMessage(messageEntry{Time: now, Message: m.errorMessage, IsError: true})
+	}
+}
+
+// appendMessage appends an entry to the message history, dropping the oldest
+// entries beyond maxMessageHistory
+func (m *Model) appendMessage(entry messageEntry) {
+	m.messageHistory = append(m.messageHistory, entry)
+	if extra := len(m.messageHistory) - maxMessageHistory; extra > 0 {
+		m.messageHistory = append([]messageEntry(nil), m.messageHistory[extra:]...)
+	}
+}
+
+// messagesHeight returns the number of lines available to the message list
+func (m Model) messagesHeight() int {
+	// Title and bottom border take one line each
+	height := m.height - lipgloss.Height(m.renderSections()) - lipgloss.Height(m.renderFooter()) - 2
+	return max(height, 1)
+}
+
+// scrollMessages moves the message list by delta lines, within bounds
+func (m *Model) scrollMessages(delta int) {
+	maxOffset := max(len(m.messageHistory)-m.messagesHeight(), 0)
+	m.messagesOffset = min(max(m.messagesOffset+delta, 0), maxOffset)
+}
+
+// handleMessagesKeys handles keys while the message history is shown
+func (m Model) handleMessagesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keyPressed := msg.String()
+	switch {
+	case keyPressed == "esc" || keyPressed == "q" || m.keyMatches(keyPressed, "messages"):
+		m.state = StateNormal
+	case keyPressed == "j" || keyPressed == "down":
+		m.scrollMessages(1)
+	case keyPressed == "k" || keyPressed == "up":
+		m.scrollMessages(-1)
+	case keyPressed == "ctrl+d" || keyPressed == "pgdown":
+		m.scrollMessages(m.messagesHeight())
+	case keyPressed == "ctrl+u" || keyPressed == "pgup":
+		m.scrollMessages(-m.messagesHeight())
+	case keyPressed == "g":
+		m.messagesOffset = 0
+	case keyPressed == "G":
+		m.scrollMessages(len(m.messageHistory))
+	}
+	return m, nil
+}
+
+// renderMessages renders the message history, newest first
+func (m Model) renderMessages() string {
+	lines := []string{m.styles.Header.Render(fmt.Sprintf("Messages (%d)", len(m.messageHistory)))}
+
+	height := m.messagesHeight()
+	if len(m.messageHistory) == 0 {
+		lines = append(lines, m.styles.Dim.Render("No messages yet."))
+	}
+	for i := len(m.messageHistory) - 1 - m.messagesOffset; i >= 0 && len(lines) <= height; i-- {
+		entry := m.messageHistory[i]
+		timestamp := m.styles.Dim.Render(entry.Time.Format("15:04:05")) + " "
+		if entry.Output {
+			// Output lines are indented below the command name
+			outputLines := strings.Split(entry.Message, "\n")
+			lines = append(lines, lipgloss.NewStyle().MaxWidth(m.width).Render(timestamp+"$ "+outputLines[0]))
+			for _, outputLine := range outputLines[1:] {
+				if len(lines) > height {
+					break
+				}
+				lines = append(lines, lipgloss.NewStyle().MaxWidth(m.width).Render("           "+outputLine))
+			}
+			continue
+		}
+		text := m.styles.Success.Render("✓ " + entry.Message)
+		if entry.IsError {
+			text = m.styles.Error.Render("✗ " + entry.Message)
+		}
+		lines = append(lines, lipgloss.NewStyle().MaxWidth(m.width).Render(timestamp+text))
+	}
+	for len(lines) <= height {
+		lines = append(lines, "")
+	}
+
+	bottomBorder := m.styles.Separator.Width(m.width).Render(strings.Repeat("─", m.width))
+	return strings.Join(lines, "\n") + "\n" + bottomBorder
+}
@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestMessageHistoryAccumulates(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	updated, _ := model.Update(StatusMsg{Message: "Copied to clipboard: x"})
+	model = updated.(Model)
+	updated, _ = model.Update(ErrorMsg{Err: errors.New("task failed")})
+	model = updated.(Model)
+	updated, _ = model.Update(StatusMsg{Message: "Failed to copy", IsError: true})
+	model = updated.(Model)
+
+	if len(model.messageHistory) != 3 {
+		t.Fatalf("Expected 3 messages in history, got %d: %+v", len(model.messageHistory), model.messageHistory)
+	}
+	expected := []messageEntry{
+		{Message: "Copied to clipboard: x"},
+		{Message: "task failed", IsError: true},
+		{Message: "Failed to copy", IsError: true},
+	}
+	for i, entry := range model.messageHistory {
+		if entry.Message != expected[i].Message || entry.IsError != expected[i].IsError {
+			t.Errorf("Expected entry %d to be %+v, got %+v", i, expected[i], entry)
+		}
+		if entry.Time.IsZero() {
+			t.Errorf("Expected entry %d to have a timestamp", i)
+		}
+	}
+
+	// Messages that did not change are not recorded again
+	updated, _ = model.Update(RefreshMsg{})
+	model = updated.(Model)
+	if len(model.messageHistory) != 3 {
+		t.Errorf("Expected unchanged messages not to be recorded, got %d entries", len(model.messageHistory))
+	}
+}
+
+func TestMessageHistoryBounded(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	for i := range maxMessageHistory + 5 {
+		updated, _ := model.Update(StatusMsg{Message: fmt.Sprintf("message %d", i)})
+		model = updated.(Model)
+	}
+
+	if len(model.messageHistory) != maxMessageHistory {
+		t.Fatalf("Expected %d messages, got %d", maxMessageHistory, len(model.messageHistory))
+	}
+	if got := model.messageHistory[0].Message; got != "message 5" {
+		t.Errorf("Expected the oldest messages to be dropped, first is %q", got)
+	}
+}
+
+func TestMessageHistoryView(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	updated, _ := model.Update(StatusMsg{Message: "first"})
+	model = updated.(Model)
+	updated, _ = model.Update(StatusMsg{Message: "second"})
+	model = updated.(Model)
+
+	model = pressKey(t, model, "E")
+	if model.state != StateMessages {
+		t.Fatalf("Expected the message history, got state %v", model.state)
+	}
+	view := model.View()
+	if !strings.Contains(view, "Messages (2)") || strings.Index(view, "second") > strings.Index(view, "first") {
+		t.Errorf("Expected messages newest first, got:\n%s", view)
+	}
+
+	model = pressKey(t, model, "q")
+	if model.state != StateNormal {
+		t.Errorf("Expected q to close the message history, got state %v", model.state)
+	}
+}
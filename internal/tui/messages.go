@@ -43,6 +43,17 @@ type CalendarSyncCompletedMsg struct {
 	Err    error
 }
 
+// CalendarSyncProgressMsg is sent before each task is synced to Google Calendar
+type CalendarSyncProgressMsg struct {
+	Current int // Position of the task being synced
+	Total   int // Number of tasks to sync
+}
+
+// TaskSyncCompletedMsg is sent when "task sync" completes
+type TaskSyncCompletedMsg struct {
+	Err error
+}
+
 // CalendarAuthRequiredMsg is sent when calendar sync cannot proceed because
 // there is no saved OAuth2 token. The AuthServer is already listening; the
 // TUI should display AuthServer.URL to the user and launch waitForCalendarAuthCmd.
@@ -63,8 +74,58 @@ type AutocompleteDataLoadedMsg struct {
 	Err      error
 }
 
+// ActiveContextMsg is sent when the active Taskwarrior context has been loaded at startup
+type ActiveContextMsg struct {
+	Name string
+	Err  error
+}
+
+// ContextsLoadedMsg is sent when the Taskwarrior contexts have been loaded for the context picker
+type ContextsLoadedMsg struct {
+	Contexts []string
+	Active   string
+	Err      error
+}
+
+// ContextChangedMsg is sent when a Taskwarrior context has been activated, or cleared when Name is empty
+type ContextChangedMsg struct {
+	Name string
+	Err  error
+}
+
 // DepTasksLoadedMsg is sent when dependency tasks (not in the main task list) have been loaded
 type DepTasksLoadedMsg struct {
 	Tasks []core.Task
 	Err   error
 }
+
+// GlobalSearchResultsMsg is sent when a search across all tasks completes
+type GlobalSearchResultsMsg struct {
+	Query string
+	Tasks []core.Task
+	Err   error
+}
+
+// HomeLoadedMsg is sent when the data of the Home tab widgets has been loaded
+type HomeLoadedMsg struct {
+	TabCounts []homeTabCount // Tasks per tab
+	Completed []core.Task    // Recently completed tasks
+	Err       error
+}
+
+// CommandRunMsg carries the command line of a task command run by the service (tui.show_commands)
+type CommandRunMsg struct {
+	Command string
+}
+
+// CommandOutputMsg carries the output captured from a custom command or task edit
+type CommandOutputMsg struct {
+	Name   string // Name of the command that produced the output
+	Output string
+}
+
+// UndoPreviewMsg is sent when the description of what undo would revert is known
+type UndoPreviewMsg struct {
+	Summary string // e.g. `modify "Pay rent": priority H→M`
+	Err     error  // Set when the preview cannot be computed
+}
@@ -2,7 +2,6 @@ package tui
 
 import (
 	"bytes"
-	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -11,7 +10,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/clobrano/wui/internal/calendar"
@@ -35,6 +33,8 @@ const (
 	ViewModeSmallTaskDetail
 	// ViewModeTaskDetail shows full-screen task details (replaces list view)
 	ViewModeTaskDetail
+	// ViewModeProjectPanes shows projects on the left and the selected project's tasks on the right
+	ViewModeProjectPanes
 )
 
 // String returns the string representation of ViewMode
@@ -50,6 +50,8 @@ func (v ViewMode) String() string {
 		return "small_task_detail"
 	case ViewModeTaskDetail:
 		return "task_detail"
+	case ViewModeProjectPanes:
+		return "project_panes"
 	default:
 		return "unknown"
 	}
@@ -75,12 +77,34 @@ const (
 	StateNewTaskInput
 	// StateResourcePicker is active when user is selecting a resource (URL or file) to open
 	StateResourcePicker
+	// StateDuePresetPicker is active when user is choosing a due date preset
+	StateDuePresetPicker
+	// StateProjectPicker is active when user is choosing a project to assign tasks to
+	StateProjectPicker
+	// StateTemplatePicker is active when user is choosing a template for a new task
+	StateTemplatePicker
+	// StateRecurrencePicker is active when user is choosing the period of a new recurring task
+	StateRecurrencePicker
 	// StateTaskValidation is active when user is shown task validation warnings (TODOs or blocking tasks)
 	StateTaskValidation
 	// StateTokenExpired is active when the calendar token is expired and user is prompted to refresh it
 	StateTokenExpired
 	// StateWaitingForCalendarAuth is active while waiting for the user to complete browser-based OAuth2 authorization
 	StateWaitingForCalendarAuth
+	// StateMessages is active when the history of status and error messages is shown
+	StateMessages
+	// StateGlobalSearch is active when the results of a search across all tasks are shown
+	StateGlobalSearch
+	// StateTabPicker is active when user is choosing one of the tabs hidden by max_visible_tabs
+	StateTabPicker
+	// StateDatePicker is active when user is choosing the due date of tasks in the calendar
+	StateDatePicker
+	// StateFuzzyFind is active when user is narrowing the loaded tasks with the fuzzy finder
+	StateFuzzyFind
+	// StateRecurrenceScopePicker is active when user is choosing whether a modification applies to all instances of a recurring task
+	StateRecurrenceScopePicker
+	// StateContextPicker is active when user is choosing the Taskwarrior context
+	StateContextPicker
 )
 
 // String returns the string representation of AppState
@@ -102,12 +126,34 @@ func (s AppState) String() string {
 		return "new_task_input"
 	case StateResourcePicker:
 		return "resource_picker"
+	case StateDuePresetPicker:
+		return "due_preset_picker"
+	case StateProjectPicker:
+		return "project_picker"
+	case StateTemplatePicker:
+		return "template_picker"
+	case StateRecurrencePicker:
+		return "recurrence_picker"
 	case StateTaskValidation:
 		return "task_validation"
 	case StateTokenExpired:
 		return "token_expired"
 	case StateWaitingForCalendarAuth:
 		return "waiting_for_calendar_auth"
+	case StateMessages:
+		return "messages"
+	case StateGlobalSearch:
+		return "global_search"
+	case StateTabPicker:
+		return "tab_picker"
+	case StateDatePicker:
+		return "date_picker"
+	case StateFuzzyFind:
+		return "fuzzy_find"
+	case StateRecurrenceScopePicker:
+		return "recurrence_scope_picker"
+	case StateContextPicker:
+		return "context_picker"
 	default:
 		return "unknown"
 	}
@@ -116,9 +162,10 @@ func (s AppState) String() string {
 // Model represents the main TUI application model
 type Model struct {
 	// Core dependencies
-	service core.TaskService
-	config  *config.Config
-	styles  *Styles // Centralized styling
+	service   core.TaskService
+	config    *config.Config
+	styles    *Styles        // Centralized styling
+	taskCache *cachedService // Export cache wrapping service (nil when tui.cache_ttl_seconds is not set)
 
 	// Task data
 	tasks            []core.Task
@@ -129,8 +176,13 @@ type Model struct {
 	// Grouping state (for Projects/Tags sections)
 	groups        []core.TaskGroup // Current groups (when in group list view)
 	selectedGroup *core.TaskGroup  // Selected group (when drilling into a group)
+	groupOrigin   int              // Index of the Projects/Tags tab a group was opened from; 0 (the Search tab) when none
 	inGroupView   bool             // true = showing group list, false = showing tasks
 
+	// Two-pane Projects view state
+	projectPane        components.TaskList // Left pane listing projects (tasks go in taskList)
+	projectPaneFocused bool                // true = project pane has focus, false = task pane
+
 	// UI state
 	viewMode ViewMode
 	state    AppState
@@ -140,6 +192,15 @@ type Model struct {
 
 	// Search tab filter (persists for the session)
 	searchTabFilter string
+	// Filters applied in the other tabs, by tab name, shown again when switching back
+	tabFilters map[string]string
+
+	// Fuzzy finder over the loaded tasks
+	fuzzyQuery  string // Text typed in the finder
+	fuzzyOrigin string // UUID of the task selected when the finder opened
+
+	// UUID of the task the list is focused on, with its dependency chain; empty when not focused
+	focusUUID string
 
 	// Status and error messages
 	statusMessage string
@@ -188,11 +249,95 @@ type Model struct {
 	resourcePickerActive bool            // true when resource picker is shown
 	resourcePickerItems  []ResourceMatch // Resources (URLs/files) extracted from current task's annotations
 
+	// Due date presets menu
+	duePresetPicker       components.ListPicker
+	duePresetPickerActive bool        // true when the due presets menu is shown
+	duePresetTasks        []core.Task // Tasks the chosen preset will be applied to
+
+	// Recurrence scope menu (this instance or all future instances of a recurring task)
+	recurrenceScopePicker        components.ListPicker
+	recurrenceScopePickerActive  bool        // true when the recurrence scope menu is shown
+	recurrenceScopeTasks         []core.Task // Tasks the modifications will be applied to
+	recurrenceScopeModifications string      // Modifications typed in the modify input
+
+	// Due date picker (calendar opened on the selected tasks)
+	datePickerTasks []core.Task // Tasks the chosen date will be applied to
+
+	// Assign-to-project picker
+	projectPicker       components.ListPicker
+	projectPickerActive bool        // true when the assign-to-project picker is shown
+	projectPickerTasks  []core.Task // Tasks the chosen project will be assigned to
+	newProjectPrompt    bool        // true when the modify prompt was opened for a new project, to apply project_rules
+
+	// New task template picker
+	templatePicker       components.ListPicker
+	templatePickerActive bool // true when the template picker is shown
+
+	// New recurring task flow (description, recurrence period, first due date)
+	recurringTask          *recurringDraft // Answers so far; nil when the flow is not running
+	subtaskParent          *core.Task      // Task the new task input adds a subtask to; nil for a plain new task
+	recurrencePicker       components.ListPicker
+	recurrencePickerActive bool // true when the recurrence period picker is shown
+
+	// Picker of the tabs hidden by max_visible_tabs
+	tabPicker       components.ListPicker
+	tabPickerActive bool // true when the hidden tabs picker is shown
+
+	// Taskwarrior context
+	activeContext       string // Name of the active context; empty when none
+	contextPicker       components.ListPicker
+	contextPickerActive bool // true when the context picker is shown
+
+	// View modes visited by the cycle_view key
+	viewCycle []ViewMode
+
+	// View mode restored when leaving a tab with its own view_mode
+	globalViewMode ViewMode
+	tabViewModeSet bool // true while a tab's view_mode replaces globalViewMode
+
+	// Actions tried in order by the esc key
+	escBehavior []string
+
+	// First key of a chord binding (e.g. "g" of "gg") waiting for the second
+	pendingChord string
+
+	// Held navigation key tracked by nav_acceleration
+	navRepeatKey string    // Last up or down key pressed
+	navRepeatAt  time.Time // When it was pressed
+	navRepeats   int       // Presses of the key in a row, less one
+
+	// Home tab dashboard
+	homeWidgets   []string       // Widgets shown, in order
+	homeTabCounts []homeTabCount // Tasks per tab, from the last HomeLoadedMsg
+	homeCompleted []core.Task    // Recently completed tasks, from the last HomeLoadedMsg
+	contentHeight int            // Lines available between the sections bar and the footer
+
+	// Search across all tasks, shown over the current tab
+	globalSearchInput   bool // true when the filter input runs a search across all tasks
+	globalSearchPicker  components.ListPicker
+	globalSearchActive  bool        // true when the search results are shown
+	globalSearchResults []core.Task // Tasks listed in globalSearchPicker
+
+	// Peek popup with a summary of the selected task
+	peekActive bool
+
+	// Automatic sidebar for annotated tasks (tui.auto_sidebar_if_annotated)
+	sidebarPinned        bool   // true when the user opened the sidebar, so it is not closed automatically
+	sidebarDismissedUUID string // Task whose sidebar the user closed, so it is not reopened automatically
+
+	// onEmptyPending is true until the first task load of the current tab, the only
+	// load that may open the new task input for tabs with on_empty: new
+	onEmptyPending bool
+
 	// Confirm action tracking
 	confirmAction string // "delete", "done", etc.
+	undoPreview   string // What the confirmed undo reverts; empty when unknown
+	noConfirm     bool   // true when destructive actions skip the confirmation prompt
+	quitWarned    bool   // true right after quitting was held back by warn_quit_with_selection
 
 	// Task validation state (TODOs and blocking tasks)
 	pendingDoneTasks []core.Task // Tasks pending completion (waiting for validation)
+	doneNoteTasks    []core.Task // Tasks marked done after the completion note is entered (annotate_on_done)
 	outstandingTodos []string    // Outstanding TODO: annotations found in tasks
 	blockingTasks    []string    // Descriptions of tasks blocking the selected tasks
 
@@ -203,8 +348,21 @@ type Model struct {
 	calendarAuthServer   *calendar.AuthServer // active OAuth2 auth server (nil when not in auth flow)
 	calendarAuthURL      string               // auth URL shown in the waiting-for-auth popup
 
+	// Progress updates of the running calendar sync (nil when idle)
+	calendarSyncProgress <-chan CalendarSyncProgressMsg
+
+	// Command lines of the task commands run, when tui.show_commands is set; nil otherwise
+	commandLog <-chan string
+
+	// Clipboard of the copy actions (tui.clipboard)
+	clipboard Clipboard
+
 	// Shortcut override warnings (custom commands overriding internal shortcuts)
 	shortcutWarnings []string
+
+	// History of status and error messages, oldest first
+	messageHistory []messageEntry
+	messagesOffset int // Scroll offset of the message history view
 }
 
 // NewModel creates a new TUI model
@@ -239,10 +397,13 @@ func NewModel(service core.TaskService, cfg *config.Config) Model {
 				continue
 			}
 			coreTabs = append(coreTabs, core.Tab{
-				Name:    t.Name,
-				Filter:  t.Filter,
-				Sort:    t.Sort,
-				Reverse: t.Reverse,
+				Name:     t.Name,
+				Filter:   t.Filter,
+				Sort:     t.Sort,
+				Reverse:  t.Reverse,
+				OnEmpty:  t.OnEmpty,
+				Icon:     t.Icon,
+				ViewMode: t.ViewMode,
 			})
 		}
 		allSections = append(allSections, core.TabsToSections(coreTabs)...)
@@ -253,6 +414,17 @@ func NewModel(service core.TaskService, cfg *config.Config) Model {
 	taskList := components.NewTaskList(80, 24, cfg.TUI.Columns, cfg.TUI.NarrowViewFields, styles.ToTaskListStyles())
 	taskList.SetScrollBuffer(cfg.TUI.ScrollBuffer)
 	taskList.SetRelativeDates(cfg.TUI.RelativeDates)
+	taskList.SetDueDisplay(cfg.TUI.DueDisplay)
+	taskList.SetDimFuture(cfg.TUI.DimFuture)
+	taskList.SetCompletedSort(cfg.TUI.CompletedSort)
+	taskList.SetScrollbar(cfg.TUI.Scrollbar)
+	taskList.SetNavWrap(cfg.TUI.NavWrap)
+	taskList.SetProjectDisplay(cfg.TUI.ProjectDisplay)
+	taskList.SetUUIDLength(cfg.TUI.UUIDLength)
+	taskList.SetEllipsis(cfg.TUI.Ellipsis)
+	taskList.SetColumnSeparator(cfg.TUI.ColumnSeparator)
+	taskList.SetColumnAlign(cfg.TUI.ColumnAlign)
+	taskList.SetTagsDisplay(cfg.TUI.TagsDisplay, cfg.TUI.PriorityTags)
 
 	// Determine initial section: Search tab if --search flag provided, otherwise "Next" tab
 	initialSectionIndex := 1 // Default to "Next" tab (index 1)
@@ -285,10 +457,14 @@ func NewModel(service core.TaskService, cfg *config.Config) Model {
 					Description: cmd.Description,
 				}
 
-				// Check if custom command overrides an internal shortcut
+				// Check if custom command replaces an internal shortcut, or never runs
+				// because its key quits
 				if internalAction, exists := internalShortcuts[key]; exists {
-					warning := fmt.Sprintf("Custom command '%s' (key: %s) overrides internal shortcut for '%s'",
+					warning := fmt.Sprintf("Custom command '%s' (key: %s) replaces the internal shortcut for '%s'",
 						cmd.Name, key, internalAction)
+					if key == cfg.TUI.Keybindings["quit"] {
+						warning = fmt.Sprintf("Custom command '%s' (key: %s) never runs: the key quits wui", cmd.Name, key)
+					}
 					shortcutWarnings = append(shortcutWarnings, warning)
 				}
 			}
@@ -303,8 +479,19 @@ func NewModel(service core.TaskService, cfg *config.Config) Model {
 		helpComponent = components.NewHelp(80, 24, components.DefaultHelpStyles())
 	}
 
+	// Show the task commands run by the service in the message history
+	commandLog := startCommandLog(service, cfg.TUI.ShowCommands)
+
+	// Reuse recent exports when a cache TTL is configured
+	var taskCache *cachedService
+	if cfg.TUI.CacheTTLSeconds > 0 {
+		taskCache = newCachedService(service, time.Duration(cfg.TUI.CacheTTLSeconds)*time.Second)
+		service = taskCache
+	}
+
 	m := Model{
 		service:          service,
+		taskCache:        taskCache,
 		config:           cfg,
 		styles:           styles,
 		tasks:            []core.Task{},
@@ -319,6 +506,7 @@ func NewModel(service core.TaskService, cfg *config.Config) Model {
 		statusMessage:    "",
 		errorMessage:     "",
 		taskList:         taskList,
+		projectPane:      components.NewTaskList(30, 24, cfg.TUI.Columns, cfg.TUI.NarrowViewFields, styles.ToTaskListStyles()),
 		sidebar:          components.NewSidebar(40, 24, styles.ToSidebarStyles()), // Initial size, will be updated
 		filter:           components.NewFilter(),
 		modifyInput:      components.NewFilter(),
@@ -327,12 +515,45 @@ func NewModel(service core.TaskService, cfg *config.Config) Model {
 		sections:         components.NewSectionsWithIndex(allSections, 80, styles.ToSectionsStyles(), initialSectionIndex), // Initial size, will be updated
 		help:             helpComponent,                                                                                    // Initial size, will be updated
 		confirmAction:    "",
+		noConfirm:        cfg.TUI.NoConfirm,
+		viewCycle:        resolveViewCycle(cfg.TUI.ViewCycle),
+		escBehavior:      resolveEscBehavior(cfg.TUI.EscBehavior),
+		homeWidgets:      resolveHomeWidgets(cfg.TUI.HomeWidgets),
+		onEmptyPending:   true,
 		shortcutWarnings: shortcutWarnings,
+		commandLog:       commandLog,
+		clipboard:        newClipboard(cfg.TUI.Clipboard, cfg.TUI.ClipboardCommand),
+	}
+
+	m.projectPane.SetScrollbar(cfg.TUI.Scrollbar)
+	m.projectPane.SetNavWrap(cfg.TUI.NavWrap)
+	m.projectPane.SetProjectDisplay(cfg.TUI.ProjectDisplay)
+	m.projectPane.SetUUIDLength(cfg.TUI.UUIDLength)
+	m.projectPane.SetEllipsis(cfg.TUI.Ellipsis)
+	m.projectPane.SetDueDisplay(cfg.TUI.DueDisplay)
+	m.projectPane.SetTagsDisplay(cfg.TUI.TagsDisplay, cfg.TUI.PriorityTags)
+	m.sidebar.SetScrollbar(cfg.TUI.Scrollbar)
+	m.sidebar.SetProjectDisplay(cfg.TUI.ProjectDisplay)
+	m.sidebar.SetEllipsis(cfg.TUI.Ellipsis)
+	m.sidebar.SetRelativePrecision(cfg.TUI.RelativePrecision)
+	m.sidebar.SetLabels(cfg.TUI.SidebarLabels)
+	m.sections.SetMaxVisible(cfg.TUI.MaxVisibleTabs)
+
+	// Reopen the tab and filters of the previous session
+	if cfg.StatePath != "" {
+		m.restoreState(cfg.StatePath)
+	}
+
+	// Apply the startup filter, so that the first load already uses it
+	if action, filter := m.startupAction(); action == startupFilterPrefix {
+		m.activeFilter = filter
 	}
 
 	// Set custom empty message for Search tab if starting there
-	if initialSectionIndex == 0 {
-		m.taskList.SetEmptyMessage("Search across all tasks\n\nPress / to enter a search filter\n\nExamples:\n  • bug                    - search for 'bug' in all tasks\n  • project:home           - tasks in 'home' project\n  • status:completed       - completed tasks only\n  • +urgent due.before:eom - urgent tasks due before end of month")
+	if m.sections.ActiveIndex == 0 {
+		m.taskList.SetEmptyMessage(m.searchEmptyMessage())
+	} else {
+		m.taskList.SetEmptyMessage(m.text(msgEmptyTasks))
 	}
 
 	return m
@@ -350,15 +571,36 @@ func (m Model) Init() tea.Cmd {
 		filterToUse = m.searchTabFilter
 	}
 
-	// Load both tasks and autocomplete data in parallel
+	// Load both tasks and autocomplete data in parallel, then run the startup action
 	return tea.Batch(
-		loadTasksCmd(m.service, filterToUse, isSearchTab),
+		loadTasksCmd(m.service, filterToUse, isSearchTab, m.searchAnnotations()),
 		loadAllProjectsAndTagsCmd(m.service),
+		loadActiveContextCmd(m.service),
+		waitForCommand(m.commandLog),
+		m.startupActionCmd(),
 	)
 }
 
-// Update handles messages and updates the model
+// Update handles messages and updates the model, recording new status and
+// error messages in the message history
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	prevStatus, prevError := m.statusMessage, m.errorMessage
+	updated, cmd := m.update(msg)
+	if model, ok := updated.(Model); ok {
+		model.recordMessages(prevStatus, prevError)
+		return model, cmd
+	}
+	return updated, cmd
+}
+
+// update handles messages and updates the model
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Tasks may have been changed outside the service (task shell, custom commands, syncs)
+	switch msg.(type) {
+	case TaskModifiedMsg, RefreshMsg, CommandOutputMsg, TaskSyncCompletedMsg, CalendarSyncCompletedMsg:
+		m.taskCache.Invalidate()
+	}
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -368,19 +610,37 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateComponentSizes()
 		return m, nil
 
+	case startupActionMsg:
+		return m.runStartupAction(msg)
+
 	case components.SectionChangedMsg:
 		// Section changed - load tasks with new filter
 		m.currentSection = &msg.Section
 		m.errorMessage = ""
 		m.statusMessage = ""
 		m.isLoading = true
+		m.onEmptyPending = true
+		m.groupOrigin = 0
+		m.focusUUID = ""
 
 		// Reset grouping state when switching sections
 		m.selectedGroup = nil
 		m.groups = []core.TaskGroup{}
 
+		// The two-pane view only applies to the Projects tab
+		if m.viewMode == ViewModeProjectPanes && !m.sections.IsProjectsView() {
+			m.viewMode = ViewModeList
+			m.updateComponentSizes()
+		}
+
+		// Tabs can open or close the sidebar
+		m.applyTabViewMode(msg.Section)
+		m.updateComponentSizes()
+
 		// Determine if we should show groups
-		if m.sections.IsProjectsView() || m.sections.IsTagsView() {
+		if m.viewMode == ViewModeProjectPanes {
+			m.inGroupView = false
+		} else if m.sections.IsProjectsView() || m.sections.IsTagsView() {
 			m.inGroupView = true
 		} else {
 			m.inGroupView = false
@@ -388,22 +648,24 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Set custom empty message for Search tab
 		isSearchTab := msg.Section.Name == "Search"
+		// Restore the last filter of the tab, or use the section's default filter
+		m.activeFilter = m.tabFilter(msg.Section)
 		if isSearchTab {
-			// Restore the saved Search tab filter (if any)
-			m.activeFilter = m.searchTabFilter
-			m.taskList.SetEmptyMessage("Search across all tasks\n\nPress / to enter a search filter\n\nExamples:\n  • bug                    - search for 'bug' in all tasks\n  • project:home           - tasks in 'home' project\n  • status:completed       - completed tasks only\n  • +urgent due.before:eom - urgent tasks due before end of month")
+			m.taskList.SetEmptyMessage(m.searchEmptyMessage())
 		} else {
-			// Use the section's default filter
-			m.activeFilter = msg.Section.Filter
-			m.taskList.SetEmptyMessage("") // Reset to default message
+			m.taskList.SetEmptyMessage(m.text(msgEmptyTasks)) // Reset to default message
 		}
 
-		return m, loadTasksCmd(m.service, m.activeFilter, isSearchTab)
+		loadCmd := loadTasksCmd(m.service, m.activeFilter, isSearchTab, m.searchAnnotations())
+		if isSearchTab {
+			return m, tea.Batch(loadCmd, m.focusSearchFilter())
+		}
+		return m, loadCmd
 
 	case TasksLoadedMsg:
 		m.isLoading = false
 		if msg.Err != nil {
-			m.errorMessage = "Failed to load tasks: " + msg.Err.Error()
+			m.errorMessage = m.text(msgErrLoadTasks, msg.Err.Error())
 			// If error was from filter, reopen filter input
 			if m.state == StateNormal && m.activeFilter != "" {
 				m.state = StateFilterInput
@@ -428,12 +690,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// We'll build groups when summaries arrive
 				m.isLoading = true
 				depCmd := loadMissingDepTasksCmd(m.service, m.tasks)
-				return m, tea.Batch(loadProjectSummaryCmd(m.service), depCmd)
+				return m, tea.Batch(loadProjectSummaryCmd(m.service), depCmd, m.prefetchNeighborsCmd())
 			} else if m.sections.IsTagsView() {
 				m.groups = core.GroupByTag(m.tasks)
 				m.taskList.SetGroupTitle("TAG")
 				m.taskList.SetGroups(m.groups)
 			}
+		} else if m.viewMode == ViewModeProjectPanes {
+			m.refreshProjectPanes()
+		} else if m.isHomeView() {
+			// The dashboard replaces the list: leave it empty so that task actions
+			// do not apply to tasks that are not shown
+			m.taskList.SetTasks(nil)
 		} else {
 			// Normal view or drilling into a group
 			// Update task list component with actual tasks
@@ -443,19 +711,48 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				sortMethod = m.currentSection.Sort
 				reverse = m.currentSection.Reverse
 			}
-			m.taskList.SetTasksWithSort(m.tasks, sortMethod, reverse)
+			// Keep the focus while the focused task is still loaded
+			if m.focusedTask() == nil {
+				m.focusUUID = ""
+			}
+			m.taskList.SetTasksWithSort(m.listedTasks(), sortMethod, reverse)
+
+			// Keep the fuzzy finder results on a refresh
+			if m.state == StateFuzzyFind {
+				m.applyFuzzyFind()
+			}
 		}
 
 		// Update task count in sections component
 		m.sections.SetTaskCount(len(m.tasks))
 
-		// Update sidebar with selected task (only if not in group view)
-		if !m.inGroupView {
-			m.updateSidebar()
+		// Update sidebar with the selected task or group
+		m.updateSidebar()
+
+		// The Home tab also needs the data of its widgets
+		var homeCmd tea.Cmd
+		if m.isHomeView() {
+			homeCmd = loadHomeCmd(m.service, m.sections.Items, m.homeWidgets)
 		}
 
-		// Load dependency tasks that aren't in the current task list
-		return m, loadMissingDepTasksCmd(m.service, m.tasks)
+		// Load dependency tasks that aren't in the current task list, and the
+		// tasks of the neighbor tabs into the cache
+		return m, tea.Batch(loadMissingDepTasksCmd(m.service, m.tasks), m.applyOnEmpty(), homeCmd, m.prefetchNeighborsCmd())
+
+	case CommandRunMsg:
+		return m, m.showCommand(msg)
+
+	case ActiveContextMsg:
+		if msg.Err == nil {
+			m.activeContext = msg.Name
+		}
+		return m, nil
+
+	case ContextsLoadedMsg:
+		return m.openContextPicker(msg)
+
+	case ContextChangedMsg:
+		return m.contextChanged(msg)
 
 	case DepTasksLoadedMsg:
 		if msg.Err == nil && len(msg.Tasks) > 0 {
@@ -465,13 +762,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			merged = append(merged, m.tasks...)
 			merged = append(merged, m.depTasks...)
 			m.sidebar.SetAllTasks(merged)
+
+			// The dependency chain of the focused task may reach the new tasks
+			if m.focusUUID != "" {
+				m.resortTasks()
+			}
 		}
 		return m, nil
 
 	case ProjectSummaryLoadedMsg:
 		m.isLoading = false
 		if msg.Err != nil {
-			m.errorMessage = "Failed to load project summary: " + msg.Err.Error()
+			m.errorMessage = m.text(msgErrLoadProjectSummary, msg.Err.Error())
 			return m, nil
 		}
 
@@ -482,22 +784,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		m.taskList.SetGroupTitle("PROJECT")
 		m.taskList.SetGroups(m.groups)
+		m.updateSidebar()
 
 		return m, nil
 
 	case TaskModifiedMsg:
 		if msg.Err != nil {
-			m.errorMessage = "Task operation failed: " + msg.Err.Error()
+			m.errorMessage = m.text(msgErrTaskOperation, msg.Err.Error())
 			m.isLoading = false
 			return m, nil
 		}
 		m.errorMessage = "" // Clear any previous error
-		m.statusMessage = "Task updated successfully"
+		m.statusMessage = m.text(msgTaskUpdated)
 		m.isLoading = true
 		// Refresh tasks and autocomplete data
 		isSearchTab := m.currentSection != nil && m.currentSection.Name == "Search"
 		return m, tea.Batch(
-			loadTasksCmd(m.service, m.activeFilter, isSearchTab),
+			loadTasksCmd(m.service, m.activeFilter, isSearchTab, m.searchAnnotations()),
 			loadAllProjectsAndTagsCmd(m.service),
 		)
 
@@ -507,7 +810,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case RefreshMsg:
 		isSearchTab := m.currentSection != nil && m.currentSection.Name == "Search"
-		return m, loadTasksCmd(m.service, m.activeFilter, isSearchTab)
+		return m, loadTasksCmd(m.service, m.activeFilter, isSearchTab, m.searchAnnotations())
 
 	case StatusMsg:
 		if msg.IsError {
@@ -517,20 +820,68 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case CommandOutputMsg:
+		m.showCommandOutput(msg)
+		return m, nil
+
+	case HomeLoadedMsg:
+		if msg.Err != nil {
+			m.errorMessage = m.text(msgErrLoadTasks, msg.Err.Error())
+			return m, nil
+		}
+		m.homeTabCounts = msg.TabCounts
+		m.homeCompleted = msg.Completed
+		return m, nil
+
+	case GlobalSearchResultsMsg:
+		m.showGlobalSearchResults(msg)
+		return m, nil
+
+	case UndoPreviewMsg:
+		return m.showUndoConfirm(msg)
+
+	case TaskSyncCompletedMsg:
+		m.isLoading = false
+		if msg.Err != nil {
+			m.statusMessage = ""
+			m.errorMessage = m.text(msgErrTaskSync, msg.Err.Error())
+			return m, nil
+		}
+		m.errorMessage = ""
+		m.statusMessage = m.text(msgTasksSynced)
+		m.isLoading = true
+		// Refresh tasks and autocomplete data with the synced changes
+		isSearchTab := m.currentSection != nil && m.currentSection.Name == "Search"
+		return m, tea.Batch(
+			loadTasksCmd(m.service, m.activeFilter, isSearchTab, m.searchAnnotations()),
+			loadAllProjectsAndTagsCmd(m.service),
+		)
+
+	case CalendarSyncProgressMsg:
+		// The last progress can arrive after the sync completed; its channel is gone
+		if m.calendarSyncProgress == nil {
+			return m, nil
+		}
+		m.statusMessage = m.text(msgCalendarSyncProgress, msg.Current, msg.Total)
+		return m, waitForCalendarSyncProgress(m.calendarSyncProgress)
+
 	case CalendarSyncCompletedMsg:
 		m.isLoading = false
-		m.syncingBeforeQuit = false
+		m.calendarSyncProgress = nil
+		quitAfterSync := m.syncingBeforeQuit
 		if msg.Err != nil {
 			if errors.Is(msg.Err, calendar.ErrNoToken) {
 				// No token on disk — start the browser-based auth flow
 				oauthConfig, err := calendar.LoadOAuthConfig(m.config.CalendarSync.CredentialsPath)
 				if err != nil {
-					m.errorMessage = "Calendar auth setup failed: " + err.Error()
+					m.syncingBeforeQuit = false
+					m.errorMessage = m.text(msgErrCalendarAuthSetup, err.Error())
 					return m, nil
 				}
 				authServer, err := calendar.StartAuthServer(oauthConfig)
 				if err != nil {
-					m.errorMessage = "Calendar auth setup failed: " + err.Error()
+					m.syncingBeforeQuit = false
+					m.errorMessage = m.text(msgErrCalendarAuthSetup, err.Error())
 					return m, nil
 				}
 				m.calendarAuthServer = authServer
@@ -547,26 +898,31 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.state = StateTokenExpired
 				return m, nil
 			}
-			m.errorMessage = "Calendar sync failed: " + msg.Err.Error()
+			m.syncingBeforeQuit = false
+			m.errorMessage = m.text(msgErrCalendarSync, msg.Err.Error())
 			// Don't quit on sync error, let user see the error
 			return m, nil
 		}
+		m.syncingBeforeQuit = false
 
 		// Build status message with result details
 		if msg.Result != nil {
-			m.statusMessage = fmt.Sprintf("Calendar synced: %d created, %d updated", msg.Result.Created, msg.Result.Updated)
+			m.statusMessage = m.text(msgCalendarSyncedSummary, msg.Result.Created, msg.Result.Updated)
 			if len(msg.Result.Warnings) > 0 {
-				m.statusMessage += fmt.Sprintf(", %d warnings - see output after quit", len(msg.Result.Warnings))
+				m.statusMessage += m.text(msgCalendarSyncWarnings, len(msg.Result.Warnings))
 			}
 		} else {
-			m.statusMessage = "Calendar synced successfully"
+			m.statusMessage = m.text(msgCalendarSynced)
 		}
 
 		// Store warnings to print after quit
 		m.syncWarnings = msg.Result
 
-		// Quit after successful sync
-		return m, tea.Quit
+		// Quit after a successful sync on quit, stay after a sync started from the TUI
+		if quitAfterSync {
+			return m, tea.Quit
+		}
+		return m, nil
 
 	case CalendarAuthRequiredMsg:
 		// Auth server was started elsewhere; wire it up.
@@ -585,13 +941,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.calendarAuthURL = ""
 		m.state = StateNormal
 		if msg.Err != nil {
-			m.errorMessage = "Calendar authorization failed: " + msg.Err.Error()
+			m.syncingBeforeQuit = false
+			m.errorMessage = m.text(msgErrCalendarAuthorization, msg.Err.Error())
 			return m, nil
 		}
 		// Token saved — retry the sync
-		m.isLoading = true
-		m.statusMessage = "Authorized! Syncing calendar..."
-		return m, calendarSyncCmd(m.config, m.service)
+		m.statusMessage = m.text(msgCalendarAuthorized)
+		return m, m.startCalendarSync()
 
 	case AutocompleteDataLoadedMsg:
 		if msg.Err != nil {
@@ -616,9 +972,23 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Global keys (work in any state)
 	switch msg.String() {
 	case "ctrl+c":
+		if m.state == StateConfirm {
+			return m.cancelPendingConfirm()
+		}
 		return m, tea.Quit
 	}
 
+	// Any key closes the peek popup
+	if m.peekActive {
+		m.peekActive = false
+		return m, nil
+	}
+
+	// If due date picker is active, handle its input
+	if m.state == StateDatePicker {
+		return m.handleDatePickerKeys(msg)
+	}
+
 	// If calendar is active, handle calendar input
 	if m.calendarActive {
 		var cmd tea.Cmd
@@ -627,13 +997,20 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case "enter":
 			// Select date and insert into input
 			selectedDate := m.calendar.GetSelectedDate()
-			m.insertDateFromCalendar(selectedDate)
 			m.deactivateCalendar()
+			if m.recurringTask != nil {
+				// Last step of the new recurring task flow
+				return m.finishRecurringTask(selectedDate)
+			}
+			m.insertDateFromCalendar(selectedDate)
 			return m, nil
 
 		case "esc":
 			// Cancel calendar
 			m.deactivateCalendar()
+			if m.recurringTask != nil {
+				m.cancelRecurringTask()
+			}
 			return m, nil
 
 		default:
@@ -691,6 +1068,46 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// If due presets menu is active, handle its input
+	if m.duePresetPickerActive {
+		return m.handleDuePresetPickerKeys(msg)
+	}
+
+	// If recurrence scope menu is active, handle its input
+	if m.recurrenceScopePickerActive {
+		return m.handleRecurrenceScopePickerKeys(msg)
+	}
+
+	// If assign-to-project picker is active, handle its input
+	if m.projectPickerActive {
+		return m.handleProjectPickerKeys(msg)
+	}
+
+	// If new task template picker is active, handle its input
+	if m.templatePickerActive {
+		return m.handleTemplatePickerKeys(msg)
+	}
+
+	// If recurrence period picker is active, handle its input
+	if m.recurrencePickerActive {
+		return m.handleRecurrencePickerKeys(msg)
+	}
+
+	// If hidden tabs picker is active, handle its input
+	if m.tabPickerActive {
+		return m.handleTabPickerKeys(msg)
+	}
+
+	// If context picker is active, handle its input
+	if m.contextPickerActive {
+		return m.handleContextPickerKeys(msg)
+	}
+
+	// If search results are shown, handle their input
+	if m.globalSearchActive {
+		return m.handleGlobalSearchKeys(msg)
+	}
+
 	// If resource picker is active, handle resource picker input
 	if m.resourcePickerActive {
 		var cmd tea.Cmd
@@ -705,9 +1122,9 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					m.deactivateResourcePicker()
 					// Use appropriate opener based on resource type
 					if resource.Type == ResourceTypeURL {
-						return m, openURLCmd(resource.Resource)
+						return m, openURLCmd(m.language(), resource.Resource)
 					}
-					return m, openFileCmd(resource.Resource)
+					return m, openFileCmd(m.language(), resource.Resource)
 				}
 			}
 			m.deactivateResourcePicker()
@@ -733,8 +1150,12 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleFilterKeys(msg)
 	case StateHelp:
 		return m.handleHelpKeys(msg)
+	case StateMessages:
+		return m.handleMessagesKeys(msg)
 	case StateConfirm:
 		return m.handleConfirmKeys(msg)
+	case StateFuzzyFind:
+		return m.handleFuzzyFindKeys(msg)
 	case StateModifyInput:
 		return m.handleModifyKeys(msg)
 	case StateAnnotateInput:
@@ -887,7 +1308,7 @@ func detectTagFieldContext(input string, cursorPos int) (string, int, bool) {
 
 // activateCalendar activates the calendar picker for date selection
 func (m *Model) activateCalendar(fieldType string, insertPos int, inputState AppState) {
-	m.calendar = components.NewCalendar(time.Now())
+	m.calendar = components.NewCalendar(core.Now())
 	m.calendarActive = true
 	m.calendarFieldType = fieldType
 	m.calendarInsertPos = insertPos
@@ -1118,7 +1539,7 @@ func isTermux() bool {
 
 // openURLCmd creates a command to open a URL in the default browser
 // Works on Android/Termux, Linux, macOS, and Windows
-func openURLCmd(url string) tea.Cmd {
+func openURLCmd(language string, url string) tea.Cmd {
 	return func() tea.Msg {
 		var cmd *exec.Cmd
 		var useRun bool // Use Run() instead of Start() to capture errors
@@ -1142,7 +1563,7 @@ func openURLCmd(url string) tea.Cmd {
 			cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
 		default:
 			return StatusMsg{
-				Message: fmt.Sprintf("Unsupported platform: %s", runtime.GOOS),
+				Message: translate(language, msgErrUnsupportedPlatform, runtime.GOOS),
 				IsError: true,
 			}
 		}
@@ -1166,13 +1587,13 @@ func openURLCmd(url string) tea.Cmd {
 
 		if err != nil {
 			return StatusMsg{
-				Message: fmt.Sprintf("Failed to open URL: %s", err.Error()),
+				Message: translate(language, msgErrOpenURL, err.Error()),
 				IsError: true,
 			}
 		}
 
 		return StatusMsg{
-			Message: "Opening URL in browser...",
+			Message: translate(language, msgOpeningURL),
 			IsError: false,
 		}
 	}
@@ -1180,12 +1601,12 @@ func openURLCmd(url string) tea.Cmd {
 
 // openFileCmd creates a command to open a file with the default application
 // Works on Android/Termux, Linux, macOS, and Windows
-func openFileCmd(path string) tea.Cmd {
+func openFileCmd(language string, path string) tea.Cmd {
 	return func() tea.Msg {
 		// Check if file exists
 		if _, err := os.Stat(path); os.IsNotExist(err) {
 			return StatusMsg{
-				Message: fmt.Sprintf("File not found: %s", path),
+				Message: translate(language, msgErrFileNotFound, path),
 				IsError: true,
 			}
 		}
@@ -1212,7 +1633,7 @@ func openFileCmd(path string) tea.Cmd {
 			cmd = exec.Command("cmd", "/c", "start", "", path)
 		default:
 			return StatusMsg{
-				Message: fmt.Sprintf("Unsupported platform: %s", runtime.GOOS),
+				Message: translate(language, msgErrUnsupportedPlatform, runtime.GOOS),
 				IsError: true,
 			}
 		}
@@ -1236,23 +1657,58 @@ func openFileCmd(path string) tea.Cmd {
 
 		if err != nil {
 			return StatusMsg{
-				Message: fmt.Sprintf("Failed to open file: %s", err.Error()),
+				Message: translate(language, msgErrOpenFile, err.Error()),
 				IsError: true,
 			}
 		}
 
 		return StatusMsg{
-			Message: "Opening file...",
+			Message: translate(language, msgOpeningFile),
 			IsError: false,
 		}
 	}
 }
 
+// quit exits the application, syncing the calendar first when auto-sync on quit is enabled
+func (m Model) quit() (tea.Model, tea.Cmd) {
+	if m.config.CalendarSync != nil &&
+		m.config.CalendarSync.Enabled &&
+		m.config.CalendarSync.AutoSyncOnQuit &&
+		!m.syncingBeforeQuit {
+		// Trigger calendar sync before quitting
+		m.syncingBeforeQuit = true
+		m.statusMessage = m.text(msgCalendarSyncingBeforeQuit)
+		if m.calendarSyncProgress != nil {
+			// A sync is already running: quit when it completes instead of
+			// starting a second one next to it
+			return m, nil
+		}
+		return m, m.startCalendarSync()
+	}
+	return m, tea.Quit
+}
+
 // handleNormalKeys handles keys in normal state
 func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	keyPressed := msg.String()
 
+	// A quit warning only holds for the next key
+	quitWarned := m.quitWarned
+	m.quitWarned = false
+
+	// While jump labels are shown, keys spell a label
+	if m.taskList.JumpActive() {
+		m.taskList, cmd = m.taskList.Update(msg)
+		m.updateSidebar()
+		return m, cmd
+	}
+
+	// Wait for the second key of a chord binding such as "gg"
+	if keyPressed = m.chordKey(keyPressed); keyPressed == "" {
+		return m, nil
+	}
+
 	// In task detail view, handle detail-specific keys
 	if m.viewMode == ViewModeTaskDetail {
 		switch keyPressed {
@@ -1286,18 +1742,19 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	// Check configured keybindings
 	if m.keyMatches(keyPressed, "quit") {
-		// Check if auto-sync before quit is enabled
-		if m.config.CalendarSync != nil &&
-			m.config.CalendarSync.Enabled &&
-			m.config.CalendarSync.AutoSyncOnQuit &&
-			!m.syncingBeforeQuit {
-			// Trigger calendar sync before quitting
-			m.syncingBeforeQuit = true
-			m.isLoading = true
-			m.statusMessage = "Syncing calendar before quit..."
-			return m, calendarSyncCmd(m.config, m.service)
+		return m.requestQuit(quitWarned)
+	}
+
+	// Custom commands (user-configured in config) replace the built-in action of
+	// their key, except quit. They do not apply to the group view.
+	if m.config.TUI != nil && m.config.TUI.CustomCommands != nil && !m.inGroupView {
+		if customCmd, exists := m.config.TUI.CustomCommands[keyPressed]; exists {
+			if tasks := m.taskList.GetSelectedTasks(); len(tasks) > 0 {
+				return m, executeCustomCommand(m.language(), customCmd, tasks)
+			}
+			m.statusMessage = m.text(msgNoTaskSelected)
+			return m, nil
 		}
-		return m, tea.Quit
 	}
 
 	if m.keyMatches(keyPressed, "help") {
@@ -1305,6 +1762,12 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if m.keyMatches(keyPressed, "messages") {
+		m.state = StateMessages
+		m.messagesOffset = 0
+		return m, nil
+	}
+
 	// Space key for multi-select (not configurable)
 	if keyPressed == " " {
 		// Toggle selection on current task
@@ -1328,7 +1791,7 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	// Escape key for clearing selections/going back (not configurable)
+	// Escape key for going back (the order of the fallbacks is configurable)
 	if keyPressed == "esc" {
 		// If in small screen task detail view, go back to task list
 		if m.viewMode == ViewModeSmallTaskDetail {
@@ -1342,31 +1805,95 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.updateComponentSizes()
 			return m, nil
 		}
-		// Clear selections if any exist
-		if m.taskList.HasSelections() {
-			m.taskList.ClearSelection()
-			return m, nil
+		// Clear selections, close the sidebar, etc. as configured in tui.esc_behavior
+		return m.handleEsc()
+	}
+
+	if m.keyMatches(keyPressed, "project_panes") {
+		return m.toggleProjectPanes()
+	}
+
+	if m.keyMatches(keyPressed, "cycle_view") {
+		return m.cycleViewMode()
+	}
+
+	if m.keyMatches(keyPressed, "peek") {
+		m.peekActive = m.taskList.SelectedTask() != nil
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "toggle_confirm") {
+		m.noConfirm = !m.noConfirm
+		if m.noConfirm {
+			m.statusMessage = m.text(msgConfirmationsOff)
+		} else {
+			m.statusMessage = m.text(msgConfirmationsOn)
 		}
 		return m, nil
 	}
 
+	if m.keyMatches(keyPressed, "toggle_uuids") {
+		m.taskList.ToggleLongUUIDs()
+		m.projectPane.ToggleLongUUIDs()
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "toggle_completed_last") {
+		return m.toggleCompletedLast()
+	}
+
+	if m.keyMatches(keyPressed, "sort_column") {
+		return m.cycleSortKey()
+	}
+
+	if m.keyMatches(keyPressed, "sort_reverse") {
+		return m.toggleSortDirection()
+	}
+
+	// In the two-pane Projects view, h/l/tab move focus between panes before moving between sections
+	if m.viewMode == ViewModeProjectPanes {
+		if handled, model, cmd := m.handleProjectPaneKeys(keyPressed, msg); handled {
+			return model, cmd
+		}
+	}
+
+	if m.keyMatches(keyPressed, "global_search") {
+		// Search all tasks without leaving the current tab
+		return m.startGlobalSearch()
+	}
+
 	if m.keyMatches(keyPressed, "filter") {
 		// Activate filter input
 		m.state = StateFilterInput
-		// Add trailing space to make it easier to extend the filter
-		filterValue := m.activeFilter
-		if filterValue != "" {
-			filterValue += " "
-		}
-		m.filter.SetValue(filterValue)
+		m.filter.SetValue(initialFilterValue(m.config.TUI.FilterEditMode, m.activeFilter))
 		m.updateComponentSizes()
 		return m, m.filter.Focus()
 	}
 
 	if m.keyMatches(keyPressed, "refresh") {
 		m.isLoading = true
+		m.taskCache.Invalidate()
 		isSearchTab := m.currentSection != nil && m.currentSection.Name == "Search"
-		return m, loadTasksCmd(m.service, m.activeFilter, isSearchTab)
+		return m, loadTasksCmd(m.service, m.activeFilter, isSearchTab, m.searchAnnotations())
+	}
+
+	if m.keyMatches(keyPressed, "task_sync") {
+		m.isLoading = true
+		m.errorMessage = ""
+		m.statusMessage = m.text(msgSyncingTasks)
+		return m, taskSyncCmd(m.service)
+	}
+
+	if m.keyMatches(keyPressed, "calendar_sync") {
+		return m.syncCalendarNow()
+	}
+
+	if m.keyMatches(keyPressed, "copy_filter") {
+		return m.copyFilter()
+	}
+
+	if m.keyMatches(keyPressed, "copy_status") {
+		return m.copyStatus()
 	}
 
 	// Enter key for sidebar toggle/group drill-down (not configurable)
@@ -1375,26 +1902,10 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.inGroupView && len(m.groups) > 0 {
 			selectedIndex := m.taskList.Cursor()
 			if selectedIndex >= 0 && selectedIndex < len(m.groups) {
-				group := m.groups[selectedIndex]
-				var searchFilter string
-				if m.sections.IsProjectsView() {
-					searchFilter = "project:" + group.Name
-				} else if m.sections.IsTagsView() {
-					searchFilter = "+" + group.Name
-				}
+				searchFilter := m.groupFilter(m.groups[selectedIndex])
 				if searchFilter != "" && len(m.sections.Items) > 0 {
-					m.sections.ActiveIndex = 0
-					searchSection := m.sections.Items[0]
-					m.currentSection = &searchSection
-					m.searchTabFilter = searchFilter
-					m.activeFilter = searchFilter
-					m.inGroupView = false
-					m.selectedGroup = nil
-					m.groups = []core.TaskGroup{}
-					m.isLoading = true
-					m.errorMessage = ""
-					m.statusMessage = ""
-					return m, loadTasksCmd(m.service, searchFilter, true)
+					m.groupOrigin = m.sections.ActiveIndex
+					return m.openSearchTab(searchFilter)
 				}
 			}
 			return m, nil
@@ -1418,51 +1929,40 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 
 	if m.keyMatches(keyPressed, "done") {
+		if m.inGroupView {
+			// Mark all the tasks of the highlighted group done
+			return m.startGroupAction(confirmGroupDone)
+		}
+
 		// Mark task(s) done
 		selectedTasks := m.taskList.GetSelectedTasks()
 		if len(selectedTasks) > 0 {
-			var todos []string
-			var blocking []string
-
-			// Check for blocking tasks if validation is enabled
-			if m.hasBlockedValidationEnabled() {
-				blocking = m.findBlockingTasks(selectedTasks)
-			}
-
-			// Check for outstanding TODOs if validation is enabled
-			if m.hasTodoValidationEnabled() {
-				todos = extractOutstandingTodos(selectedTasks)
-			}
-
-			// If any validation issues, show popup
-			if len(blocking) > 0 || len(todos) > 0 {
-				m.pendingDoneTasks = selectedTasks
-				m.blockingTasks = blocking
-				m.outstandingTodos = todos
-				m.state = StateTaskValidation
+			if m.holdForValidation(selectedTasks) {
 				return m, nil
 			}
-
-			m.taskList.ClearSelection()
-			return m, markTasksDoneCmd(m.service, selectedTasks)
+			return m.completeTasks(selectedTasks)
 		}
 		return m, nil
 	}
 
 	// Start/stop task (not in default config, but 's' is commonly used)
 	if keyPressed == "s" {
-		// Toggle start/stop on task(s)
-		selectedTasks := m.taskList.GetSelectedTasks()
-		if len(selectedTasks) > 0 {
-			m.taskList.ClearSelection()
-			return m, toggleStartStopCmd(m.service, selectedTasks)
-		}
-		return m, nil
+		// Toggle start/stop on task(s) (with confirmation for several tasks)
+		return m.startStartStop()
 	}
 
 	if m.keyMatches(keyPressed, "delete") {
-		// Delete task(s) (with confirmation)
+		if m.inGroupView {
+			// Delete all the tasks of the highlighted group
+			return m.startGroupAction(confirmGroupDelete)
+		}
+
+		// Delete task(s) (with confirmation, unless confirmations are disabled)
 		selectedTasks := m.taskList.GetSelectedTasks()
+		if len(selectedTasks) > 0 && m.noConfirm {
+			m.taskList.ClearSelection()
+			return m, deleteTasksCmd(m.service, selectedTasks)
+		}
 		if len(selectedTasks) > 0 {
 			m.state = StateConfirm
 			m.confirmAction = "delete"
@@ -1471,22 +1971,144 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if m.keyMatches(keyPressed, "archive") {
+		// Archive the completed tasks shown (always with confirmation)
+		return m.startArchive()
+	}
+
+	if m.keyMatches(keyPressed, "reopen") {
+		// Set completed task(s) back to pending (with confirmation, unless confirmations are disabled)
+		if !m.inGroupView {
+			return m.startReopen()
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "duplicate") {
+		// Copy the selected task(s)
+		if !m.inGroupView {
+			return m.duplicateSelected()
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "filter_project") {
+		// Filter the tab to the project of the selected task
+		if !m.inGroupView {
+			return m.filterBySelected(projectFilterToken)
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "filter_tag") {
+		// Filter the tab to the first tag of the selected task
+		if !m.inGroupView {
+			return m.filterBySelected(tagFilterToken)
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "focus_task") {
+		// Focus the list on the selected task and its dependency chain
+		if !m.inGroupView {
+			return m.toggleFocus()
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "context") {
+		// Choose the Taskwarrior context
+		return m, loadContextsCmd(m.service)
+	}
+
+	if m.keyMatches(keyPressed, "due_presets") {
+		// Open the due date presets menu for the selected task(s)
+		if !m.inGroupView {
+			selectedTasks := m.taskList.GetSelectedTasks()
+			if len(selectedTasks) > 0 {
+				m.activateDuePresetPicker(selectedTasks)
+			}
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "set_due") {
+		// Choose the due date of the selected task(s) in the calendar
+		if !m.inGroupView {
+			selectedTasks := m.taskList.GetSelectedTasks()
+			if len(selectedTasks) > 0 {
+				m.activateDatePicker(selectedTasks)
+			}
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "assign_project") {
+		// Open the assign-to-project picker for the selected task(s)
+		if !m.inGroupView {
+			selectedTasks := m.taskList.GetSelectedTasks()
+			if len(selectedTasks) > 0 {
+				m.activateProjectPicker(selectedTasks)
+			}
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "priority_up") || m.keyMatches(keyPressed, "priority_down") {
+		// Cycle the priority of the selected task(s)
+		if !m.inGroupView {
+			return m.cyclePriority(m.keyMatches(keyPressed, "priority_up"))
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "counter_up") || m.keyMatches(keyPressed, "counter_down") {
+		// Adjust the counter UDA of the selected task(s)
+		if !m.inGroupView {
+			step := m.counterStep()
+			if m.keyMatches(keyPressed, "counter_down") {
+				step = -step
+			}
+			return m.adjustCounter(step)
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "clear_due") {
+		// Remove the due date from the selected task(s)
+		if !m.inGroupView {
+			selectedTasks := m.taskList.GetSelectedTasks()
+			if len(selectedTasks) > 0 {
+				m.taskList.ClearSelection()
+				return m, modifyTasksCmd(m.service, selectedTasks, clearAttribute("due"))
+			}
+		}
+		return m, nil
+	}
+
 	if m.keyMatches(keyPressed, "undo") {
-		// Undo last operation
-		return m, undoCmd(m.service)
+		// Undo last operation (with confirmation, unless confirmations are disabled)
+		return m.startUndo()
 	}
 
 	if m.keyMatches(keyPressed, "new") {
-		// New task
-		m.state = StateNewTaskInput
-		m.newTaskInput.SetValue("")
-		m.updateComponentSizes()
-		return m, m.newTaskInput.Focus()
+		// New task, from a template when templates are configured
+		return m.startNewTask()
+	}
+
+	if m.keyMatches(keyPressed, "new_recurring") {
+		// New recurring task, guided through its period and first due date
+		return m.startRecurringTask()
+	}
+
+	if m.keyMatches(keyPressed, "add_subtask") {
+		// New task linked to the current one by a dependency
+		return m.startSubtask()
 	}
 
 	if m.keyMatches(keyPressed, "modify") {
-		// Modify task(s)
-		selectedTasks := m.taskList.GetSelectedTasks()
+		// Modify task(s), or all the tasks of the highlighted group
+		selectedTasks := m.actionTasks()
 		if len(selectedTasks) > 0 {
 			m.state = StateModifyInput
 			m.modifyInput.SetValue("")
@@ -1496,13 +2118,33 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if m.keyMatches(keyPressed, "yank_description") {
+		// Copy the description(s) of the selected task(s)
+		selectedTasks := m.taskList.GetSelectedTasks()
+		if len(selectedTasks) > 0 {
+			m.taskList.ClearSelection()
+			return m, yankDescriptionCmd(m.language(), m.clipboard, selectedTasks)
+		}
+		return m, nil
+	}
+
 	// Export to markdown (not in default config, but 'M' is commonly used)
 	if keyPressed == "M" {
 		// Export task(s) to markdown
 		selectedTasks := m.taskList.GetSelectedTasks()
 		if len(selectedTasks) > 0 {
 			m.taskList.ClearSelection()
-			return m, exportMarkdownCmd(selectedTasks)
+			return m, exportMarkdownCmd(m.language(), m.clipboard, selectedTasks, markdownOptions(m.config.TUI))
+		}
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "export_checklist") {
+		// Export task(s) and their dependencies as a checklist
+		selectedTasks := m.taskList.GetSelectedTasks()
+		if len(selectedTasks) > 0 {
+			m.taskList.ClearSelection()
+			return m, exportChecklistCmd(m.language(), m.clipboard, selectedTasks, m.checklistTasks())
 		}
 		return m, nil
 	}
@@ -1519,6 +2161,11 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if m.keyMatches(keyPressed, "annotate_path") {
+		// Annotate task(s) with the path in the clipboard or the working directory
+		return m.startPathAnnotation()
+	}
+
 	if m.keyMatches(keyPressed, "todo") {
 		// Add TODO annotation to task(s)
 		selectedTasks := m.taskList.GetSelectedTasks()
@@ -1538,36 +2185,54 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			if selectedTask != nil {
 				resources := ExtractResourcesFromAnnotations(selectedTask)
 				if len(resources) == 0 {
-					m.statusMessage = "No URLs or file paths found in task annotations"
+					m.statusMessage = m.text(msgNoResources)
 					return m, nil
 				} else if len(resources) == 1 {
 					// Single resource - open directly
 					resource := resources[0]
 					if resource.Type == ResourceTypeURL {
-						return m, openURLCmd(resource.Resource)
+						return m, openURLCmd(m.language(), resource.Resource)
 					}
-					return m, openFileCmd(resource.Resource)
+					return m, openFileCmd(m.language(), resource.Resource)
 				} else {
 					// Multiple resources - show picker
 					m.activateResourcePicker(resources)
 					return m, nil
 				}
 			} else {
-				m.statusMessage = "No task selected"
+				m.statusMessage = m.text(msgNoTaskSelected)
 			}
 		}
 		return m, nil
 	}
 
+	if m.keyMatches(keyPressed, "task_shell") {
+		// Run the task shell (suspend TUI), refreshing afterwards
+		return m, taskShellCmd(m.config.TUI.TaskShell, m.config.TaskrcPath)
+	}
+
 	if m.keyMatches(keyPressed, "edit") {
 		// Edit task (suspend TUI)
 		selectedTask := m.taskList.SelectedTask()
 		if selectedTask != nil {
-			return m, editTaskCmd(m.config.TaskBin, m.config.TaskrcPath, selectedTask.UUID)
+			return m, editTaskCmd(m.config.TaskBin, m.config.TaskrcPath, selectedTask.UUID, m.config.TUI.CaptureEditOutput)
 		}
 		return m, nil
 	}
 
+	if m.config.TUI.JumpLabels && m.keyMatches(keyPressed, "jump") {
+		m.taskList.StartJump()
+		return m, nil
+	}
+
+	if m.keyMatches(keyPressed, "fuzzy_find") {
+		return m.startFuzzyFind()
+	}
+
+	if m.keyMatches(keyPressed, "more_tabs") {
+		return m.openTabPicker()
+	}
+
 	// Section navigation (not configurable - uses tab, h/l, arrows, and number keys)
 	if keyPressed == "tab" || keyPressed == "shift+tab" || keyPressed == "h" || keyPressed == "l" ||
 		keyPressed == "left" || keyPressed == "right" {
@@ -1603,35 +2268,21 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, cmd
 	}
 
-	// Check for custom commands (user-configured in config)
-	if m.config.TUI != nil && m.config.TUI.CustomCommands != nil {
-		if customCmd, exists := m.config.TUI.CustomCommands[keyPressed]; exists {
-			// Skip if in group view
-			if !m.inGroupView {
-				selectedTask := m.taskList.SelectedTask()
-				if selectedTask != nil {
-					return m, executeCustomCommand(customCmd, selectedTask)
-				} else {
-					m.statusMessage = "No task selected"
-				}
-			}
-			return m, nil
-		}
-	}
-
-	// Navigation keys - check both configured keys and arrow keys
+	// Navigation keys - check both configured keys and arrow keys.
+	// Page keys scroll the task details instead while they are shown.
+	inTaskDetail := m.viewMode == ViewModeTaskDetail || m.viewMode == ViewModeSmallTaskDetail
 	if m.keyMatches(keyPressed, "up") || m.keyMatches(keyPressed, "down") ||
 		m.keyMatches(keyPressed, "first") || m.keyMatches(keyPressed, "last") ||
-		m.keyMatches(keyPressed, "page_up") || m.keyMatches(keyPressed, "page_down") ||
-		keyPressed == "up" || keyPressed == "down" {
+		keyPressed == "up" || keyPressed == "down" ||
+		(m.isPageKey(keyPressed) && !inTaskDetail) {
 		// Delegate navigation to task list component
-		m.taskList, cmd = m.taskList.Update(msg)
+		m.taskList = m.navigateList(m.taskList, keyPressed, msg)
 		m.updateSidebar()
-		return m, cmd
+		return m, nil
 	}
 
-	// If sidebar is visible (task detail view), check for sidebar scrolling keys (not configurable)
-	if m.viewMode == ViewModeTaskDetail || m.viewMode == ViewModeSmallTaskDetail {
+	// In the task detail view, check for sidebar scrolling keys (not configurable)
+	if inTaskDetail {
 		if keyPressed == "ctrl+d" || keyPressed == "ctrl+u" || keyPressed == "ctrl+f" ||
 			keyPressed == "ctrl+b" || keyPressed == "pgdown" || keyPressed == "pgup" {
 			m.sidebar, cmd = m.sidebar.Update(msg)
@@ -1639,6 +2290,17 @@ func (m Model) handleNormalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Whenever the sidebar is visible, move through and open its files (not configurable)
+	if inTaskDetail || m.viewMode == ViewModeListWithSidebar {
+		if keyPressed == "ctrl+n" || keyPressed == "ctrl+p" {
+			m.sidebar, cmd = m.sidebar.Update(msg)
+			return m, cmd
+		}
+		if keyPressed == "ctrl+o" {
+			return m, m.openSidebarFile()
+		}
+	}
+
 	return m, nil
 }
 
@@ -1652,7 +2314,8 @@ func (m *Model) updateComponentSizes() {
 	// Don't auto-switch if we're in task detail view
 	forceSmall := m.config.TUI.ForceSmallScreen
 	if m.width < 80 || forceSmall {
-		if m.viewMode != ViewModeSmallTaskDetail && m.viewMode != ViewModeTaskDetail {
+		if m.viewMode != ViewModeSmallTaskDetail && m.viewMode != ViewModeTaskDetail &&
+			m.viewMode != ViewModeProjectPanes {
 			m.viewMode = ViewModeSmall
 		}
 	} else {
@@ -1690,6 +2353,7 @@ func (m *Model) updateComponentSizes() {
 		m.state == StateAnnotateInput || m.state == StateNewTaskInput {
 		availableHeight -= 2
 	}
+	m.contentHeight = availableHeight
 
 	if m.viewMode == ViewModeListWithSidebar {
 		// Split view: task list and sidebar
@@ -1706,6 +2370,11 @@ func (m *Model) updateComponentSizes() {
 
 		m.taskList.SetSize(taskListWidth, availableHeight)
 		m.sidebar.SetSize(sidebarWidth, availableHeight)
+	} else if m.viewMode == ViewModeProjectPanes {
+		// Project list on the left, tasks of the selected project on the right
+		projectPaneWidth := projectPaneWidth(m.width)
+		m.projectPane.SetSize(projectPaneWidth, availableHeight)
+		m.taskList.SetSize(m.width-projectPaneWidth-1, availableHeight) // 1 column for the separator
 	} else if m.viewMode == ViewModeSmallTaskDetail || m.viewMode == ViewModeTaskDetail {
 		// Full screen task detail view (using sidebar component)
 		m.sidebar.SetSize(m.width, availableHeight)
@@ -1736,10 +2405,58 @@ func (m *Model) updateComponentSizes() {
 	}
 }
 
-// updateSidebar updates the sidebar with the currently selected task
+// groupFilter returns the search filter that drills into a Projects or Tags group.
+// The synthesized "(none)" group maps to the filter matching tasks without a project or tag.
+func (m Model) groupFilter(group core.TaskGroup) string {
+	if m.sections.IsProjectsView() {
+		if group.Name == core.NoGroupName {
+			return "project:"
+		}
+		return "project:" + group.Name
+	}
+	if m.sections.IsTagsView() {
+		if group.Name == core.NoGroupName {
+			return "tags.none:"
+		}
+		return "+" + group.Name
+	}
+	return ""
+}
+
+// openSearchTab switches to the Search tab and loads the tasks matching filter
+func (m Model) openSearchTab(filter string) (tea.Model, tea.Cmd) {
+	if len(m.sections.Items) == 0 {
+		return m, nil
+	}
+	if m.viewMode == ViewModeProjectPanes {
+		m.viewMode = ViewModeList
+		m.updateComponentSizes()
+	}
+	m.sections.ActiveIndex = 0
+	searchSection := m.sections.Items[0]
+	m.currentSection = &searchSection
+	m.searchTabFilter = filter
+	m.activeFilter = filter
+	m.inGroupView = false
+	m.selectedGroup = nil
+	m.groups = []core.TaskGroup{}
+	m.isLoading = true
+	m.errorMessage = ""
+	m.statusMessage = ""
+	return m, loadTasksCmd(m.service, filter, true, m.searchAnnotations())
+}
+
+// updateSidebar updates the sidebar with the currently selected task, or with
+// the highlighted group in the Projects and Tags lists
 func (m *Model) updateSidebar() {
+	if m.inGroupView {
+		m.sidebar.SetGroup(m.highlightedGroup())
+		return
+	}
 	selectedTask := m.taskList.SelectedTask()
 	m.sidebar.SetTask(selectedTask)
+	m.sidebar.SetFiles(sidebarFiles(selectedTask))
+	m.autoToggleSidebar(selectedTask)
 }
 
 // handleFilterKeys handles keys in filter input state
@@ -1749,12 +2466,27 @@ func (m Model) handleFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "esc":
 		m.state = StateNormal
+		m.globalSearchInput = false
 		m.filter.Blur()
 		m.filter.ResetHistoryNavigation()
 		m.updateComponentSizes()
 		return m, nil
 
 	case "enter":
+		// Search all tasks, keeping the current tab and its filter
+		if m.globalSearchInput {
+			query := strings.TrimSpace(m.filter.Value())
+			m.globalSearchInput = false
+			m.state = StateNormal
+			m.filter.Blur()
+			m.filter.ResetHistoryNavigation()
+			m.updateComponentSizes()
+			if query == "" {
+				return m, nil
+			}
+			return m, globalSearchCmd(m.service, query, m.searchAnnotations())
+		}
+
 		// Apply the filter
 		filterText := m.filter.Value()
 
@@ -1771,13 +2503,11 @@ func (m Model) handleFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Check if we're in the Search tab
 		isSearchTab := m.currentSection != nil && m.currentSection.Name == "Search"
 
-		// Save the filter if we're in the Search tab (for session persistence)
-		if isSearchTab {
-			m.searchTabFilter = filterText
-		}
+		// Remember the filter of the tab (saved for the next session on quit)
+		m.rememberTabFilter(filterText)
 
 		// Load tasks with new filter
-		return m, loadTasksCmd(m.service, filterText, isSearchTab)
+		return m, loadTasksCmd(m.service, filterText, isSearchTab, m.searchAnnotations())
 
 	case "up":
 		// Navigate to previous command in history
@@ -1851,6 +2581,10 @@ func (m Model) handleHelpKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // handleConfirmKeys handles keys in confirm state
 func (m Model) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.keyMatches(msg.String(), "quit") {
+		return m.cancelPendingConfirm()
+	}
+
 	switch msg.String() {
 	case "esc", "n", "N":
 		m.state = StateNormal
@@ -1867,6 +2601,40 @@ func (m Model) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, deleteTasksCmd(m.service, selectedTasks)
 		}
 
+		if m.confirmAction == confirmReopen {
+			m.confirmAction = ""
+			if tasks := completedTasks(selectedTasks); len(tasks) > 0 {
+				m.taskList.ClearSelection()
+				return m, reopenTasksCmd(m.service, tasks)
+			}
+			return m, nil
+		}
+
+		if m.confirmAction == confirmStartStop && len(selectedTasks) > 0 {
+			m.confirmAction = ""
+			m.taskList.ClearSelection()
+			return m, toggleStartStopCmd(m.service, selectedTasks, m.autoAnnotations())
+		}
+
+		if m.confirmAction == confirmUndo {
+			m.confirmAction = ""
+			return m, undoCmd(m.service)
+		}
+
+		if m.confirmAction == confirmArchive {
+			m.confirmAction = ""
+			if tasks := m.archiveTasks(); len(tasks) > 0 {
+				return m, m.archiveTasksCmd(tasks)
+			}
+			return m, nil
+		}
+
+		if m.confirmAction == confirmGroupDone || m.confirmAction == confirmGroupDelete {
+			action := m.confirmAction
+			m.confirmAction = ""
+			return m.runGroupAction(action)
+		}
+
 		m.confirmAction = ""
 		return m, nil
 	}
@@ -1881,6 +2649,7 @@ func (m Model) handleTokenExpiredKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "esc", "n", "N":
 		m.state = StateNormal
 		m.tokenExpiredMessage = ""
+		m.syncingBeforeQuit = false
 		return m, nil
 	case "y", "Y":
 		m.state = StateNormal
@@ -1888,16 +2657,16 @@ func (m Model) handleTokenExpiredKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		// Determine token path from config
 		if m.config.CalendarSync == nil {
-			m.errorMessage = "Calendar sync not configured"
+			m.errorMessage = m.text(msgErrCalendarNotConfigured)
 			return m, nil
 		}
 		tokenPath := m.config.CalendarSync.TokenPath
 		if err := calendar.DeleteToken(tokenPath); err != nil {
-			m.errorMessage = "Failed to delete token: " + err.Error()
+			m.syncingBeforeQuit = false
+			m.errorMessage = m.text(msgErrDeleteToken, err.Error())
 			return m, nil
 		}
-		m.isLoading = true
-		return m, calendarSyncCmd(m.config, m.service)
+		return m, m.startCalendarSync()
 	}
 	return m, nil
 }
@@ -1913,7 +2682,8 @@ func (m Model) handleWaitingForCalendarAuthKeys(msg tea.KeyMsg) (tea.Model, tea.
 		}
 		m.calendarAuthURL = ""
 		m.state = StateNormal
-		m.statusMessage = "Calendar authorization cancelled"
+		m.syncingBeforeQuit = false
+		m.statusMessage = m.text(msgCalendarAuthCancelled)
 		return m, nil
 	}
 	return m, nil
@@ -1949,6 +2719,7 @@ func (m Model) handleModifyKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "esc":
 		m.state = StateNormal
+		m.newProjectPrompt = false
 		m.modifyInput.Blur()
 		m.updateComponentSizes()
 		return m, nil
@@ -1956,12 +2727,25 @@ func (m Model) handleModifyKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "enter":
 		// Apply modifications
 		modifications := m.modifyInput.Value()
-		selectedTasks := m.taskList.GetSelectedTasks()
+		empty := isEmptyInput(modifications)
+		if empty && m.handleEmptyInput(emptyInputModify) {
+			return m, nil
+		}
+		if m.newProjectPrompt {
+			modifications = projectRuleModifications(m.config.TUI.ProjectRules, modifications)
+			m.newProjectPrompt = false
+		}
+		selectedTasks := m.actionTasks()
 		m.state = StateNormal
 		m.modifyInput.Blur()
 		m.updateComponentSizes()
 
-		if len(selectedTasks) > 0 && modifications != "" {
+		if len(selectedTasks) > 0 && !empty {
+			// Taskwarrior would ask whether to change the other instances too
+			if hasRecurringInstance(selectedTasks) {
+				m.activateRecurrenceScopePicker(selectedTasks, modifications)
+				return m, nil
+			}
 			m.taskList.ClearSelection()
 			return m, modifyTasksCmd(m.service, selectedTasks, modifications)
 		}
@@ -2019,17 +2803,32 @@ func (m Model) handleAnnotateKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.state = StateNormal
 		m.annotateInput.Blur()
 		m.updateComponentSizes()
+		if m.doneNoteTasks != nil {
+			m.doneNoteTasks = nil
+			m.statusMessage = m.text(msgCompletionCancelled)
+		}
 		return m, nil
 
 	case "enter":
 		// Add annotation
 		text := m.annotateInput.Value()
+		if m.doneNoteTasks != nil {
+			// Completion note: annotate, then mark done
+			m.state = StateNormal
+			m.annotateInput.Blur()
+			m.updateComponentSizes()
+			return m.finishDoneNote(text)
+		}
+		empty := isEmptyInput(text)
+		if empty && m.handleEmptyInput(emptyInputAnnotate) {
+			return m, nil
+		}
 		selectedTasks := m.taskList.GetSelectedTasks()
 		m.state = StateNormal
 		m.annotateInput.Blur()
 		m.updateComponentSizes()
 
-		if len(selectedTasks) > 0 && text != "" {
+		if len(selectedTasks) > 0 && !empty {
 			m.taskList.ClearSelection()
 			return m, annotateTasksCmd(m.service, selectedTasks, text)
 		}
@@ -2049,6 +2848,8 @@ func (m Model) handleNewTaskKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "esc":
 		m.state = StateNormal
+		m.recurringTask = nil
+		m.subtaskParent = nil
 		m.newTaskInput.Blur()
 		m.updateComponentSizes()
 		return m, nil
@@ -2060,6 +2861,25 @@ func (m Model) handleNewTaskKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.newTaskInput.Blur()
 		m.updateComponentSizes()
 
+		if m.recurringTask != nil {
+			// First step of the new recurring task flow: ask for the period next
+			if strings.TrimSpace(description) == "" {
+				m.recurringTask = nil
+				return m, nil
+			}
+			m.recurringTask.description = description
+			m.activateRecurrencePicker()
+			return m, nil
+		}
+
+		if parent := m.subtaskParent; parent != nil {
+			m.subtaskParent = nil
+			if description != "" {
+				return m, addSubtaskCmd(m.service, description, parent.UUID, m.config.TUI.SubtaskDirection)
+			}
+			return m, nil
+		}
+
 		if description != "" {
 			return m, addTaskCmd(m.service, description)
 		}
@@ -2187,6 +3007,33 @@ func (m Model) findBlockingTasks(tasks []core.Task) []string {
 	return blocking
 }
 
+// holdForValidation shows the validation popup instead of completing tasks that
+// are blocked or have outstanding TODOs, when these validations are enabled.
+// It reports whether the tasks wait for the popup.
+func (m *Model) holdForValidation(tasks []core.Task) bool {
+	var todos []string
+	var blocking []string
+
+	// Check for blocking tasks if validation is enabled
+	if m.hasBlockedValidationEnabled() {
+		blocking = m.findBlockingTasks(tasks)
+	}
+
+	// Check for outstanding TODOs if validation is enabled
+	if m.hasTodoValidationEnabled() {
+		todos = extractOutstandingTodos(tasks)
+	}
+
+	if len(blocking) == 0 && len(todos) == 0 {
+		return false
+	}
+	m.pendingDoneTasks = tasks
+	m.blockingTasks = blocking
+	m.outstandingTodos = todos
+	m.state = StateTaskValidation
+	return true
+}
+
 // handleTaskValidationKeys handles keys in task validation state
 func (m Model) handleTaskValidationKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
@@ -2196,7 +3043,7 @@ func (m Model) handleTaskValidationKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.pendingDoneTasks = nil
 		m.outstandingTodos = nil
 		m.blockingTasks = nil
-		m.statusMessage = "Task completion cancelled"
+		m.statusMessage = m.text(msgCompletionCancelled)
 		return m, nil
 	case "y", "Y":
 		// Force complete despite validation issues
@@ -2205,14 +3052,13 @@ func (m Model) handleTaskValidationKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.pendingDoneTasks = nil
 		m.outstandingTodos = nil
 		m.blockingTasks = nil
-		m.taskList.ClearSelection()
-		return m, markTasksDoneCmd(m.service, tasks)
+		return m.completeTasks(tasks)
 	}
 	return m, nil
 }
 
 // loadTasksCmd creates a command to load tasks asynchronously
-func loadTasksCmd(service core.TaskService, filter string, isSearchTab bool) tea.Cmd {
+func loadTasksCmd(service core.TaskService, filter string, isSearchTab bool, searchAnnotations bool) tea.Cmd {
 	return func() tea.Msg {
 		// If filter is empty, return empty task list
 		// This shows nothing until user enters a search query
@@ -2223,24 +3069,7 @@ func loadTasksCmd(service core.TaskService, filter string, isSearchTab bool) tea
 			}
 		}
 
-		// In Search tab, we want to search ALL tasks in the database by default
-		// unless the user explicitly filters by status
-		actualFilter := filter
-		if isSearchTab {
-			// Check if user already specified a status filter
-			// Common patterns: "status:", "status.not:", "status.is:"
-			hasStatusFilter := strings.Contains(filter, "status:")
-
-			// If no status filter specified, search across ALL tasks
-			// By using "status.any:" we tell taskwarrior to search all statuses
-			if !hasStatusFilter {
-				// Prepend status.any: to search all tasks regardless of status
-				// This searches pending, completed, deleted, waiting, and recurring tasks
-				actualFilter = "status.any: " + filter
-			}
-		}
-
-		tasks, err := service.Export(actualFilter)
+		tasks, err := service.Export(taskFilter(filter, isSearchTab, searchAnnotations))
 		if err == nil && !isSearchTab {
 			filtered := tasks[:0]
 			for _, t := range tasks {
@@ -2362,6 +3191,9 @@ func annotateTaskCmd(service core.TaskService, uuid, text string) tea.Cmd {
 // addTaskCmd creates a command to add a new task
 func addTaskCmd(service core.TaskService, description string) tea.Cmd {
 	return func() tea.Msg {
+		if err := validateRecurrence(description); err != nil {
+			return TaskModifiedMsg{Err: err}
+		}
 		_, err := service.Add(description)
 		if err != nil {
 			return TaskModifiedMsg{Err: err}
@@ -2371,19 +3203,25 @@ func addTaskCmd(service core.TaskService, description string) tea.Cmd {
 	}
 }
 
-// editTaskCmd creates a command to edit a task (suspends TUI)
-func editTaskCmd(taskBin, taskrcPath, uuid string) tea.Cmd {
+// editTaskCmd creates a command to edit a task (suspends TUI). When capture is
+// set, the errors printed by the edit are shown in the message history afterward.
+func editTaskCmd(taskBin, taskrcPath, uuid string, capture bool) tea.Cmd {
 	c := exec.Command(taskBin, uuid, "edit")
 	if taskrcPath != "" {
 		c.Env = append(os.Environ(), fmt.Sprintf("TASKRC=%s", taskrcPath))
 	}
 
+	var output *bytes.Buffer
+	if capture {
+		output = captureExecOutput(c)
+	}
+
 	return tea.ExecProcess(c, func(err error) tea.Msg {
 		if err != nil {
-			return TaskModifiedMsg{Err: err}
+			return withCommandOutput(TaskModifiedMsg{Err: err}, "task edit", output)
 		}
 		// Return success - will trigger refresh
-		return TaskModifiedMsg{Err: nil}
+		return withCommandOutput(TaskModifiedMsg{Err: nil}, "task edit", output)
 	})
 }
 
@@ -2407,12 +3245,16 @@ func stopTaskCmd(service core.TaskService, uuid string) tea.Cmd {
 	}
 }
 
-// markTasksDoneCmd creates a command to mark multiple tasks as done
-func markTasksDoneCmd(service core.TaskService, tasks []core.Task) tea.Cmd {
+// markTasksDoneCmd creates a command to mark multiple tasks as done,
+// adding the configured "done" auto-annotation to each completed task
+func markTasksDoneCmd(service core.TaskService, tasks []core.Task, annotations map[string]string) tea.Cmd {
 	return func() tea.Msg {
 		var firstErr error
 		for _, task := range tasks {
 			err := service.Done(task.UUID)
+			if err == nil {
+				err = autoAnnotate(service, task, "done", annotations)
+			}
 			if err != nil && firstErr == nil {
 				firstErr = err
 			}
@@ -2471,18 +3313,24 @@ func annotateTasksCmd(service core.TaskService, tasks []core.Task, text string)
 	}
 }
 
-// toggleStartStopCmd creates a command to toggle start/stop on multiple tasks
-func toggleStartStopCmd(service core.TaskService, tasks []core.Task) tea.Cmd {
+// toggleStartStopCmd creates a command to toggle start/stop on multiple tasks,
+// adding the configured "start" or "stop" auto-annotation after each successful toggle
+func toggleStartStopCmd(service core.TaskService, tasks []core.Task, annotations map[string]string) tea.Cmd {
 	return func() tea.Msg {
 		var firstErr error
 		for _, task := range tasks {
 			var err error
 			// If task is started (has Start field), stop it; otherwise start it
+			action := "start"
 			if task.Start != nil {
+				action = "stop"
 				err = service.Stop(task.UUID)
 			} else {
 				err = service.Start(task.UUID)
 			}
+			if err == nil {
+				err = autoAnnotate(service, task, action, annotations)
+			}
 			if err != nil && firstErr == nil {
 				firstErr = err
 			}
@@ -2493,101 +3341,62 @@ func toggleStartStopCmd(service core.TaskService, tasks []core.Task) tea.Cmd {
 	}
 }
 
+// markdownOptions returns the markdown export options set in tui.markdown_export
+func markdownOptions(cfg *config.TUIConfig) core.MarkdownOptions {
+	if cfg == nil || cfg.MarkdownExport == nil {
+		return core.MarkdownOptions{}
+	}
+	export := cfg.MarkdownExport
+	return core.MarkdownOptions{
+		Fields:          export.Fields,
+		Annotations:     export.Annotations,
+		AnnotationStyle: export.AnnotationStyle,
+		AnnotationDates: export.AnnotationDates,
+		MaxLineLength:   export.MaxLineLength,
+	}
+}
+
 // exportMarkdownCmd exports task(s) to markdown format and copies to clipboard
-func exportMarkdownCmd(tasks []core.Task) tea.Cmd {
+func exportMarkdownCmd(language string, cb Clipboard, tasks []core.Task, opts core.MarkdownOptions) tea.Cmd {
 	return func() tea.Msg {
 		var markdowns []string
 		for _, task := range tasks {
-			markdowns = append(markdowns, task.ToMarkdown())
+			markdowns = append(markdowns, task.ToMarkdownWithOptions(opts))
 		}
 		markdown := strings.Join(markdowns, "\n")
 
 		// Try to copy to clipboard
-		err := clipboard.WriteAll(markdown)
+		err := cb.WriteText(markdown)
 
 		if err != nil {
 			return StatusMsg{
-				Message: "Failed to copy to clipboard: " + markdown,
+				Message: translate(language, msgErrCopyClipboard, markdown),
 				IsError: true,
 			}
 		}
 
 		return StatusMsg{
-			Message: "Task exported to clipboard as markdown ✓",
+			Message: translate(language, msgExportedMarkdown),
 			IsError: false,
 		}
 	}
 }
 
-// calendarSyncCmd creates a command to sync tasks to Google Calendar
-func calendarSyncCmd(cfg *config.Config, service core.TaskService) tea.Cmd {
+// taskSyncCmd creates a command that runs "task sync"
+func taskSyncCmd(service core.TaskService) tea.Cmd {
 	return func() tea.Msg {
-		// Validate calendar configuration
-		if cfg.CalendarSync == nil {
-			return CalendarSyncCompletedMsg{
-				Err: fmt.Errorf("calendar sync not configured"),
-			}
-		}
-
-		calendarName := cfg.CalendarSync.CalendarName
-		taskFilter := cfg.CalendarSync.TaskFilter
-		credentialsPath := cfg.CalendarSync.CredentialsPath
-		tokenPath := cfg.CalendarSync.TokenPath
-
-		// Validate required fields
-		if calendarName == "" {
-			return CalendarSyncCompletedMsg{
-				Err: fmt.Errorf("calendar name is not configured"),
-			}
-		}
-		if taskFilter == "" {
-			return CalendarSyncCompletedMsg{
-				Err: fmt.Errorf("task filter is not configured"),
-			}
-		}
-
-		// We need access to the taskwarrior client to create the calendar sync client
-		// Since the service interface doesn't expose the underlying client,
-		// we need to recreate it from the config
-		// This is necessary because the calendar sync requires the taskwarrior.Client type
-		taskClient, err := createTaskClient(cfg)
-		if err != nil {
-			return CalendarSyncCompletedMsg{
-				Err: fmt.Errorf("failed to create task client: %w", err),
-			}
-		}
-
-		// Perform the calendar sync
-		result, err := performCalendarSync(taskClient, credentialsPath, tokenPath, calendarName, taskFilter)
-		return CalendarSyncCompletedMsg{
-			Result: result,
-			Err:    err,
-		}
+		return TaskSyncCompletedMsg{Err: service.TaskSync()}
 	}
 }
 
 // Helper function to create taskwarrior client from config
 func createTaskClient(cfg *config.Config) (*taskwarrior.Client, error) {
-	return taskwarrior.NewClient(cfg.TaskBin, cfg.TaskrcPath)
-}
-
-// Helper function to perform calendar sync
-func performCalendarSync(taskClient *taskwarrior.Client, credentialsPath, tokenPath, calendarName, taskFilter string) (*calendar.SyncResult, error) {
-	ctx := context.Background()
-
-	// Create sync client
-	syncClient, err := calendar.NewSyncClient(ctx, taskClient, credentialsPath, tokenPath, calendarName, taskFilter)
+	client, err := taskwarrior.NewClient(cfg.TaskBin, cfg.TaskrcPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create sync client: %w", err)
+		return nil, err
 	}
-
-	// Perform sync
-	result, err := syncClient.Sync(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("sync failed: %w", err)
-	}
-
-	return result, nil
+	client.SetStrictJSON(cfg.StrictJSON)
+	return client, nil
 }
 
 // extractUniqueProjects extracts all unique projects from tasks
@@ -2718,55 +3527,107 @@ func expandCommandTemplate(template string, task *core.Task) (string, error) {
 	return result, nil
 }
 
-// executeCustomCommand executes a custom command with template expansion
-func executeCustomCommand(cmd config.CustomCommand, task *core.Task) tea.Cmd {
-	return func() tea.Msg {
-		// Expand template
-		expandedCmd, err := expandCommandTemplate(cmd.Command, task)
-		if err != nil {
-			return StatusMsg{
-				Message: fmt.Sprintf("Command expansion failed: %s", err.Error()),
-				IsError: true,
-			}
-		}
+// customCommandEnv returns the environment of a custom command: the TUI's own
+// environment plus the UUIDs of the tasks it acts on
+func customCommandEnv(tasks []core.Task) []string {
+	uuids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		uuids = append(uuids, task.UUID)
+	}
+	env := os.Environ()
+	if len(uuids) > 0 {
+		env = append(env, "WUI_TASK_UUID="+uuids[0])
+	}
+	return append(env, "WUI_TASK_UUIDS="+strings.Join(uuids, " "))
+}
 
-		// Parse command into parts (handle quoted arguments properly)
-		parts, err := parseCommandLine(expandedCmd)
-		if err != nil {
-			return StatusMsg{
-				Message: fmt.Sprintf("Command parsing failed: %s", err.Error()),
-				IsError: true,
-			}
-		}
+// customCommandExec builds the process of a custom command, expanding its
+// template with the fields of the first task
+func customCommandExec(cmd config.CustomCommand, tasks []core.Task) (*exec.Cmd, error) {
+	if len(tasks) == 0 {
+		return nil, errors.New("no task selected")
+	}
 
-		if len(parts) == 0 {
-			return StatusMsg{
-				Message: "Empty command after expansion",
-				IsError: true,
-			}
+	// Expand template
+	expandedCmd, err := expandCommandTemplate(cmd.Command, &tasks[0])
+	if err != nil {
+		return nil, fmt.Errorf("command expansion failed: %w", err)
+	}
+
+	// Parse command into parts (handle quoted arguments properly)
+	parts, err := parseCommandLine(expandedCmd)
+	if err != nil {
+		return nil, fmt.Errorf("command parsing failed: %w", err)
+	}
+
+	if len(parts) == 0 {
+		return nil, errors.New("empty command after expansion")
+	}
+
+	execCmd := exec.Command(parts[0], parts[1:]...)
+
+	// Ensure command inherits the environment (including PATH)
+	execCmd.Env = customCommandEnv(tasks)
+	return execCmd, nil
+}
+
+// customCommandResult reports the outcome of a custom command, refreshing the
+// task list after a successful run when the command asks for it
+func customCommandResult(cmd config.CustomCommand, status StatusMsg) tea.Msg {
+	if cmd.Refresh && !status.IsError {
+		return tea.BatchMsg{
+			func() tea.Msg { return status },
+			func() tea.Msg { return RefreshMsg{} },
 		}
+	}
+	return status
+}
 
-		// Execute command and capture output
-		execCmd := exec.Command(parts[0], parts[1:]...)
+// executeCustomCommand executes a custom command with template expansion on the
+// given tasks. Interactive commands suspend the TUI while they run.
+func executeCustomCommand(language string, cmd config.CustomCommand, tasks []core.Task) tea.Cmd {
+	execCmd, err := customCommandExec(cmd, tasks)
+	if err != nil {
+		return func() tea.Msg {
+			return StatusMsg{Message: err.Error(), IsError: true}
+		}
+	}
 
-		// Ensure command inherits the environment (including PATH)
-		execCmd.Env = os.Environ()
+	if cmd.Interactive {
+		var output *bytes.Buffer
+		if cmd.CaptureOutput {
+			output = captureExecOutput(execCmd)
+		}
+		return tea.ExecProcess(execCmd, func(err error) tea.Msg {
+			if err != nil {
+				return withCommandOutput(StatusMsg{
+					Message: translate(language, msgErrCommandFailed, cmd.Name, err.Error()),
+					IsError: true,
+				}, cmd.Name, output)
+			}
+			return withCommandOutput(customCommandResult(cmd, StatusMsg{Message: translate(language, msgCommandExecuted, cmd.Name)}), cmd.Name, output)
+		})
+	}
 
-		// Capture stderr for error reporting
+	return func() tea.Msg {
+		// Capture stderr for error reporting, or all the output when asked to
 		var stderr bytes.Buffer
-		execCmd.Stderr = &stderr
+		var output *bytes.Buffer
+		if cmd.CaptureOutput {
+			output = captureOutput(execCmd)
+		} else {
+			execCmd.Stderr = &stderr
+		}
 
 		// Run the command and wait for it to complete
-		err = execCmd.Run()
+		err := execCmd.Run()
 		if err != nil {
 			// Command failed - report exit code and stderr
-			errMsg := fmt.Sprintf("Command '%s' failed", cmd.Name)
+			errMsg := translate(language, msgErrCommandFailed, cmd.Name, err.Error())
 
 			// Add exit code if available
 			if exitErr, ok := err.(*exec.ExitError); ok {
-				errMsg += fmt.Sprintf(" (exit code %d)", exitErr.ExitCode())
-			} else {
-				errMsg += fmt.Sprintf(": %s", err.Error())
+				errMsg = translate(language, msgErrCommandExitCode, cmd.Name, exitErr.ExitCode())
 			}
 
 			// Add stderr if available
@@ -2774,16 +3635,16 @@ func executeCustomCommand(cmd config.CustomCommand, task *core.Task) tea.Cmd {
 				errMsg += fmt.Sprintf(": %s", stderrStr)
 			}
 
-			return StatusMsg{
+			return withCommandOutput(StatusMsg{
 				Message: errMsg,
 				IsError: true,
-			}
+			}, cmd.Name, output)
 		}
 
-		return StatusMsg{
-			Message: fmt.Sprintf("Executed: %s", cmd.Name),
+		return withCommandOutput(customCommandResult(cmd, StatusMsg{
+			Message: translate(language, msgCommandExecuted, cmd.Name),
 			IsError: false,
-		}
+		}), cmd.Name, output)
 	}
 }
 
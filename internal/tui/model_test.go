@@ -55,7 +55,7 @@ func TestModelInit(t *testing.T) {
 	}
 
 	// Check it's a TasksLoadedMsg
-	if _, ok := msg.(TasksLoadedMsg); !ok {
+	if _, ok := findTasksLoadedMsg(msg); !ok {
 		t.Errorf("Expected TasksLoadedMsg, got %T", msg)
 	}
 }
@@ -178,13 +178,13 @@ func TestLoadTasksCmd(t *testing.T) {
 		},
 	}
 
-	cmd := loadTasksCmd(service, "status:pending", false)
+	cmd := loadTasksCmd(service, "status:pending", false, false)
 	if cmd == nil {
 		t.Fatal("Expected loadTasksCmd to return a command")
 	}
 
-	// Execute the command
-	msg := cmd()
+	// Execute the command; the tasks load runs first in the batch
+	msg, _ := findTasksLoadedMsg(cmd())
 	if msg == nil {
 		t.Fatal("Expected command to return a message")
 	}
@@ -210,7 +210,7 @@ func TestLoadTasksCmdWithError(t *testing.T) {
 		},
 	}
 
-	cmd := loadTasksCmd(service, "status:pending", false)
+	cmd := loadTasksCmd(service, "status:pending", false, false)
 	msg := cmd()
 
 	loadedMsg, ok := msg.(TasksLoadedMsg)
@@ -282,11 +282,11 @@ func TestInitWithSearchFilter(t *testing.T) {
 		t.Fatal("Expected Init to return a command")
 	}
 
-	// Execute the command
-	msg := cmd()
+	// Execute the command; the tasks load runs first in the batch
+	msg, _ := findTasksLoadedMsg(cmd())
 
 	// Check it's a TasksLoadedMsg
-	if _, ok := msg.(TasksLoadedMsg); !ok {
+	if _, ok := findTasksLoadedMsg(msg); !ok {
 		t.Errorf("Expected TasksLoadedMsg, got %T", msg)
 	}
 
@@ -316,11 +316,11 @@ func TestInitWithSearchFilterWithStatus(t *testing.T) {
 		t.Fatal("Expected Init to return a command")
 	}
 
-	// Execute the command
-	msg := cmd()
+	// Execute the command; the tasks load runs first in the batch
+	msg, _ := findTasksLoadedMsg(cmd())
 
 	// Check it's a TasksLoadedMsg
-	if _, ok := msg.(TasksLoadedMsg); !ok {
+	if _, ok := findTasksLoadedMsg(msg); !ok {
 		t.Errorf("Expected TasksLoadedMsg, got %T", msg)
 	}
 
@@ -374,3 +374,42 @@ func TestLoadMissingDepTasksCmd(t *testing.T) {
 		}
 	})
 }
+
+// findTasksLoadedMsg runs the commands of a batch message (if any) in order
+// and returns the first TasksLoadedMsg produced.
+func findTasksLoadedMsg(msg tea.Msg) (tea.Msg, bool) {
+	if loaded, ok := msg.(TasksLoadedMsg); ok {
+		return loaded, true
+	}
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, cmd := range batch {
+			if cmd == nil {
+				continue
+			}
+			if loaded, ok := findTasksLoadedMsg(cmd()); ok {
+				return loaded, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func TestJumpKeyNeedsJumpLabels(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	model = pressKey(t, model, "f")
+	if model.taskList.JumpActive() {
+		t.Fatal("Expected the jump key to do nothing without jump_labels")
+	}
+
+	model.config.TUI.JumpLabels = true
+	model = pressKey(t, model, "f")
+	if !model.taskList.JumpActive() {
+		t.Fatal("Expected jump labels to be shown")
+	}
+	// The third row is labeled "d"
+	model = pressKey(t, model, "d")
+	if model.taskList.JumpActive() || model.taskList.SelectedIndex() != 2 {
+		t.Errorf("Expected the cursor on the third task, got %d", model.taskList.SelectedIndex())
+	}
+}
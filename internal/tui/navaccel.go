@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// navRepeatWindow is the longest pause between two presses of a navigation key
+// that still counts as holding the key down
+const navRepeatWindow = 150 * time.Millisecond
+
+// navAccelerationSteps are the rows moved by each press of a held navigation key,
+// starting from the given number of repeats
+var navAccelerationSteps = []struct {
+	repeats int
+	rows    int
+}{
+	{0, 1},
+	{5, 3},
+	{15, 5},
+}
+
+// navStep returns the rows moved by a navigation key pressed repeats times in a row
+func navStep(repeats int) int {
+	rows := 1
+	for _, step := range navAccelerationSteps {
+		if repeats >= step.repeats {
+			rows = step.rows
+		}
+	}
+	return rows
+}
+
+// navigationStep returns the rows moved by an up or down key press. With
+// tui.nav_acceleration the step grows while the key is held, and drops back
+// to one row after a pause or another key.
+func (m *Model) navigationStep(keyPressed string) int {
+	if !m.config.TUI.NavAcceleration {
+		return 1
+	}
+
+	now := core.Now()
+	if keyPressed == m.navRepeatKey && now.Sub(m.navRepeatAt) <= navRepeatWindow {
+		m.navRepeats++
+	} else {
+		m.navRepeats = 0
+	}
+	m.navRepeatKey = keyPressed
+	m.navRepeatAt = now
+	return navStep(m.navRepeats)
+}
@@ -0,0 +1,111 @@
+package tui
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestNavStep(t *testing.T) {
+	tests := []struct{ repeats, rows int }{
+		{0, 1}, {4, 1}, {5, 3}, {14, 3}, {15, 5}, {100, 5},
+	}
+	for _, tt := range tests {
+		if got := navStep(tt.repeats); got != tt.rows {
+			t.Errorf("navStep(%d) = %d, expected %d", tt.repeats, got, tt.rows)
+		}
+	}
+}
+
+// createLongListModel returns a test model listing count tasks, with a clock
+// that is advanced by the returned function
+func createLongListModel(t *testing.T, count int) (Model, func(time.Duration)) {
+	t.Helper()
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	core.SetNowFunc(func() time.Time { return now })
+	t.Cleanup(func() { core.SetNowFunc(nil) })
+
+	tasks := make([]core.Task, count)
+	for i := range tasks {
+		tasks[i] = core.Task{ID: i + 1, UUID: fmt.Sprintf("uuid-%d", i+1), Description: fmt.Sprintf("Task %d", i+1), Status: "pending"}
+	}
+	model := createTestModel(&core.MockTaskService{})
+	model, _ = loadTasks(model, tasks)
+	return model, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestNavAccelerationRapidPresses(t *testing.T) {
+	model, advance := createLongListModel(t, 100)
+	model.config.TUI.NavAcceleration = true
+
+	// Rapid presses move by 1 row for 5 presses, then 3 rows
+	var moves []int
+	for range 8 {
+		before := model.taskList.Cursor()
+		model = pressKey(t, model, "j")
+		moves = append(moves, model.taskList.Cursor()-before)
+		advance(30 * time.Millisecond)
+	}
+	expected := []int{1, 1, 1, 1, 1, 3, 3, 3}
+	if fmt.Sprint(moves) != fmt.Sprint(expected) {
+		t.Errorf("Expected moves %v, got %v", expected, moves)
+	}
+
+	// Ten more repeats reach 5 rows
+	for range 10 {
+		model = pressKey(t, model, "j")
+		advance(30 * time.Millisecond)
+	}
+	before := model.taskList.Cursor()
+	model = pressKey(t, model, "j")
+	if moved := model.taskList.Cursor() - before; moved != 5 {
+		t.Errorf("Expected a 5 row step after a long hold, moved %d", moved)
+	}
+
+	// A pause resets the step
+	advance(time.Second)
+	before = model.taskList.Cursor()
+	model = pressKey(t, model, "j")
+	if moved := model.taskList.Cursor() - before; moved != 1 {
+		t.Errorf("Expected a single row after a pause, moved %d", moved)
+	}
+
+	// So does switching direction
+	for range 6 {
+		advance(30 * time.Millisecond)
+		model = pressKey(t, model, "j")
+	}
+	advance(30 * time.Millisecond)
+	before = model.taskList.Cursor()
+	model = pressKey(t, model, "k")
+	if moved := before - model.taskList.Cursor(); moved != 1 {
+		t.Errorf("Expected a single row after changing direction, moved %d", moved)
+	}
+}
+
+func TestNavAccelerationDisabled(t *testing.T) {
+	model, advance := createLongListModel(t, 50)
+
+	for range 20 {
+		model = pressKey(t, model, "j")
+		advance(10 * time.Millisecond)
+	}
+	if cursor := model.taskList.Cursor(); cursor != 20 {
+		t.Errorf("Expected one row per press without nav_acceleration, cursor at %d", cursor)
+	}
+}
+
+func TestNavAccelerationStopsAtEnd(t *testing.T) {
+	model, advance := createLongListModel(t, 10)
+	model.config.TUI.NavAcceleration = true
+
+	for range 30 {
+		model = pressKey(t, model, "j")
+		advance(30 * time.Millisecond)
+	}
+	if cursor := model.taskList.Cursor(); cursor != 9 {
+		t.Errorf("Expected the cursor on the last task, got %d", cursor)
+	}
+}
@@ -0,0 +1,46 @@
+package tui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// Values of tabs[].on_empty
+const (
+	onEmptyMessage = "message" // Show the static empty message (default)
+	onEmptyHint    = "hint"    // Suggest adding a task in the empty message
+	onEmptyNewTask = "new"     // Open the new task input
+)
+
+// applyOnEmpty runs the current tab's on_empty behavior after its tasks are loaded.
+// The new task input is only opened on the first load after switching to the tab,
+// so refreshing or cancelling the input does not reopen it.
+func (m *Model) applyOnEmpty() tea.Cmd {
+	firstLoad := m.onEmptyPending
+	m.onEmptyPending = false
+
+	if m.currentSection == nil || m.currentSection.Name == "Search" ||
+		m.inGroupView || m.viewMode == ViewModeProjectPanes {
+		return nil
+	}
+
+	switch m.currentSection.OnEmpty {
+	case onEmptyHint:
+		m.taskList.SetEmptyMessage(m.text(msgEmptyTasksHint, m.actionKey("new", "n")))
+	case onEmptyNewTask:
+		if firstLoad && len(m.tasks) == 0 && m.state == StateNormal {
+			m.state = StateNewTaskInput
+			m.newTaskInput.SetValue("")
+			m.updateComponentSizes()
+			return m.newTaskInput.Focus()
+		}
+	}
+	return nil
+}
+
+// actionKey returns the key bound to an action, or defaultKey when it is not configured
+func (m Model) actionKey(action, defaultKey string) string {
+	if m.config != nil && m.config.TUI != nil {
+		if key, ok := m.config.TUI.Keybindings[action]; ok && key != "" {
+			return key
+		}
+	}
+	return defaultKey
+}
@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// createOnEmptyModel returns a model whose "Next" tab has the given on_empty behavior
+func createOnEmptyModel(onEmpty string) Model {
+	cfg := config.DefaultConfig()
+	cfg.TUI.Tabs = []config.Tab{
+		{Name: "Next", Filter: "status:pending", OnEmpty: onEmpty},
+		{Name: "Waiting", Filter: "status:waiting"},
+	}
+	model := NewModel(&core.MockTaskService{}, cfg)
+	model.width = 100
+	model.height = 30
+	return model
+}
+
+// loadTasks delivers a task load result to the model
+func loadTasks(model Model, tasks []core.Task) (Model, tea.Cmd) {
+	updated, cmd := model.Update(TasksLoadedMsg{Tasks: tasks})
+	return updated.(Model), cmd
+}
+
+func TestOnEmptyNewOpensInput(t *testing.T) {
+	model := createOnEmptyModel(onEmptyNewTask)
+
+	model, _ = loadTasks(model, []core.Task{})
+	if model.state != StateNewTaskInput {
+		t.Fatalf("Expected the new task input to open for an empty tab, got state %v", model.state)
+	}
+
+	// Cancelling and refreshing does not reopen the input
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	model, _ = loadTasks(model, []core.Task{})
+	if model.state != StateNormal {
+		t.Errorf("Expected the input to stay closed after a refresh, got state %v", model.state)
+	}
+
+	// Switching back to the tab opens it again
+	updated, _ = model.Update(components.SectionChangedMsg{Section: model.sections.Items[1]})
+	model = updated.(Model)
+	model, _ = loadTasks(model, []core.Task{})
+	if model.state != StateNewTaskInput {
+		t.Errorf("Expected the new task input after switching to the tab, got state %v", model.state)
+	}
+}
+
+func TestOnEmptyNewWithTasks(t *testing.T) {
+	model := createOnEmptyModel(onEmptyNewTask)
+
+	model, _ = loadTasks(model, []core.Task{{UUID: "1", Description: "Task", Status: "pending"}})
+	if model.state != StateNormal {
+		t.Errorf("Expected the input to stay closed when tasks are found, got state %v", model.state)
+	}
+}
+
+func TestOnEmptyOtherTabsUnchanged(t *testing.T) {
+	model := createOnEmptyModel(onEmptyNewTask)
+
+	updated, _ := model.Update(components.SectionChangedMsg{Section: model.sections.Items[2]})
+	model = updated.(Model)
+	model, _ = loadTasks(model, []core.Task{})
+	if model.state != StateNormal {
+		t.Errorf("Expected tabs without on_empty to keep the static message, got state %v", model.state)
+	}
+}
+
+func TestOnEmptyHint(t *testing.T) {
+	model := createOnEmptyModel(onEmptyHint)
+
+	model, _ = loadTasks(model, []core.Task{})
+	if model.state != StateNormal {
+		t.Errorf("Expected the hint not to open the input, got state %v", model.state)
+	}
+	if view := model.taskList.View(); !strings.Contains(view, "Press n to add a task") {
+		t.Errorf("Expected the add task hint in the empty list, got:\n%s", view)
+	}
+}
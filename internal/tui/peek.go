@@ -0,0 +1,36 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// renderPeek overlays a small popup with the selected task's description, due
+// date and tags on the base view, leaving the layout underneath unchanged
+func (m Model) renderPeek(baseView string) string {
+	content := m.sidebar.PeekView()
+	if content == "" {
+		return baseView
+	}
+
+	box := m.styles.FloatingWindowBox.
+		Padding(1, 2).
+		MaxWidth(m.width).
+		Render(content + "\n\n" + m.styles.InputHint.Render(m.text(msgHintPeek)))
+	boxWidth := lipgloss.Width(box)
+
+	baseLines := strings.Split(baseView, "\n")
+	boxLines := strings.Split(box, "\n")
+
+	// Center the popup over the task list
+	top := max(0, (len(baseLines)-len(boxLines))/2)
+	padding := strings.Repeat(" ", max(0, (m.width-boxWidth)/2))
+	for i, line := range boxLines {
+		if top+i < len(baseLines) {
+			baseLines[top+i] = padding + line
+		}
+	}
+
+	return strings.Join(baseLines, "\n")
+}
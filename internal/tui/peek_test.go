@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestPeekOverlayContent(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	due := time.Date(2026, 11, 2, 0, 0, 0, 0, time.Local)
+	model.tasks = []core.Task{
+		{ID: 1, UUID: "peek-1", Description: "Renew passport", Due: &due, Tags: []string{"errand", "home"}, Status: "pending"},
+		{ID: 2, UUID: "peek-2", Description: "Second task", Status: "pending"},
+	}
+	model.taskList.SetTasks(model.tasks)
+	model.updateSidebar()
+	before := model.View()
+
+	model = pressKey(t, model, "i")
+	if !model.peekActive {
+		t.Fatal("Expected the peek popup to open")
+	}
+	view := model.View()
+	for _, expected := range []string{"Renew passport", "Due: 2026-11-02", "Tags: +errand +home", model.text(msgHintPeek)} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the peek popup, got:\n%s", expected, view)
+		}
+	}
+	if got, expected := len(strings.Split(view, "\n")), len(strings.Split(before, "\n")); got != expected {
+		t.Errorf("Expected the popup not to change the layout height %d, got %d", expected, got)
+	}
+
+	// Any key closes the popup without acting on the list
+	model = pressKey(t, model, "j")
+	if model.peekActive {
+		t.Error("Expected any key to close the peek popup")
+	}
+	if task := model.taskList.SelectedTask(); task == nil || task.UUID != "peek-1" {
+		t.Error("Expected the closing key not to move the cursor")
+	}
+	if strings.Contains(model.View(), model.text(msgHintPeek)) {
+		t.Error("Expected the popup to be gone")
+	}
+}
@@ -0,0 +1,40 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// prefetchNeighborsCmd loads the tasks of the tabs next to the current one into the
+// export cache, so switching to them with tab or h/l shows them at once. It does
+// nothing without a cache, and skips the Search tab and tabs that are already cached.
+func (m Model) prefetchNeighborsCmd() tea.Cmd {
+	if m.taskCache == nil || len(m.sections.Items) < 2 {
+		return nil
+	}
+
+	count := len(m.sections.Items)
+	active := m.sections.ActiveIndex
+	var cmds []tea.Cmd
+	seen := map[string]bool{}
+	for _, index := range []int{(active + 1) % count, (active - 1 + count) % count} {
+		section := m.sections.Items[index]
+		filter := m.tabFilter(section)
+		if index == active || section.Name == "Search" || filter == "" || seen[filter] {
+			continue
+		}
+		seen[filter] = true
+		if !m.taskCache.cached(filter) {
+			cmds = append(cmds, prefetchTasksCmd(m.taskCache, filter))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// prefetchTasksCmd exports the tasks of a tab filter into the cache without showing
+// them. A change made meanwhile drops the result (see cachedService.Invalidate).
+func prefetchTasksCmd(cache *cachedService, filter string) tea.Cmd {
+	return func() tea.Msg {
+		cache.Export(taskFilter(filter, false, false))
+		return nil
+	}
+}
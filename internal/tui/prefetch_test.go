@@ -0,0 +1,88 @@
+package tui
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// createPrefetchModel returns a model with three tabs and the export cache enabled.
+// exports records the filters exported through the service.
+func createPrefetchModel(ttl int, exports *[]string) Model {
+	cfg := config.DefaultConfig()
+	cfg.TUI.CacheTTLSeconds = ttl
+	cfg.TUI.Tabs = []config.Tab{
+		{Name: "Next", Filter: "status:pending"},
+		{Name: "Waiting", Filter: "status:waiting"},
+		{Name: "Done", Filter: "status:completed"},
+	}
+	service := &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			*exports = append(*exports, filter)
+			return []core.Task{}, nil
+		},
+	}
+	model := NewModel(service, cfg)
+	model.width = 100
+	model.height = 30
+	return model
+}
+
+// runCmds runs cmd and the commands of the batches it returns
+func runCmds(cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	if batch, ok := cmd().(tea.BatchMsg); ok {
+		for _, c := range batch {
+			runCmds(c)
+		}
+	}
+}
+
+func TestPrefetchNeighborTabs(t *testing.T) {
+	var exports []string
+	model := createPrefetchModel(30, &exports)
+	if active := model.sections.GetActiveSection().Name; active != "Next" {
+		t.Fatalf("Expected the Next tab to be active, got %s", active)
+	}
+
+	// Loading Next prefetches Waiting; Search (its other neighbor) is skipped
+	_, cmd := loadTasks(model, []core.Task{})
+	runCmds(cmd)
+	if !slices.Equal(exports, []string{"status:waiting"}) {
+		t.Fatalf("Expected the Waiting tab to be prefetched, got %v", exports)
+	}
+
+	// Switching to Waiting uses the prefetched tasks
+	exports = nil
+	updated, cmd := model.Update(components.SectionChangedMsg{Section: model.sections.Items[2]})
+	model = updated.(Model)
+	model.sections.ActiveIndex = 2
+	updated, cmd = model.Update(cmd())
+	model = updated.(Model)
+	runCmds(cmd)
+
+	// Both neighbors of Waiting are prefetched
+	if !slices.Equal(exports, []string{"status:completed", "status:pending"}) {
+		t.Errorf("Expected Waiting from the cache and its neighbors to be prefetched, got %v", exports)
+	}
+
+	// Neighbors already in the cache are not exported again
+	if cmd := model.prefetchNeighborsCmd(); cmd != nil {
+		t.Error("Expected no prefetch when the neighbors are cached")
+	}
+}
+
+func TestPrefetchNeedsCache(t *testing.T) {
+	var exports []string
+	model := createPrefetchModel(0, &exports)
+
+	if cmd := model.prefetchNeighborsCmd(); cmd != nil {
+		t.Error("Expected no prefetch without cache_ttl_seconds")
+	}
+}
@@ -0,0 +1,18 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// cyclePriority moves the selected task(s) one priority up or down (none, L, M, H).
+// Every selected task gets the priority after the one of the task under the cursor.
+func (m Model) cyclePriority(up bool) (tea.Model, tea.Cmd) {
+	cursorTask := m.taskList.SelectedTask()
+	selectedTasks := m.taskList.GetSelectedTasks()
+	if cursorTask == nil || len(selectedTasks) == 0 {
+		return m, nil
+	}
+	m.taskList.ClearSelection()
+	return m, modifyTasksCmd(m.service, selectedTasks, "priority:"+core.NextPriority(cursorTask.Priority, up))
+}
@@ -0,0 +1,72 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// createPriorityModel returns a model whose service records the modifications by task UUID
+func createPriorityModel() (Model, map[string]string) {
+	modified := map[string]string{}
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, modifications string) error {
+			modified[uuid] = modifications
+			return nil
+		},
+	}
+	model := createTestModel(service)
+	model.tasks[0].Priority = "M"
+	model.tasks[1].Priority = "L"
+	model.taskList.SetTasks(model.tasks)
+	return model, modified
+}
+
+func TestCyclePriority(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{">", "priority:H"},
+		{"<", "priority:L"},
+	}
+
+	for _, tt := range tests {
+		model, modified := createPriorityModel()
+		updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.key)})
+		model = updated.(Model)
+		if cmd == nil {
+			t.Fatalf("Expected %s to modify the task", tt.key)
+		}
+		cmd()
+		if modified["test-uuid-1"] != tt.expected || len(modified) != 1 {
+			t.Errorf("Key %s: expected %q on the cursor task, got %v", tt.key, tt.expected, modified)
+		}
+	}
+}
+
+func TestCyclePrioritySelectedTasks(t *testing.T) {
+	model, modified := createPriorityModel()
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+
+	// The cursor task has priority L: both tasks go to M
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(">")})
+	model = updated.(Model)
+	cmd()
+	if modified["test-uuid-1"] != "priority:M" || modified["test-uuid-2"] != "priority:M" {
+		t.Errorf("Expected both tasks set to priority:M, got %v", modified)
+	}
+	if len(model.taskList.GetSelectedTasks()) != 1 {
+		t.Error("Expected the selection to be cleared")
+	}
+
+	// Lowering L clears the priority
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("<")})
+	cmd()
+	if modified["test-uuid-2"] != "priority:" {
+		t.Errorf("Expected the priority to be cleared, got %v", modified)
+	}
+}
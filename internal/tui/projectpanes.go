@@ -0,0 +1,134 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// projectPaneWidth returns the width of the project (left) pane in the two-pane Projects view
+func projectPaneWidth(totalWidth int) int {
+	w := totalWidth / 3
+	if w < 28 {
+		w = 28
+	}
+	if w > 50 {
+		w = 50
+	}
+	return w
+}
+
+// toggleProjectPanes switches the Projects tab between the group list and the two-pane view
+func (m Model) toggleProjectPanes() (tea.Model, tea.Cmd) {
+	if !m.sections.IsProjectsView() {
+		m.statusMessage = m.text(msgProjectPanesUnavailable)
+		return m, nil
+	}
+
+	m.selectedGroup = nil
+	m.groups = []core.TaskGroup{}
+	m.taskList.ClearSelection()
+
+	if m.viewMode == ViewModeProjectPanes {
+		// Back to the group list, which needs the project summaries again
+		m.viewMode = ViewModeList
+		m.inGroupView = true
+		m.updateComponentSizes()
+		m.isLoading = true
+		return m, loadTasksCmd(m.service, m.activeFilter, false, m.searchAnnotations())
+	}
+
+	m.viewMode = ViewModeProjectPanes
+	m.inGroupView = false
+	m.projectPaneFocused = true
+	m.projectPane.SetCursor(0)
+	m.updateComponentSizes()
+	m.refreshProjectPanes()
+	return m, nil
+}
+
+// refreshProjectPanes regroups the loaded tasks by project and refreshes both panes
+func (m *Model) refreshProjectPanes() {
+	m.projectPane.SetGroupTitle("PROJECT")
+	m.projectPane.SetGroups(core.GroupByProject(m.tasks))
+	m.syncProjectPaneTasks()
+}
+
+// syncProjectPaneTasks shows the tasks of the project under the project pane cursor
+func (m *Model) syncProjectPaneTasks() {
+	var tasks []core.Task
+	if group := m.projectPane.SelectedGroup(); group != nil {
+		tasks = group.Tasks
+	}
+
+	sortMethod := ""
+	reverse := false
+	if m.currentSection != nil {
+		sortMethod = m.currentSection.Sort
+		reverse = m.currentSection.Reverse
+	}
+	m.taskList.SetTasksWithSort(tasks, sortMethod, reverse)
+	m.sections.SetTaskCount(len(tasks))
+	m.updateSidebar()
+}
+
+// handleProjectPaneKeys handles pane focus and navigation in the two-pane Projects view.
+// It returns false when the key should go through the normal key handling, e.g. h
+// in the project pane or l and tab in the task pane move to another section.
+func (m Model) handleProjectPaneKeys(keyPressed string, msg tea.KeyMsg) (bool, tea.Model, tea.Cmd) {
+	switch keyPressed {
+	case "h":
+		if m.projectPaneFocused {
+			return false, m, nil
+		}
+		m.projectPaneFocused = true
+		return true, m, nil
+	case "l", "tab":
+		if !m.projectPaneFocused {
+			return false, m, nil
+		}
+		m.projectPaneFocused = false
+		return true, m, nil
+	case "enter":
+		if m.projectPaneFocused {
+			m.projectPaneFocused = false
+		}
+		return true, m, nil
+	}
+
+	if !m.projectPaneFocused {
+		return false, m, nil
+	}
+
+	if m.keyMatches(keyPressed, "up") || m.keyMatches(keyPressed, "down") ||
+		m.keyMatches(keyPressed, "first") || m.keyMatches(keyPressed, "last") ||
+		keyPressed == "up" || keyPressed == "down" {
+		previous := m.projectPane.Cursor()
+		m.projectPane = m.navigateList(m.projectPane, keyPressed, msg)
+		if m.projectPane.Cursor() != previous {
+			m.taskList.ClearSelection()
+			m.taskList.SetCursor(0)
+			m.syncProjectPaneTasks()
+		}
+		return true, m, nil
+	}
+
+	return false, m, nil
+}
+
+// renderProjectPanes renders the project list and the selected project's tasks side by side
+func (m Model) renderProjectPanes() string {
+	left := m.projectPane.View()
+	right := m.taskList.View()
+
+	height := max(lipgloss.Height(left), lipgloss.Height(right))
+	separatorStyle := m.styles.Separator
+	if m.projectPaneFocused {
+		separatorStyle = separatorStyle.Foreground(m.styles.Header.GetForeground())
+	}
+	separator := separatorStyle.Render(strings.TrimSuffix(strings.Repeat("│\n", height), "\n"))
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, separator, right)
+}
@@ -0,0 +1,234 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// createProjectsTabModel returns a model on the Projects tab with tasks in two projects
+func createProjectsTabModel(service core.TaskService) Model {
+	model := NewModel(service, config.DefaultConfig())
+	for i, section := range model.sections.Items {
+		if section.Name == "Projects" {
+			model.sections.ActiveIndex = i
+			model.currentSection = &model.sections.Items[i]
+		}
+	}
+	model.inGroupView = true
+	model.tasks = []core.Task{
+		{ID: 1, UUID: "home-1", Description: "Fix the sink", Project: "Home", Status: "pending"},
+		{ID: 2, UUID: "work-1", Description: "Write report", Project: "Work.reports", Status: "pending"},
+		{ID: 3, UUID: "work-2", Description: "Review PR", Project: "Work", Status: "pending"},
+		{ID: 4, UUID: "none-1", Description: "Buy milk", Status: "pending"},
+	}
+	model.width = 120
+	model.height = 30
+	model.updateComponentSizes()
+	return model
+}
+
+func pressKey(t *testing.T, model Model, key string) Model {
+	t.Helper()
+	var msg tea.KeyMsg
+	if key == "tab" {
+		msg = tea.KeyMsg{Type: tea.KeyTab}
+	} else {
+		msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	}
+	updated, _ := model.Update(msg)
+	return updated.(Model)
+}
+
+func TestProjectPanesToggle(t *testing.T) {
+	model := createProjectsTabModel(&core.MockTaskService{})
+
+	model = pressKey(t, model, "p")
+	if model.viewMode != ViewModeProjectPanes {
+		t.Fatalf("Expected view mode project_panes, got %v", model.viewMode)
+	}
+	if model.inGroupView {
+		t.Error("Expected inGroupView to be false in the two-pane view")
+	}
+	if !model.projectPaneFocused {
+		t.Error("Expected the project pane to have focus initially")
+	}
+
+	// Projects are grouped by main project, "(none)" last
+	group := model.projectPane.SelectedGroup()
+	if group == nil || group.Name != "Home" {
+		t.Fatalf("Expected first project to be Home, got %v", group)
+	}
+	if model.taskList.TaskCount() != 1 {
+		t.Errorf("Expected 1 task for Home, got %d", model.taskList.TaskCount())
+	}
+
+	// Toggling again returns to the group list and reloads
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	model = updated.(Model)
+	if model.viewMode != ViewModeList || !model.inGroupView {
+		t.Errorf("Expected group list view, got %v (inGroupView=%v)", model.viewMode, model.inGroupView)
+	}
+	if cmd == nil {
+		t.Error("Expected a reload command when leaving the two-pane view")
+	}
+}
+
+func TestProjectPanesToggleOutsideProjectsTab(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	model = pressKey(t, model, "p")
+	if model.viewMode == ViewModeProjectPanes {
+		t.Error("Expected two-pane view to be unavailable outside the Projects tab")
+	}
+}
+
+func TestProjectPanesNavigation(t *testing.T) {
+	model := createProjectsTabModel(&core.MockTaskService{})
+	model = pressKey(t, model, "p")
+
+	// Moving the project cursor updates the task pane
+	model = pressKey(t, model, "j")
+	if group := model.projectPane.SelectedGroup(); group == nil || group.Name != "Work" {
+		t.Fatalf("Expected Work project selected, got %v", group)
+	}
+	if model.taskList.TaskCount() != 2 {
+		t.Errorf("Expected 2 tasks for Work (including subprojects), got %d", model.taskList.TaskCount())
+	}
+
+	// l moves focus to the task pane, where j moves the task cursor
+	model = pressKey(t, model, "l")
+	if model.projectPaneFocused {
+		t.Fatal("Expected the task pane to have focus after l")
+	}
+	model = pressKey(t, model, "j")
+	if model.taskList.Cursor() != 1 {
+		t.Errorf("Expected task cursor at 1, got %d", model.taskList.Cursor())
+	}
+	if group := model.projectPane.SelectedGroup(); group == nil || group.Name != "Work" {
+		t.Errorf("Expected project selection to stay on Work, got %v", group)
+	}
+
+	// h moves focus back, tab moves it to the task pane again
+	model = pressKey(t, model, "h")
+	if !model.projectPaneFocused {
+		t.Error("Expected the project pane to have focus after h")
+	}
+	model = pressKey(t, model, "tab")
+	if model.projectPaneFocused {
+		t.Error("Expected tab to move focus to the task pane")
+	}
+	model = pressKey(t, model, "h")
+
+	// Changing project resets the task cursor
+	model = pressKey(t, model, "j")
+	if group := model.projectPane.SelectedGroup(); group == nil || group.Name != "(none)" {
+		t.Fatalf("Expected (none) project selected, got %v", group)
+	}
+	if model.taskList.Cursor() != 0 {
+		t.Errorf("Expected task cursor reset to 0, got %d", model.taskList.Cursor())
+	}
+}
+
+func TestProjectPanesOuterKeysChangeSection(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		toTasks   bool
+		key       tea.KeyMsg
+		wantDelta int
+	}{
+		{"h in the project pane", false, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}}, -1},
+		{"l in the task pane", true, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}}, 1},
+		{"tab in the task pane", true, tea.KeyMsg{Type: tea.KeyTab}, 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			model := createProjectsTabModel(&core.MockTaskService{})
+			model = pressKey(t, model, "p")
+			if tt.toTasks {
+				model = pressKey(t, model, "l")
+			}
+			before := model.sections.ActiveIndex
+
+			updated, _ := model.Update(tt.key)
+			model = updated.(Model)
+			if got := model.sections.ActiveIndex; got != before+tt.wantDelta {
+				t.Errorf("Expected section %d, got %d", before+tt.wantDelta, got)
+			}
+		})
+	}
+}
+
+func TestProjectPanesActionsTargetTaskPane(t *testing.T) {
+	var doneUUID string
+	service := &core.MockTaskService{
+		DoneFunc: func(uuid string) error {
+			doneUUID = uuid
+			return nil
+		},
+	}
+	model := createProjectsTabModel(service)
+	model = pressKey(t, model, "p")
+	model = pressKey(t, model, "j") // Work
+	model = pressKey(t, model, "l")
+
+	selected := model.taskList.SelectedTask()
+	if selected == nil {
+		t.Fatal("Expected a selected task in the task pane")
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected done command")
+	}
+	cmd()
+	if doneUUID != selected.UUID {
+		t.Errorf("Expected done on %q, got %q", selected.UUID, doneUUID)
+	}
+}
+
+func TestProjectPanesReloadKeepsView(t *testing.T) {
+	model := createProjectsTabModel(&core.MockTaskService{})
+	model = pressKey(t, model, "p")
+	model = pressKey(t, model, "j")
+
+	updated, _ := model.Update(TasksLoadedMsg{Tasks: model.tasks})
+	model = updated.(Model)
+	if model.viewMode != ViewModeProjectPanes {
+		t.Fatalf("Expected two-pane view after reload, got %v", model.viewMode)
+	}
+	if group := model.projectPane.SelectedGroup(); group == nil || group.Name != "Work" {
+		t.Errorf("Expected Work to stay selected after reload, got %v", group)
+	}
+}
+
+func TestProjectPanesLeftOnSectionChange(t *testing.T) {
+	model := createProjectsTabModel(&core.MockTaskService{})
+	model = pressKey(t, model, "p")
+
+	model.sections.ActiveIndex = 1
+	updated, _ := model.Update(components.SectionChangedMsg{Section: model.sections.Items[1]})
+	model = updated.(Model)
+	if model.viewMode != ViewModeList {
+		t.Errorf("Expected list view after leaving Projects, got %v", model.viewMode)
+	}
+}
+
+func TestRenderProjectPanes(t *testing.T) {
+	model := createProjectsTabModel(&core.MockTaskService{})
+	model = pressKey(t, model, "p")
+
+	view := model.View()
+	for _, want := range []string{"PROJECT", "Home", "Work", "Fix the sink", "│"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected two-pane view to contain %q", want)
+		}
+	}
+	if strings.Contains(view, "Write report") {
+		t.Error("Expected tasks of unselected projects to be hidden")
+	}
+}
@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// newProjectItem is the project picker entry that lets the user type a new project name
+const newProjectItem = "+ New project..."
+
+// projectPickerItems returns the existing projects followed by the new project entry.
+// The projects are the autocomplete ones, loaded from all the pending tasks, and
+// those of the tasks in the current tab, with their parent projects.
+func projectPickerItems(projects []string, tasks []core.Task) []string {
+	seen := make(map[string]bool)
+	var items []string
+	for _, project := range slices.Concat(projects, extractUniqueProjects(tasks)) {
+		parts := strings.Split(project, ".")
+		for i := range parts {
+			name := strings.Join(parts[:i+1], ".")
+			if !seen[name] {
+				seen[name] = true
+				items = append(items, name)
+			}
+		}
+	}
+	slices.Sort(items)
+	return append(items, newProjectItem)
+}
+
+// assignProjectModifications returns the modification that assigns tasks to project,
+// followed by the tag changes of the project rules that match it. A rule matches
+// its project and the subprojects (a rule for "Work" matches "Work.reports").
+func assignProjectModifications(rules []config.ProjectRule, project string) string {
+	args := []string{"project:" + project}
+	for _, rule := range rules {
+		if rule.Project == "" || (project != rule.Project && !strings.HasPrefix(project, rule.Project+".")) {
+			continue
+		}
+		for _, tag := range rule.AddTags {
+			if tag = strings.TrimPrefix(strings.TrimSpace(tag), "+"); tag != "" {
+				args = append(args, "+"+tag)
+			}
+		}
+		for _, tag := range rule.RemoveTags {
+			if tag = strings.TrimPrefix(strings.TrimSpace(tag), "-"); tag != "" {
+				args = append(args, "-"+tag)
+			}
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+// projectRuleModifications appends the tag changes of the project rules to
+// modifications typed by the user, when they assign a project
+func projectRuleModifications(rules []config.ProjectRule, modifications string) string {
+	for _, token := range strings.Fields(modifications) {
+		if project, ok := strings.CutPrefix(token, "project:"); ok && project != "" {
+			return modifications + strings.TrimPrefix(assignProjectModifications(rules, project), token)
+		}
+	}
+	return modifications
+}
+
+// activateProjectPicker opens the assign-to-project picker for the given tasks
+func (m *Model) activateProjectPicker(tasks []core.Task) {
+	m.projectPicker = components.NewListPicker("Assign to project", projectPickerItems(m.availableProjects, m.tasks), "")
+	m.projectPickerActive = true
+	m.projectPickerTasks = tasks
+	m.state = StateProjectPicker
+}
+
+// deactivateProjectPicker closes the assign-to-project picker
+func (m *Model) deactivateProjectPicker() {
+	m.projectPickerActive = false
+	m.projectPickerTasks = nil
+	m.state = StateNormal
+}
+
+// handleProjectPickerKeys handles input while the assign-to-project picker is shown.
+// Choosing the new project entry opens the modify prompt prefilled with "project:";
+// pressing enter when the search matches no project uses the search text as the new name.
+// The tag changes of matching project_rules are applied with the project, typed or chosen.
+func (m Model) handleProjectPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "enter":
+		selected := m.projectPicker.SelectedItem()
+		if selected == "" {
+			selected = m.projectPicker.Filter()
+		}
+		tasks := m.projectPickerTasks
+		m.deactivateProjectPicker()
+
+		switch selected {
+		case "":
+			return m, nil
+		case newProjectItem:
+			m.state = StateModifyInput
+			m.newProjectPrompt = true
+			m.modifyInput.SetValue("project:")
+			m.modifyInput.SetCursor(len("project:"))
+			m.updateComponentSizes()
+			return m, m.modifyInput.Focus()
+		default:
+			m.taskList.ClearSelection()
+			return m, modifyTasksCmd(m.service, tasks, assignProjectModifications(m.config.TUI.ProjectRules, selected))
+		}
+
+	case "esc":
+		m.deactivateProjectPicker()
+		return m, nil
+
+	default:
+		m.projectPicker, cmd = m.projectPicker.Update(msg)
+		return m, cmd
+	}
+}
@@ -0,0 +1,232 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestProjectPickerItems(t *testing.T) {
+	tasks := []core.Task{
+		{UUID: "1", Project: "Work.reports"},
+		{UUID: "2", Project: "Home"},
+		{UUID: "3"},
+	}
+	// Projects of pending tasks loaded for autocompletion, e.g. from other tabs
+	projects := []string{"Garden", "Home.kitchen"}
+
+	items := projectPickerItems(projects, tasks)
+	expected := []string{"Garden", "Home", "Home.kitchen", "Work", "Work.reports", newProjectItem}
+	if len(items) != len(expected) {
+		t.Fatalf("Expected items %v, got %v", expected, items)
+	}
+	for i := range expected {
+		if items[i] != expected[i] {
+			t.Errorf("Expected item %d to be %q, got %q", i, expected[i], items[i])
+		}
+	}
+}
+
+func TestAssignProjectModifications(t *testing.T) {
+	rules := []config.ProjectRule{
+		{Project: "Work", AddTags: []string{"office", "+billable"}, RemoveTags: []string{"home"}},
+		{Project: "Work.reports", AddTags: []string{"writing"}},
+		{Project: "Home", RemoveTags: []string{"-office", "billable"}},
+	}
+	tests := []struct {
+		project  string
+		expected string
+	}{
+		{"Work", "project:Work +office +billable -home"},
+		{"Work.reports", "project:Work.reports +office +billable -home +writing"},
+		{"Home", "project:Home -office -billable"},
+		{"Workshop", "project:Workshop"},
+		{"Garden", "project:Garden"},
+	}
+
+	for _, tt := range tests {
+		if got := assignProjectModifications(rules, tt.project); got != tt.expected {
+			t.Errorf("assignProjectModifications(%q) = %q, expected %q", tt.project, got, tt.expected)
+		}
+	}
+	if got := assignProjectModifications(nil, "Work"); got != "project:Work" {
+		t.Errorf("Expected only the project without rules, got %q", got)
+	}
+}
+
+func TestProjectPickerAppliesProjectRules(t *testing.T) {
+	var modifications string
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, mods string) error {
+			modifications = mods
+			return nil
+		},
+	}
+	model := createProjectPickerModel(service)
+	model.config.TUI.ProjectRules = []config.ProjectRule{{Project: "Home", AddTags: []string{"personal"}}}
+
+	model = pressKey(t, model, "P")
+	model = pressKey(t, model, "ho")
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected modify command")
+	}
+	cmd()
+	if modifications != "project:Home +personal" {
+		t.Errorf("Expected the rule's tags with the project, got %q", modifications)
+	}
+}
+
+// createProjectPickerModel returns a model with tasks in two projects and two of them selected
+func createProjectPickerModel(service core.TaskService) Model {
+	model := createTestModel(service)
+	model.tasks[0].Project = "Home"
+	model.tasks[1].Project = "Work"
+	model.taskList.SetTasks(model.tasks)
+
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+	return model
+}
+
+func TestProjectPickerAssignsExistingProject(t *testing.T) {
+	modified := map[string]string{}
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, modifications string) error {
+			modified[uuid] = modifications
+			return nil
+		},
+	}
+	model := createProjectPickerModel(service)
+
+	model = pressKey(t, model, "P")
+	if model.state != StateProjectPicker || !model.projectPickerActive {
+		t.Fatalf("Expected project picker to be open, got state %v", model.state)
+	}
+
+	// Search narrows the list to Work
+	model = pressKey(t, model, "wo")
+	if got := model.projectPicker.SelectedItem(); got != "Work" {
+		t.Fatalf("Expected Work to be selected after searching, got %q", got)
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.state != StateNormal || model.projectPickerActive {
+		t.Errorf("Expected picker to close, got state %v", model.state)
+	}
+	if cmd == nil {
+		t.Fatal("Expected modify command")
+	}
+	cmd()
+
+	if len(modified) != 2 {
+		t.Fatalf("Expected 2 tasks modified, got %d", len(modified))
+	}
+	for _, uuid := range []string{"test-uuid-1", "test-uuid-2"} {
+		if modified[uuid] != "project:Work" {
+			t.Errorf("Expected %s modified with project:Work, got %q", uuid, modified[uuid])
+		}
+	}
+}
+
+func TestProjectPickerSearchTextAsNewProject(t *testing.T) {
+	var modifications string
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, mods string) error {
+			modifications = mods
+			return nil
+		},
+	}
+	model := createProjectPickerModel(service)
+
+	model = pressKey(t, model, "P")
+	model = pressKey(t, model, "Garden.Shed")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected modify command")
+	}
+	cmd()
+	if modifications != "project:Garden.Shed" {
+		t.Errorf("Expected project:Garden.Shed, got %q", modifications)
+	}
+}
+
+func TestProjectPickerNewProjectOpensModifyInput(t *testing.T) {
+	model := createProjectPickerModel(&core.MockTaskService{})
+
+	model = pressKey(t, model, "P")
+	// Home, Work, then the new project entry
+	for range 2 {
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+		model = updated.(Model)
+	}
+	if got := model.projectPicker.SelectedItem(); got != newProjectItem {
+		t.Fatalf("Expected new project entry selected, got %q", got)
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.state != StateModifyInput {
+		t.Fatalf("Expected modify input, got state %v", model.state)
+	}
+	if got := model.modifyInput.Value(); got != "project:" {
+		t.Errorf("Expected modify input prefilled with project:, got %q", got)
+	}
+	if len(model.taskList.GetSelectedTasks()) != 2 {
+		t.Error("Expected selection to be kept for the modify prompt")
+	}
+}
+
+func TestProjectPickerEscCancels(t *testing.T) {
+	model := createProjectPickerModel(&core.MockTaskService{})
+
+	model = pressKey(t, model, "P")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if model.state != StateNormal || model.projectPickerActive {
+		t.Errorf("Expected picker to close on esc, got state %v", model.state)
+	}
+	if cmd != nil {
+		t.Error("Expected no command on esc")
+	}
+}
+
+func TestProjectPickerNewProjectAppliesProjectRules(t *testing.T) {
+	var modifications string
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, mods string) error {
+			modifications = mods
+			return nil
+		},
+	}
+	model := createProjectPickerModel(service)
+	model.config.TUI.ProjectRules = []config.ProjectRule{{Project: "Home", AddTags: []string{"personal"}}}
+
+	model = pressKey(t, model, "P")
+	for range 2 {
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+		model = updated.(Model)
+	}
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+
+	model = pressKey(t, model, "Home.Kitchen")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected modify command")
+	}
+	cmd()
+	if modifications != "project:Home.Kitchen +personal" {
+		t.Errorf("Expected the rule's tags with the new project, got %q", modifications)
+	}
+	if model.newProjectPrompt {
+		t.Error("Expected the next modify prompt not to apply project rules")
+	}
+}
@@ -0,0 +1,23 @@
+package tui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// cancelPendingConfirm drops the action waiting for confirmation when quit is
+// requested, so the next quit exits instead of leaving mid-confirm
+func (m Model) cancelPendingConfirm() (tea.Model, tea.Cmd) {
+	m.state = StateNormal
+	m.confirmAction = ""
+	m.statusMessage = m.text(msgPendingActionCancelled, m.actionKey("quit", "q"))
+	return m, nil
+}
+
+// requestQuit quits, unless warn_quit_with_selection is set and tasks are
+// multi-selected: the first quit then only warns, and a second one in a row quits
+func (m Model) requestQuit(warned bool) (tea.Model, tea.Cmd) {
+	if m.config.TUI.WarnQuitWithSelection && !warned && m.taskList.HasSelections() {
+		m.quitWarned = true
+		m.statusMessage = m.text(msgQuitWithSelection, len(m.taskList.GetSelectedTasks()), m.actionKey("quit", "q"))
+		return m, nil
+	}
+	return m.quit()
+}
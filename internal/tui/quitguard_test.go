@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// isQuit reports whether cmd quits the program
+func isQuit(cmd tea.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+	_, ok := cmd().(tea.QuitMsg)
+	return ok
+}
+
+func TestQuitWhileConfirmingCancelsAction(t *testing.T) {
+	deleted := false
+	service := &core.MockTaskService{
+		DeleteFunc: func(uuid string) error {
+			deleted = true
+			return nil
+		},
+	}
+
+	for _, quitKey := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("q")},
+		{Type: tea.KeyCtrlC},
+	} {
+		t.Run(quitKey.String(), func(t *testing.T) {
+			model := createTestModel(service)
+			model = pressKey(t, model, "x")
+			if model.state != StateConfirm {
+				t.Fatalf("Expected a delete confirmation, got state %v", model.state)
+			}
+
+			updated, cmd := model.Update(quitKey)
+			model = updated.(Model)
+			if model.state != StateNormal || model.confirmAction != "" {
+				t.Errorf("Expected the pending delete to be cancelled, got state %v action %q", model.state, model.confirmAction)
+			}
+			if isQuit(cmd) {
+				t.Error("Expected quitting mid-confirm not to exit")
+			}
+			if model.statusMessage != "Pending action cancelled; press q again to quit" {
+				t.Errorf("Unexpected status %q", model.statusMessage)
+			}
+
+			// Confirming afterwards does nothing, and quitting again exits
+			model = pressKey(t, model, "y")
+			updated, cmd = model.Update(quitKey)
+			if deleted {
+				t.Error("Expected no task to be deleted")
+			}
+			if !isQuit(cmd) {
+				t.Error("Expected the second quit to exit")
+			}
+		})
+	}
+}
+
+func TestWarnQuitWithSelection(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.WarnQuitWithSelection = true
+
+	// Without a selection quit exits right away
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); !isQuit(cmd) {
+		t.Fatal("Expected quit without a selection")
+	}
+
+	model = pressKey(t, model, " ")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	model = updated.(Model)
+	if isQuit(cmd) {
+		t.Fatal("Expected the first quit to only warn")
+	}
+	if model.statusMessage != "Selected tasks: 1; press q again to quit" {
+		t.Errorf("Unexpected warning %q", model.statusMessage)
+	}
+
+	// Another key in between resets the warning
+	model = pressKey(t, model, "j")
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	model = updated.(Model)
+	if isQuit(cmd) {
+		t.Fatal("Expected the warning to be shown again")
+	}
+
+	if _, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); !isQuit(cmd) {
+		t.Error("Expected the second quit in a row to exit")
+	}
+}
+
+func TestQuitWithSelectionWithoutWarning(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	model = pressKey(t, model, " ")
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); !isQuit(cmd) {
+		t.Error("Expected quit to exit when warn_quit_with_selection is off")
+	}
+}
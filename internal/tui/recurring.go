@@ -0,0 +1,138 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// recurrencePresets lists the recurrence periods offered by the new recurring task flow
+var recurrencePresets = []string{"daily", "weekly", "monthly"}
+
+// customRecurrenceItem is the recurrence picker entry that explains how to enter another period
+const customRecurrenceItem = "Custom (type a period)"
+
+// errRecurWithoutDue is returned when a task would recur without a due date
+var errRecurWithoutDue = errors.New("recurring tasks need a due date: add due:<date> next to recur:")
+
+// recurringDraft holds the answers collected by the new recurring task flow
+type recurringDraft struct {
+	description string // Description, optionally followed by other attributes
+	recur       string // Recurrence period (e.g. "weekly")
+}
+
+// validateRecurrence rejects add arguments that set recur: without due:,
+// which Taskwarrior refuses
+func validateRecurrence(args string) error {
+	var recur, due bool
+	for _, field := range strings.Fields(args) {
+		name, value, found := strings.Cut(field, ":")
+		if !found || value == "" {
+			continue
+		}
+		switch name {
+		case "recur":
+			recur = true
+		case "due":
+			due = true
+		}
+	}
+	if recur && !due {
+		return errRecurWithoutDue
+	}
+	return nil
+}
+
+// recurringAddArgs composes the add arguments for a recurring task
+func recurringAddArgs(description, recur string, due time.Time) (string, error) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return "", errors.New("description cannot be empty")
+	}
+	if recur == "" {
+		return "", errors.New("recurrence period cannot be empty")
+	}
+	if due.IsZero() {
+		return "", errRecurWithoutDue
+	}
+	return description + " recur:" + recur + " due:" + due.Format("2006-01-02"), nil
+}
+
+// startRecurringTask starts the new recurring task flow: description, then
+// recurrence period, then first due date
+func (m Model) startRecurringTask() (tea.Model, tea.Cmd) {
+	m.recurringTask = &recurringDraft{}
+	return m.openNewTaskInput("")
+}
+
+// cancelRecurringTask abandons the new recurring task flow
+func (m *Model) cancelRecurringTask() {
+	m.recurringTask = nil
+	m.recurrencePickerActive = false
+	m.state = StateNormal
+}
+
+// activateRecurrencePicker opens the recurrence period picker
+func (m *Model) activateRecurrencePicker() {
+	items := append(append([]string{}, recurrencePresets...), customRecurrenceItem)
+	m.recurrencePicker = components.NewListPicker("Repeat", items, "")
+	m.recurrencePickerActive = true
+	m.state = StateRecurrencePicker
+}
+
+// selectedRecurrence returns the period chosen in the recurrence picker: the selected
+// preset, or the search text when it does not start a preset (e.g. "2weeks")
+func selectedRecurrence(selected, search string) string {
+	search = strings.TrimSpace(search)
+	if search == "" || strings.HasPrefix(strings.ToLower(selected), strings.ToLower(search)) {
+		if selected == customRecurrenceItem {
+			return ""
+		}
+		return selected
+	}
+	return search
+}
+
+// handleRecurrencePickerKeys handles input while the recurrence period picker is shown
+func (m Model) handleRecurrencePickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "enter":
+		selected := selectedRecurrence(m.recurrencePicker.SelectedItem(), m.recurrencePicker.Filter())
+		if selected == "" || strings.ContainsAny(selected, " \t") {
+			// Wait for a valid period to be typed
+			return m, nil
+		}
+
+		m.recurringTask.recur = selected
+		m.recurrencePickerActive = false
+		m.state = StateNormal
+		m.activateCalendar("due", 0, StateNormal)
+		return m, nil
+
+	case "esc":
+		m.cancelRecurringTask()
+		return m, nil
+
+	default:
+		m.recurrencePicker, cmd = m.recurrencePicker.Update(msg)
+		return m, cmd
+	}
+}
+
+// finishRecurringTask adds the recurring task with the due date chosen in the calendar
+func (m Model) finishRecurringTask(due time.Time) (tea.Model, tea.Cmd) {
+	draft := m.recurringTask
+	m.cancelRecurringTask()
+
+	args, err := recurringAddArgs(draft.description, draft.recur, due)
+	if err != nil {
+		m.errorMessage = err.Error()
+		return m, nil
+	}
+	return m, addTaskCmd(m.service, args)
+}
@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestRecurringAddArgs(t *testing.T) {
+	due := time.Date(2026, 11, 2, 0, 0, 0, 0, time.Local)
+
+	args, err := recurringAddArgs("  Pay rent project:Home ", "monthly", due)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "Pay rent project:Home recur:monthly due:2026-11-02"; args != expected {
+		t.Errorf("Expected %q, got %q", expected, args)
+	}
+
+	if _, err := recurringAddArgs("", "weekly", due); err == nil {
+		t.Error("Expected error for an empty description")
+	}
+	if _, err := recurringAddArgs("Pay rent", "", due); err == nil {
+		t.Error("Expected error for an empty recurrence period")
+	}
+	if _, err := recurringAddArgs("Pay rent", "weekly", time.Time{}); err != errRecurWithoutDue {
+		t.Errorf("Expected recur without due to be rejected, got %v", err)
+	}
+}
+
+func TestSelectedRecurrence(t *testing.T) {
+	tests := []struct {
+		selected, search, expected string
+	}{
+		{"weekly", "", "weekly"},
+		{"weekly", "wee", "weekly"},
+		{"weekly", "wk", "wk"},
+		{"", "2weeks", "2weeks"},
+		{customRecurrenceItem, "", ""},
+		{customRecurrenceItem, "cu", ""},
+		{customRecurrenceItem, "quarterly", "quarterly"},
+	}
+
+	for _, tt := range tests {
+		if got := selectedRecurrence(tt.selected, tt.search); got != tt.expected {
+			t.Errorf("selectedRecurrence(%q, %q) = %q, expected %q", tt.selected, tt.search, got, tt.expected)
+		}
+	}
+}
+
+func TestValidateRecurrence(t *testing.T) {
+	tests := []struct {
+		args    string
+		wantErr bool
+	}{
+		{"Water plants recur:weekly due:saturday", false},
+		{"Water plants recur:weekly", true},
+		{"Water plants recur:weekly due:", true},
+		{"Water plants due:saturday", false},
+		{"Water plants recur: due:saturday", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			if err := validateRecurrence(tt.args); (err != nil) != tt.wantErr {
+				t.Errorf("validateRecurrence(%q) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddTaskCmdRejectsRecurWithoutDue(t *testing.T) {
+	added := false
+	service := &core.MockTaskService{
+		AddFunc: func(description string) (string, error) {
+			added = true
+			return "new-uuid", nil
+		},
+	}
+
+	msg := addTaskCmd(service, "Water plants recur:weekly")()
+	if modified, ok := msg.(TaskModifiedMsg); !ok || modified.Err != errRecurWithoutDue {
+		t.Errorf("Expected recur without due to be rejected, got %+v", msg)
+	}
+	if added {
+		t.Error("Expected the task not to be added")
+	}
+}
+
+func TestNewRecurringTaskFlow(t *testing.T) {
+	var added string
+	service := &core.MockTaskService{
+		AddFunc: func(description string) (string, error) {
+			added = description
+			return "new-uuid", nil
+		},
+	}
+	model := createTestModel(service)
+
+	model = pressKey(t, model, "R")
+	if model.state != StateNewTaskInput || model.recurringTask == nil {
+		t.Fatalf("Expected the recurring task description input, got state %v", model.state)
+	}
+	model.newTaskInput.SetValue("Water plants +home")
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.state != StateRecurrencePicker || !model.recurrencePickerActive {
+		t.Fatalf("Expected the recurrence picker, got state %v", model.state)
+	}
+
+	// A period that is not a preset is typed in the picker search
+	model = pressKey(t, model, "2weeks")
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if !model.calendarActive || model.recurrencePickerActive {
+		t.Fatal("Expected the calendar to choose the first due date")
+	}
+
+	due := model.calendar.GetSelectedDate().Format("2006-01-02")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.calendarActive || model.recurringTask != nil || model.state != StateNormal {
+		t.Errorf("Expected the flow to end, got state %v", model.state)
+	}
+	if cmd == nil {
+		t.Fatal("Expected add command")
+	}
+	cmd()
+	if expected := "Water plants +home recur:2weeks due:" + due; added != expected {
+		t.Errorf("Expected add %q, got %q", expected, added)
+	}
+}
+
+func TestNewRecurringTaskPresetAndCancel(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	model = pressKey(t, model, "R")
+	model.newTaskInput.SetValue("Standup")
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+
+	// The custom entry waits for a typed period
+	for range len(recurrencePresets) {
+		updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+		model = updated.(Model)
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if !model.recurrencePickerActive {
+		t.Fatal("Expected the picker to stay open on the custom entry")
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyUp})
+	model = updated.(Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.recurringTask == nil || model.recurringTask.recur != "monthly" {
+		t.Fatalf("Expected the monthly preset, got %+v", model.recurringTask)
+	}
+
+	// Cancelling the calendar abandons the flow
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if model.calendarActive || model.recurringTask != nil || model.state != StateNormal {
+		t.Errorf("Expected the flow to be cancelled, got state %v", model.state)
+	}
+	if cmd != nil {
+		t.Error("Expected no command on esc")
+	}
+}
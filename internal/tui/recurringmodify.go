@@ -0,0 +1,88 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// Choices of the menu shown when modifying instances of a recurring task, the
+// question Taskwarrior asks with rc.recurrence.confirmation=prompt
+const (
+	recurrenceScopeInstance = "This instance"
+	recurrenceScopeAll      = "All future instances"
+)
+
+// hasRecurringInstance reports whether any of the tasks was generated by a recurring task
+func hasRecurringInstance(tasks []core.Task) bool {
+	for _, task := range tasks {
+		if task.IsRecurringInstance() {
+			return true
+		}
+	}
+	return false
+}
+
+// activateRecurrenceScopePicker asks whether the modifications apply to the chosen
+// instances only or to all the future instances of their recurring tasks
+func (m *Model) activateRecurrenceScopePicker(tasks []core.Task, modifications string) {
+	items := []string{recurrenceScopeInstance, recurrenceScopeAll}
+	m.recurrenceScopePicker = components.NewListPicker("Modify recurring task", items, "")
+	m.recurrenceScopePickerActive = true
+	m.recurrenceScopeTasks = tasks
+	m.recurrenceScopeModifications = modifications
+	m.state = StateRecurrenceScopePicker
+}
+
+// deactivateRecurrenceScopePicker closes the recurrence scope menu
+func (m *Model) deactivateRecurrenceScopePicker() {
+	m.recurrenceScopePickerActive = false
+	m.recurrenceScopeTasks = nil
+	m.recurrenceScopeModifications = ""
+	m.state = StateNormal
+}
+
+// handleRecurrenceScopePickerKeys handles input while the recurrence scope menu is shown
+func (m Model) handleRecurrenceScopePickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "enter":
+		all := m.recurrenceScopePicker.SelectedItem() == recurrenceScopeAll
+		tasks := m.recurrenceScopeTasks
+		modifications := m.recurrenceScopeModifications
+		m.deactivateRecurrenceScopePicker()
+		m.taskList.ClearSelection()
+		return m, modifyRecurringTasksCmd(m.service, tasks, modifications, all)
+
+	case "esc":
+		m.deactivateRecurrenceScopePicker()
+		return m, nil
+
+	default:
+		m.recurrenceScopePicker, cmd = m.recurrenceScopePicker.Update(msg)
+		return m, cmd
+	}
+}
+
+// modifyRecurringTasksCmd modifies tasks, applying the chosen scope to the instances
+// of recurring tasks. Other tasks are modified as usual.
+func modifyRecurringTasksCmd(service core.TaskService, tasks []core.Task, modifications string, all bool) tea.Cmd {
+	return func() tea.Msg {
+		var firstErr error
+		for _, task := range tasks {
+			var err error
+			if task.IsRecurringInstance() {
+				err = service.ModifyRecurring(task.UUID, modifications, all)
+			} else {
+				err = service.Modify(task.UUID, modifications)
+			}
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		return TaskModifiedMsg{
+			Err: firstErr,
+		}
+	}
+}
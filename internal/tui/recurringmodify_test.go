@@ -0,0 +1,123 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// modifyCall records a modification applied through the service
+type modifyCall struct {
+	recurring bool // Applied with ModifyRecurring
+	all       bool // Scope passed to ModifyRecurring
+}
+
+// createRecurringModifyModel returns a model listing a recurring task instance and a
+// plain task, and the calls its service receives by task UUID
+func createRecurringModifyModel() (Model, map[string]modifyCall) {
+	calls := map[string]modifyCall{}
+	service := &core.MockTaskService{
+		ModifyFunc: func(uuid, modifications string) error {
+			calls[uuid] = modifyCall{}
+			return nil
+		},
+		ModifyRecurringFunc: func(uuid, modifications string, all bool) error {
+			calls[uuid] = modifyCall{recurring: true, all: all}
+			return nil
+		},
+	}
+	model := createTestModel(service)
+	model.tasks = []core.Task{
+		{UUID: "instance", Description: "Water plants", Recur: "weekly", Parent: "template"},
+		{UUID: "plain", Description: "Buy milk"},
+	}
+	model.taskList.SetTasks(model.tasks)
+	return model, calls
+}
+
+// submitModify types modifications in the modify input and applies them
+func submitModify(t *testing.T, model Model, modifications string) (Model, tea.Cmd) {
+	t.Helper()
+	model = pressKey(t, model, "m")
+	if model.state != StateModifyInput {
+		t.Fatalf("Expected the modify input, got state %v", model.state)
+	}
+	model.modifyInput.SetValue(modifications)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return updated.(Model), cmd
+}
+
+func TestModifyPlainTaskSkipsRecurrenceScope(t *testing.T) {
+	model, calls := createRecurringModifyModel()
+	model.taskList.MoveCursorDown()
+
+	model, cmd := submitModify(t, model, "priority:H")
+	if model.state != StateNormal || cmd == nil {
+		t.Fatalf("Expected the modification to run at once, got state %v", model.state)
+	}
+	cmd()
+	if call, ok := calls["plain"]; !ok || call.recurring {
+		t.Errorf("Expected a plain modify, got %+v", calls)
+	}
+}
+
+func TestModifyRecurringInstanceScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		moves int // Menu rows to move down before enter
+		all   bool
+	}{
+		{recurrenceScopeInstance, 0, false},
+		{recurrenceScopeAll, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model, calls := createRecurringModifyModel()
+
+			// Select both the instance and the plain task
+			model.taskList.ToggleSelection()
+			model.taskList.MoveCursorDown()
+			model.taskList.ToggleSelection()
+
+			model, cmd := submitModify(t, model, "priority:H")
+			if model.state != StateRecurrenceScopePicker || cmd != nil {
+				t.Fatalf("Expected the recurrence scope menu before modifying, got state %v", model.state)
+			}
+
+			for range tt.moves {
+				updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+				model = updated.(Model)
+			}
+			updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			model = updated.(Model)
+			if model.state != StateNormal || cmd == nil {
+				t.Fatalf("Expected the menu to close and modify, got state %v", model.state)
+			}
+			cmd()
+
+			if call := calls["instance"]; !call.recurring || call.all != tt.all {
+				t.Errorf("Expected ModifyRecurring with all=%v for the instance, got %+v", tt.all, call)
+			}
+			if call, ok := calls["plain"]; !ok || call.recurring {
+				t.Errorf("Expected a plain modify for the other task, got %+v", call)
+			}
+		})
+	}
+}
+
+func TestModifyRecurringInstanceCancel(t *testing.T) {
+	model, calls := createRecurringModifyModel()
+
+	model, _ = submitModify(t, model, "priority:H")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+
+	if model.state != StateNormal || model.recurrenceScopePickerActive || cmd != nil {
+		t.Errorf("Expected esc to cancel the modification, got state %v", model.state)
+	}
+	if len(calls) != 0 {
+		t.Errorf("Expected no modification, got %+v", calls)
+	}
+}
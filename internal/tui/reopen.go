@@ -0,0 +1,42 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// confirmReopen is the confirm action that sets completed tasks back to pending
+const confirmReopen = "reopen"
+
+// completedTasks returns the completed tasks among tasks
+func completedTasks(tasks []core.Task) []core.Task {
+	var completed []core.Task
+	for _, task := range tasks {
+		if task.Status == "completed" {
+			completed = append(completed, task)
+		}
+	}
+	return completed
+}
+
+// startReopen asks to confirm reopening the selected completed task(s), or reopens
+// them directly when confirmations are disabled
+func (m Model) startReopen() (tea.Model, tea.Cmd) {
+	tasks := completedTasks(m.taskList.GetSelectedTasks())
+	if len(tasks) == 0 {
+		m.statusMessage = m.text(msgNotCompleted)
+		return m, nil
+	}
+	if m.noConfirm {
+		m.taskList.ClearSelection()
+		return m, reopenTasksCmd(m.service, tasks)
+	}
+	m.state = StateConfirm
+	m.confirmAction = confirmReopen
+	return m, nil
+}
+
+// reopenTasksCmd creates a command to set completed tasks back to pending
+func reopenTasksCmd(service core.TaskService, tasks []core.Task) tea.Cmd {
+	return modifyTasksCmd(service, tasks, "status:pending")
+}
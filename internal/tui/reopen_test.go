@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// createReopenModel returns a model listing a pending and a completed task, with
+// the cursor on the completed one and the Modify calls recorded in calls
+func createReopenModel(calls *[]string) Model {
+	model := createTestModel(&core.MockTaskService{
+		ModifyFunc: func(uuid, modifications string) error {
+			*calls = append(*calls, uuid+" "+modifications)
+			return nil
+		},
+	})
+	model.tasks = []core.Task{
+		{ID: 1, UUID: "pending-1", Description: "Open task", Status: "pending"},
+		{UUID: "done-1", Description: "Finished task", Status: "completed"},
+	}
+	model.taskList.SetTasks(model.tasks)
+	model.taskList.SetCursor(1)
+	return model
+}
+
+func TestReopenCompletedTask(t *testing.T) {
+	var calls []string
+	model := createReopenModel(&calls)
+
+	model = pressKey(t, model, "O")
+	if model.state != StateConfirm || model.confirmAction != confirmReopen {
+		t.Fatalf("Expected a confirmation, got state %v action %q", model.state, model.confirmAction)
+	}
+	if prompt := model.confirmMessage(); prompt != "Reopen task 'Finished task'? (y/N)" {
+		t.Errorf("Unexpected prompt %q", prompt)
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a modify command")
+	}
+	if _, ok := cmd().(TaskModifiedMsg); !ok {
+		t.Error("Expected a task modified message")
+	}
+	if expected := []string{"done-1 status:pending"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+}
+
+func TestReopenCancelled(t *testing.T) {
+	var calls []string
+	model := createReopenModel(&calls)
+
+	model = pressKey(t, model, "O")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if cmd != nil || model.state != StateNormal || len(calls) > 0 {
+		t.Errorf("Expected reopen to be cancelled, got %v", calls)
+	}
+}
+
+func TestReopenOnlyCompletedTasks(t *testing.T) {
+	var calls []string
+	model := createReopenModel(&calls)
+	model.noConfirm = true
+
+	// Pending tasks are not reopened
+	model.taskList.SetCursor(0)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("O")})
+	model = updated.(Model)
+	if cmd != nil || model.statusMessage != "Only completed tasks can be reopened" {
+		t.Errorf("Expected pending tasks to be left alone, got status %q", model.statusMessage)
+	}
+
+	// Only the completed tasks of a selection are reopened, without confirmation
+	model.taskList.SetCursor(0)
+	model.taskList.ToggleSelection()
+	model.taskList.SetCursor(1)
+	model.taskList.ToggleSelection()
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("O")})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a modify command")
+	}
+	cmd()
+	if expected := []string{"done-1 status:pending"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+	if model.taskList.HasSelections() {
+		t.Error("Expected the selection to be cleared")
+	}
+}
@@ -0,0 +1,74 @@
+package tui
+
+import "strings"
+
+// searchAnnotations reports whether the Search tab should match plain terms against annotations
+func (m Model) searchAnnotations() bool {
+	return m.config.TUI != nil && m.config.TUI.SearchAnnotations
+}
+
+// filterOperators are taskwarrior filter keywords that are never search terms
+var filterOperators = map[string]bool{
+	"and": true, "or": true, "xor": true, "not": true,
+	"(": true, ")": true,
+}
+
+// isSearchTerm reports whether a filter token is a plain text search term, as opposed to
+// an attribute filter (project:x), a tag (+x/-x), a pattern (/x/), an operator or a task ID
+func isSearchTerm(token string) bool {
+	if token == "" || filterOperators[strings.ToLower(token)] {
+		return false
+	}
+	if strings.ContainsAny(token, ":~=<>()") {
+		return false
+	}
+	switch token[0] {
+	case '+', '-', '/':
+		return false
+	}
+	// IDs and ID lists/ranges (e.g. 12, 1-3, 4,7)
+	if strings.Trim(token, "0123456789,-") == "" {
+		return false
+	}
+	return true
+}
+
+// annotationSearchFilter rewrites plain search terms so they match either the description
+// or the annotation text, e.g. "status.any: bug" becomes
+// "status.any: ( description ~ bug or annotations ~ bug )". Other tokens are kept as is.
+func annotationSearchFilter(filter string) string {
+	tokens := strings.Fields(filter)
+	for i, token := range tokens {
+		if isSearchTerm(token) {
+			tokens[i] = "( description ~ " + token + " or annotations ~ " + token + " )"
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// taskFilter returns the filter passed to taskwarrior when loading tasks for a tab
+func taskFilter(filter string, isSearchTab bool, searchAnnotations bool) string {
+	// In Search tab, we want to search ALL tasks in the database by default
+	// unless the user explicitly filters by status
+	actualFilter := filter
+	if isSearchTab {
+		// Check if user already specified a status filter
+		// Common patterns: "status:", "status.not:", "status.is:"
+		hasStatusFilter := strings.Contains(filter, "status:")
+
+		// If no status filter specified, search across ALL tasks
+		// By using "status.any:" we tell taskwarrior to search all statuses
+		if !hasStatusFilter {
+			// Prepend status.any: to search all tasks regardless of status
+			// This searches pending, completed, deleted, waiting, and recurring tasks
+			actualFilter = "status.any: " + filter
+		}
+
+		// Explicitly match plain search terms against annotation text too
+		if searchAnnotations {
+			actualFilter = annotationSearchFilter(actualFilter)
+		}
+	}
+
+	return actualFilter
+}
@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestAnnotationSearchFilter(t *testing.T) {
+	tests := []struct {
+		filter   string
+		expected string
+	}{
+		{"bug", "( description ~ bug or annotations ~ bug )"},
+		{"status.any: bug", "status.any: ( description ~ bug or annotations ~ bug )"},
+		{"project:home +urgent", "project:home +urgent"},
+		{"login crash", "( description ~ login or annotations ~ login ) ( description ~ crash or annotations ~ crash )"},
+		{"bug or crash", "( description ~ bug or annotations ~ bug ) or ( description ~ crash or annotations ~ crash )"},
+		{"-work due.before:eom", "-work due.before:eom"},
+		{"/regex/", "/regex/"},
+		{"12 1-3 4,7", "12 1-3 4,7"},
+		{"description~bug", "description~bug"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			if got := annotationSearchFilter(tt.filter); got != tt.expected {
+				t.Errorf("annotationSearchFilter(%q) = %q, expected %q", tt.filter, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoadTasksCmdSearchAnnotations(t *testing.T) {
+	var exported string
+	service := &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			exported = filter
+			return []core.Task{}, nil
+		},
+	}
+
+	loadTasksCmd(service, "bug +urgent", true, true)()
+	if exported != "status.any: ( description ~ bug or annotations ~ bug ) +urgent" {
+		t.Errorf("Unexpected search filter with annotations: %q", exported)
+	}
+
+	loadTasksCmd(service, "bug +urgent", true, false)()
+	if exported != "status.any: bug +urgent" {
+		t.Errorf("Expected plain search filter when disabled, got %q", exported)
+	}
+
+	// Only the Search tab composes annotation terms
+	loadTasksCmd(service, "status:pending bug", false, true)()
+	if exported != "status:pending bug" {
+		t.Errorf("Expected tab filter to be unchanged, got %q", exported)
+	}
+}
+
+func TestSearchAnnotationsConfig(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	if model.searchAnnotations() {
+		t.Error("Expected annotation search to be off by default")
+	}
+	model.config.TUI.SearchAnnotations = true
+	if !model.searchAnnotations() {
+		t.Error("Expected annotation search to follow tui.search_annotations")
+	}
+}
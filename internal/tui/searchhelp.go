@@ -0,0 +1,24 @@
+package tui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// searchEmptyMessage returns the message shown by the Search tab before a search:
+// tui.search_help, or the built-in examples when it is not set
+func (m Model) searchEmptyMessage() string {
+	if m.config != nil && m.config.TUI != nil && m.config.TUI.SearchHelp != "" {
+		return m.config.TUI.SearchHelp
+	}
+	return m.text(msgEmptySearch)
+}
+
+// focusSearchFilter opens the filter input after switching to the Search tab
+// when tui.search_auto_focus is set
+func (m *Model) focusSearchFilter() tea.Cmd {
+	if !m.config.TUI.SearchAutoFocus || m.state != StateNormal {
+		return nil
+	}
+	m.state = StateFilterInput
+	m.filter.SetValue(initialFilterValue(m.config.TUI.FilterEditMode, m.activeFilter))
+	m.updateComponentSizes()
+	return m.filter.Focus()
+}
@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// switchToSearch switches the model to the Search tab and loads no tasks
+func switchToSearch(t *testing.T, model Model) Model {
+	t.Helper()
+	updated, cmd := model.Update(components.SectionChangedMsg{Section: model.sections.Items[0]})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected the Search tab to load tasks")
+	}
+	model, _ = loadTasks(model, []core.Task{})
+	return model
+}
+
+func TestSearchHelpDefault(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	model = switchToSearch(t, model)
+	if model.state != StateNormal {
+		t.Errorf("Expected the filter input to stay closed by default, got state %v", model.state)
+	}
+	if view := model.taskList.View(); !strings.Contains(view, "Search across all tasks") {
+		t.Errorf("Expected the built-in search help, got:\n%s", view)
+	}
+}
+
+func TestSearchHelpConfigured(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TUI.SearchHelp = "Press / to search"
+	model := NewModel(&core.MockTaskService{}, cfg)
+	model.width = 100
+	model.height = 30
+	model.updateComponentSizes()
+
+	model = switchToSearch(t, model)
+	view := model.taskList.View()
+	if !strings.Contains(view, "Press / to search") || strings.Contains(view, "Examples") {
+		t.Errorf("Expected the configured search help, got:\n%s", view)
+	}
+}
+
+func TestSearchAutoFocus(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.SearchAutoFocus = true
+	model.searchTabFilter = "project:home"
+
+	model = switchToSearch(t, model)
+	if model.state != StateFilterInput {
+		t.Fatalf("Expected the filter input to open on the Search tab, got state %v", model.state)
+	}
+	if got := model.filter.Value(); got != "project:home " {
+		t.Errorf("Expected the saved search in the input, got %q", got)
+	}
+
+	// Other tabs do not open it
+	model = createTestModel(&core.MockTaskService{})
+	model.config.TUI.SearchAutoFocus = true
+	updated, _ := model.Update(components.SectionChangedMsg{Section: model.sections.Items[1]})
+	if state := updated.(Model).state; state != StateNormal {
+		t.Errorf("Expected other tabs to leave the filter input closed, got state %v", state)
+	}
+}
@@ -0,0 +1,25 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// sidebarFiles returns the entries of the sidebar Files section for task
+func sidebarFiles(task *core.Task) []string {
+	var files []string
+	for _, match := range ExtractFilePathsFromAnnotations(task) {
+		files = append(files, match.FormatForDisplay())
+	}
+	return files
+}
+
+// openSidebarFile opens the file highlighted in the sidebar Files section
+func (m Model) openSidebarFile() tea.Cmd {
+	matches := ExtractFilePathsFromAnnotations(m.taskList.SelectedTask())
+	index := m.sidebar.SelectedFile()
+	if index < 0 || index >= len(matches) {
+		return nil
+	}
+	return openFileCmd(m.language(), matches[index].Path)
+}
@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestSidebarFiles(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model, _ = loadTasks(model, []core.Task{{
+		UUID:        "uuid-1",
+		ID:          1,
+		Description: "Write report",
+		Status:      "pending",
+		Annotations: []core.Annotation{
+			{Description: "Draft in /tmp/wui-missing/report.md"},
+			{Description: "Same draft: file:///tmp/wui-missing/report.md"},
+			{Description: "Data in /tmp/wui-missing/data.csv"},
+		},
+	}})
+	model.viewMode = ViewModeTaskDetail
+	model.sidebar.SetSize(120, 30)
+	model.updateSidebar()
+
+	view := model.sidebar.View()
+	if !strings.Contains(view, "Files") {
+		t.Fatalf("Expected a Files section, got:\n%s", view)
+	}
+	for _, expected := range []string{"▸ Draft in /tmp/wui-missing/report.md", "  Data in /tmp/wui-missing/data.csv"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the Files section, got:\n%s", expected, view)
+		}
+	}
+	if strings.Contains(view, "▸ Same draft") {
+		t.Errorf("Expected the duplicate path to be listed once, got:\n%s", view)
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	model = updated.(Model)
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if cmd == nil {
+		t.Fatal("Expected a command to open the highlighted file")
+	}
+	if msg, ok := cmd().(StatusMsg); !ok || !strings.Contains(msg.Message, "/tmp/wui-missing/data.csv") {
+		t.Errorf("Expected the second file to be opened, got %+v", msg)
+	}
+}
+
+func TestSidebarFilesHidden(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.viewMode = ViewModeTaskDetail
+	model.updateSidebar()
+
+	if view := model.sidebar.View(); strings.Contains(view, "Files") {
+		t.Errorf("Expected no Files section without paths, got:\n%s", view)
+	}
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlO}); cmd != nil {
+		t.Error("Expected no command without files")
+	}
+}
+
+func TestSidebarFilesInSplitView(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model, _ = loadTasks(model, []core.Task{{
+		UUID:        "uuid-1",
+		Description: "Write report",
+		Status:      "pending",
+		Annotations: []core.Annotation{
+			{Description: "Draft in /tmp/wui-missing/report.md"},
+			{Description: "Data in /tmp/wui-missing/data.csv"},
+		},
+	}})
+	model.viewMode = ViewModeListWithSidebar
+	model.updateComponentSizes()
+	model.updateSidebar()
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	model = updated.(Model)
+	if model.sidebar.SelectedFile() != 1 {
+		t.Fatalf("Expected ctrl+n to highlight the second file, got %d", model.sidebar.SelectedFile())
+	}
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if cmd == nil {
+		t.Fatal("Expected a command to open the highlighted file")
+	}
+	if msg, ok := cmd().(StatusMsg); !ok || !strings.Contains(msg.Message, "/tmp/wui-missing/data.csv") {
+		t.Errorf("Expected the second file to be opened, got %+v", msg)
+	}
+}
@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// confirmStartStop is the confirm action that starts or stops several tasks at once
+const confirmStartStop = "start_stop"
+
+// startStartStop toggles start/stop on the selected task(s). Several tasks are only
+// toggled after a confirmation, unless confirmations are disabled, since starting
+// many tasks at once is usually a stray selection.
+func (m Model) startStartStop() (tea.Model, tea.Cmd) {
+	selectedTasks := m.taskList.GetSelectedTasks()
+	if len(selectedTasks) == 0 {
+		return m, nil
+	}
+	if len(selectedTasks) > 1 && !m.noConfirm {
+		m.state = StateConfirm
+		m.confirmAction = confirmStartStop
+		return m, nil
+	}
+	m.taskList.ClearSelection()
+	return m, toggleStartStopCmd(m.service, selectedTasks, m.autoAnnotations())
+}
+
+// expandSelectionCount replaces {{.count}} in a confirm prompt with the number of selected tasks
+func (m Model) expandSelectionCount(template string) string {
+	return strings.ReplaceAll(template, "{{.count}}", strconv.Itoa(len(m.taskList.GetSelectedTasks())))
+}
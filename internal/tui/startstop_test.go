@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// createStartStopModel returns a model whose Start calls are recorded in started
+func createStartStopModel(started *[]string) Model {
+	return createTestModel(&core.MockTaskService{
+		StartFunc: func(uuid string) error {
+			*started = append(*started, uuid)
+			return nil
+		},
+	})
+}
+
+// selectFirstTwo selects the first two tasks of the list
+func selectFirstTwo(model Model) Model {
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+	return model
+}
+
+func TestStartSingleTaskImmediate(t *testing.T) {
+	var started []string
+	model := createStartStopModel(&started)
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	model = updated.(Model)
+	if model.state != StateNormal {
+		t.Fatalf("Expected no confirmation for one task, got state %v", model.state)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a start command")
+	}
+	cmd()
+	if expected := []string{"test-uuid-1"}; !reflect.DeepEqual(started, expected) {
+		t.Errorf("Expected %v started, got %v", expected, started)
+	}
+}
+
+func TestStartManyTasksConfirm(t *testing.T) {
+	var started []string
+	model := selectFirstTwo(createStartStopModel(&started))
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	model = updated.(Model)
+	if model.state != StateConfirm || model.confirmAction != confirmStartStop {
+		t.Fatalf("Expected a confirmation, got state %v action %q", model.state, model.confirmAction)
+	}
+	if cmd != nil {
+		t.Error("Expected no command before confirming")
+	}
+	if prompt := model.confirmMessage(); prompt != "Start/stop 2 tasks? (y/N)" {
+		t.Errorf("Unexpected prompt %q", prompt)
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a start command")
+	}
+	cmd()
+	if expected := []string{"test-uuid-1", "test-uuid-2"}; !reflect.DeepEqual(started, expected) {
+		t.Errorf("Expected %v started, got %v", expected, started)
+	}
+	if model.confirmAction != "" || len(model.taskList.GetSelectedTasks()) > 1 {
+		t.Error("Expected the confirmation and selection to be cleared")
+	}
+}
+
+func TestStartManyTasksCancelled(t *testing.T) {
+	var started []string
+	model := selectFirstTwo(createStartStopModel(&started))
+
+	model = pressKey(t, model, "s")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if model.state != StateNormal || cmd != nil || len(started) != 0 {
+		t.Errorf("Expected nothing to be started, got state %v and %v", model.state, started)
+	}
+}
+
+func TestStartManyTasksNoConfirm(t *testing.T) {
+	var started []string
+	model := selectFirstTwo(createStartStopModel(&started))
+	model.noConfirm = true
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	model = updated.(Model)
+	if model.state != StateNormal || cmd == nil {
+		t.Fatalf("Expected the tasks to be toggled directly, got state %v", model.state)
+	}
+	cmd()
+	if len(started) != 2 {
+		t.Errorf("Expected both tasks started, got %v", started)
+	}
+}
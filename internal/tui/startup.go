@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Values of tui.startup_action
+const (
+	startupNone         = "none"     // Start on the default tab (default)
+	startupNewTask      = "new_task" // Open the new task input
+	startupFilterPrefix = "filter:"  // Apply the filter that follows the prefix
+)
+
+// startupActionMsg runs the configured startup action once wui has started
+type startupActionMsg struct {
+	action string
+}
+
+// parseStartupAction returns the startup action and, for filter actions, the
+// filter to apply. Unknown actions fall back to none.
+func parseStartupAction(value string) (action, filter string) {
+	value = strings.TrimSpace(value)
+	switch {
+	case value == "" || value == startupNone:
+		return startupNone, ""
+	case value == startupNewTask:
+		return startupNewTask, ""
+	case strings.HasPrefix(value, startupFilterPrefix):
+		filter = strings.TrimSpace(strings.TrimPrefix(value, startupFilterPrefix))
+		if filter != "" {
+			return startupFilterPrefix, filter
+		}
+	}
+	slog.Warn("Ignoring unknown startup_action", "value", value)
+	return startupNone, ""
+}
+
+// startupAction returns the configured startup action. The --search flag takes
+// precedence, so no action runs when it is set.
+func (m Model) startupAction() (action, filter string) {
+	if m.config == nil || m.config.TUI == nil || m.config.InitialSearchFilter != "" {
+		return startupNone, ""
+	}
+	return parseStartupAction(m.config.TUI.StartupAction)
+}
+
+// startupActionCmd returns the command run by Init for startup actions that
+// need the model to be running. Filters are applied by NewModel instead, so the
+// first load already uses them.
+func (m Model) startupActionCmd() tea.Cmd {
+	action, _ := m.startupAction()
+	if action != startupNewTask {
+		return nil
+	}
+	return func() tea.Msg { return startupActionMsg{action: action} }
+}
+
+// runStartupAction runs a startup action delivered by startupActionMsg
+func (m Model) runStartupAction(msg startupActionMsg) (tea.Model, tea.Cmd) {
+	if msg.action == startupNewTask && m.state == StateNormal {
+		return m.startNewTask()
+	}
+	return m, nil
+}
@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// startModel creates a model with the given startup action and --search filter,
+// runs the commands returned by Init and delivers their messages to the model.
+// It returns the model and the filters passed to Export.
+func startModel(t *testing.T, startupAction, search string) (Model, []string) {
+	t.Helper()
+	var exported []string
+	service := &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			exported = append(exported, filter)
+			return []core.Task{}, nil
+		},
+	}
+	cfg := config.DefaultConfig()
+	cfg.TUI.StartupAction = startupAction
+	cfg.InitialSearchFilter = search
+	model := NewModel(service, cfg)
+	model.width = 100
+	model.height = 30
+
+	batch, ok := model.Init()().(tea.BatchMsg)
+	if !ok {
+		t.Fatal("Expected Init to return a batch of commands")
+	}
+	for _, cmd := range batch {
+		if cmd == nil {
+			continue
+		}
+		updated, _ := model.Update(cmd())
+		model = updated.(Model)
+	}
+	return model, exported
+}
+
+func TestParseStartupAction(t *testing.T) {
+	tests := []struct {
+		value, action, filter string
+	}{
+		{"", startupNone, ""},
+		{"none", startupNone, ""},
+		{"new_task", startupNewTask, ""},
+		{"filter: +next project:Home", startupFilterPrefix, "+next project:Home"},
+		{"filter:", startupNone, ""},
+		{"bogus", startupNone, ""},
+	}
+
+	for _, tt := range tests {
+		action, filter := parseStartupAction(tt.value)
+		if action != tt.action || filter != tt.filter {
+			t.Errorf("parseStartupAction(%q) = %q, %q, expected %q, %q", tt.value, action, filter, tt.action, tt.filter)
+		}
+	}
+}
+
+func TestStartupActionNone(t *testing.T) {
+	model, _ := startModel(t, "none", "")
+	if model.state != StateNormal || model.activeFilter != model.currentSection.Filter {
+		t.Errorf("Expected the default tab, got state %v filter %q", model.state, model.activeFilter)
+	}
+}
+
+func TestStartupActionNewTask(t *testing.T) {
+	model, _ := startModel(t, "new_task", "")
+	if model.state != StateNewTaskInput {
+		t.Errorf("Expected the new task input, got state %v", model.state)
+	}
+}
+
+func TestStartupActionFilter(t *testing.T) {
+	model, exported := startModel(t, "filter:+work", "")
+	if model.state != StateNormal || model.activeFilter != "+work" {
+		t.Errorf("Expected the +work filter, got state %v filter %q", model.state, model.activeFilter)
+	}
+	if len(exported) == 0 || !strings.HasPrefix(exported[0], "+work") {
+		t.Errorf("Expected the first load to use the startup filter, got %q", exported)
+	}
+}
+
+func TestStartupActionSearchTakesPrecedence(t *testing.T) {
+	for _, action := range []string{"new_task", "filter:+work"} {
+		model, _ := startModel(t, action, "bug")
+		if model.state != StateNormal || model.activeFilter == "+work" {
+			t.Errorf("%s: expected --search to win, got state %v filter %q", action, model.state, model.activeFilter)
+		}
+		if model.sections.ActiveIndex != 0 || model.searchTabFilter != "bug" {
+			t.Errorf("%s: expected the Search tab with the search filter, got tab %d filter %q", action, model.sections.ActiveIndex, model.searchTabFilter)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// statusOrder is the order of the statuses in the task count breakdown; other
+// statuses follow alphabetically
+var statusOrder = []string{"pending", "waiting", "recurring", "completed", "deleted"}
+
+// statusBreakdown summarizes the number of tasks of each status, e.g.
+// "12 pending, 3 waiting, 2 completed"
+func statusBreakdown(tasks []core.Task) string {
+	counts := core.CountByStatus(tasks)
+
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		if !slices.Contains(statusOrder, status) {
+			statuses = append(statuses, status)
+		}
+	}
+	slices.Sort(statuses)
+	statuses = append(slices.Clone(statusOrder), statuses...)
+
+	var parts []string
+	for _, status := range statuses {
+		if count := counts[status]; count > 0 {
+			if status == "" {
+				status = "unknown"
+			}
+			parts = append(parts, fmt.Sprintf("%d %s", count, status))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,36 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestStatusBreakdown(t *testing.T) {
+	tasks := []core.Task{
+		{UUID: "1", Status: "completed"},
+		{UUID: "2", Status: "pending"},
+		{UUID: "3", Status: "waiting"},
+		{UUID: "4", Status: "pending"},
+		{UUID: "5", Status: "completed"},
+	}
+	if got, expected := statusBreakdown(tasks), "2 pending, 1 waiting, 2 completed"; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+	if got := statusBreakdown(nil); got != "" {
+		t.Errorf("Expected no breakdown without tasks, got %q", got)
+	}
+}
+
+func TestFooterShowsStatusBreakdown(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.width = 200
+	model, _ = loadTasks(model, []core.Task{
+		{UUID: "1", Description: "Open", Status: "pending"},
+		{UUID: "2", Description: "Done", Status: "completed"},
+	})
+	if !strings.Contains(model.renderFooter(), "1 pending, 1 completed") {
+		t.Errorf("Expected the status breakdown in the footer, got %q", model.renderFooter())
+	}
+}
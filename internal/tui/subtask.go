@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// Values of tui.subtask_direction
+const (
+	subtaskBlocksParent    = "blocks_parent"     // The parent depends on the new subtask (default)
+	subtaskDependsOnParent = "depends_on_parent" // The new subtask depends on the parent
+)
+
+// startSubtask opens the new task input to add a subtask of the task under the cursor
+func (m Model) startSubtask() (tea.Model, tea.Cmd) {
+	task := m.taskList.SelectedTask()
+	if task == nil || m.inGroupView {
+		m.statusMessage = m.text(msgNoTaskSelected)
+		return m, nil
+	}
+	parent := *task
+	m.subtaskParent = &parent
+	return m.openNewTaskInput("")
+}
+
+// subtaskDependency returns the task to modify and the modification that links
+// a new subtask to its parent in the configured direction
+func subtaskDependency(direction, parentUUID, subtaskUUID string) (string, string) {
+	if direction == subtaskDependsOnParent {
+		return subtaskUUID, "depends:" + parentUUID
+	}
+	return parentUUID, "depends:" + subtaskUUID
+}
+
+// addSubtaskCmd adds a task, then links it to its parent with a dependency
+func addSubtaskCmd(service core.TaskService, description, parentUUID, direction string) tea.Cmd {
+	return func() tea.Msg {
+		if err := validateRecurrence(description); err != nil {
+			return TaskModifiedMsg{Err: err}
+		}
+		uuid, err := service.Add(description)
+		if err != nil {
+			return TaskModifiedMsg{Err: err}
+		}
+
+		target, modification := subtaskDependency(direction, parentUUID, uuid)
+		if err := service.Modify(target, modification); err != nil {
+			return TaskModifiedMsg{Err: fmt.Errorf("subtask added but not linked to its parent: %w", err)}
+		}
+		return TaskModifiedMsg{Err: nil}
+	}
+}
@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// subtaskService records the Add and Modify calls in calls, returning "new-uuid" for added tasks
+func subtaskService(calls *[]string) *core.MockTaskService {
+	return &core.MockTaskService{
+		AddFunc: func(description string) (string, error) {
+			*calls = append(*calls, "add "+description)
+			return "new-uuid", nil
+		},
+		ModifyFunc: func(uuid, modifications string) error {
+			*calls = append(*calls, "modify "+uuid+" "+modifications)
+			return nil
+		},
+	}
+}
+
+// submitSubtask adds a subtask of the task under the cursor through the input
+func submitSubtask(t *testing.T, model Model, description string) (Model, tea.Cmd) {
+	t.Helper()
+	model = pressKey(t, model, "A")
+	if model.state != StateNewTaskInput || model.subtaskParent == nil {
+		t.Fatalf("Expected the subtask input, got state %v", model.state)
+	}
+	model.newTaskInput.SetValue(description)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return updated.(Model), cmd
+}
+
+func TestAddSubtaskBlocksParent(t *testing.T) {
+	var calls []string
+	model := createTestModel(subtaskService(&calls))
+	parent := model.taskList.SelectedTask().UUID
+
+	model, cmd := submitSubtask(t, model, "Draft outline +docs")
+	if model.subtaskParent != nil || model.state != StateNormal {
+		t.Errorf("Expected the subtask flow to end, got state %v", model.state)
+	}
+	if cmd == nil {
+		t.Fatal("Expected an add command")
+	}
+	if msg := cmd(); msg != (TaskModifiedMsg{}) {
+		t.Errorf("Expected success, got %+v", msg)
+	}
+
+	expected := []string{"add Draft outline +docs", "modify " + parent + " depends:new-uuid"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestAddSubtaskDependsOnParent(t *testing.T) {
+	var calls []string
+	model := createTestModel(subtaskService(&calls))
+	model.config.TUI.SubtaskDirection = subtaskDependsOnParent
+	parent := model.taskList.SelectedTask().UUID
+
+	_, cmd := submitSubtask(t, model, "Follow up")
+	cmd()
+
+	expected := []string{"add Follow up", "modify new-uuid depends:" + parent}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestAddSubtaskFailedAddSkipsModify(t *testing.T) {
+	modified := false
+	service := &core.MockTaskService{
+		AddFunc: func(description string) (string, error) {
+			return "", errors.New("add failed")
+		},
+		ModifyFunc: func(uuid, modifications string) error {
+			modified = true
+			return nil
+		},
+	}
+
+	msg := addSubtaskCmd(service, "Subtask", "parent", subtaskBlocksParent)()
+	if result, ok := msg.(TaskModifiedMsg); !ok || result.Err == nil {
+		t.Errorf("Expected the add error, got %+v", msg)
+	}
+	if modified {
+		t.Error("Expected no dependency to be set")
+	}
+}
+
+func TestAddSubtaskCancel(t *testing.T) {
+	var calls []string
+	model := createTestModel(subtaskService(&calls))
+
+	model = pressKey(t, model, "A")
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if model.subtaskParent != nil {
+		t.Error("Expected esc to cancel the subtask")
+	}
+
+	// A plain new task afterwards is not linked
+	model = pressKey(t, model, "n")
+	model.newTaskInput.SetValue("Unrelated")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	cmd()
+	if !reflect.DeepEqual(calls, []string{"add Unrelated"}) {
+		t.Errorf("Expected a plain add, got %v", calls)
+	}
+}
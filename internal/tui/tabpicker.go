@@ -0,0 +1,52 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// openTabPicker lists the tabs hidden behind the tabs bar's "More" entry
+func (m Model) openTabPicker() (tea.Model, tea.Cmd) {
+	if !m.sections.HasOverflow() {
+		m.statusMessage = m.text(msgNoHiddenTabs)
+		return m, nil
+	}
+
+	var names []string
+	for _, section := range m.sections.OverflowItems() {
+		names = append(names, section.Name)
+	}
+	m.tabPicker = components.NewListPicker("More tabs", names, "")
+	m.tabPickerActive = true
+	m.state = StateTabPicker
+	return m, nil
+}
+
+// deactivateTabPicker closes the hidden tabs picker
+func (m *Model) deactivateTabPicker() {
+	m.tabPickerActive = false
+	m.state = StateNormal
+}
+
+// handleTabPickerKeys handles input while the hidden tabs picker is shown
+func (m Model) handleTabPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "enter":
+		selected := m.tabPicker.SelectedItem()
+		m.deactivateTabPicker()
+		if selected == "" {
+			return m, nil
+		}
+		return m, m.sections.ActivateOverflow(selected)
+
+	case "esc":
+		m.deactivateTabPicker()
+		return m, nil
+
+	default:
+		m.tabPicker, cmd = m.tabPicker.Update(msg)
+		return m, cmd
+	}
+}
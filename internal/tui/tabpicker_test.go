@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+func TestTabPickerActivatesHiddenTab(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TUI.Tabs = []config.Tab{
+		{Name: "Next", Filter: "status:pending"},
+		{Name: "Work", Filter: "+work"},
+		{Name: "Someday", Filter: "+someday"},
+	}
+	cfg.TUI.MaxVisibleTabs = 2
+	model := NewModel(&core.MockTaskService{}, cfg)
+	model.width = 100
+	model.height = 30
+
+	model = pressKey(t, model, "0")
+	if model.state != StateTabPicker || !model.tabPickerActive {
+		t.Fatalf("Expected the hidden tabs picker, got state %v", model.state)
+	}
+
+	model = pressKey(t, model, "some")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.tabPickerActive || model.state != StateNormal {
+		t.Errorf("Expected the picker to close, got state %v", model.state)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a section change")
+	}
+	msg, ok := cmd().(components.SectionChangedMsg)
+	if !ok || msg.Section.Name != "Someday" {
+		t.Errorf("Expected a change to Someday, got %+v", msg)
+	}
+}
+
+func TestTabPickerWithoutHiddenTabs(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	model = pressKey(t, model, "0")
+	if model.tabPickerActive {
+		t.Error("Expected no picker when all tabs are shown")
+	}
+	if model.statusMessage != "All tabs are shown (see tui.max_visible_tabs)" {
+		t.Errorf("Expected the no hidden tabs status, got %q", model.statusMessage)
+	}
+}
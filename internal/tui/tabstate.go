@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"log/slog"
+
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// tabFilter returns the filter to load for a tab: the last filter applied in it,
+// or its configured filter
+func (m Model) tabFilter(section core.Section) string {
+	if section.Name == "Search" {
+		return m.searchTabFilter
+	}
+	if filter, ok := m.tabFilters[section.Name]; ok {
+		return filter
+	}
+	return section.Filter
+}
+
+// rememberTabFilter records the filter applied in the current tab, so switching
+// back to the tab or restarting wui shows it again
+func (m *Model) rememberTabFilter(filter string) {
+	if m.currentSection == nil {
+		return
+	}
+	if m.currentSection.Name == "Search" {
+		m.searchTabFilter = filter
+		return
+	}
+	if filter == m.currentSection.Filter {
+		// Back to the configured filter
+		delete(m.tabFilters, m.currentSection.Name)
+		return
+	}
+	if m.tabFilters == nil {
+		m.tabFilters = make(map[string]string)
+	}
+	m.tabFilters[m.currentSection.Name] = filter
+}
+
+// restoreState reopens the tab and filters of the previous session. Tabs that were
+// renamed or removed since are ignored, and so are the filters of tabs whose
+// configured filter changed. With --search, wui stays on the Search tab.
+func (m *Model) restoreState(path string) {
+	state, err := config.LoadState(path)
+	if err != nil {
+		slog.Warn("Ignoring wui state", "path", path, "error", err)
+		return
+	}
+
+	for _, section := range m.sections.Items {
+		filter, ok := state.TabFilters[section.Name]
+		if !ok {
+			continue
+		}
+		if section.Name != "Search" {
+			if state.ConfiguredFilters[section.Name] != section.Filter {
+				// The configured filter changed since: it wins over the remembered one
+				continue
+			}
+			if m.tabFilters == nil {
+				m.tabFilters = make(map[string]string)
+			}
+			m.tabFilters[section.Name] = filter
+		} else if m.config.InitialSearchFilter == "" {
+			m.searchTabFilter = filter
+		}
+	}
+
+	if m.config.InitialSearchFilter != "" {
+		return
+	}
+	index := state.ActiveTab
+	if index < 0 || index >= len(m.sections.Items) || m.sections.Items[index].Name != state.ActiveTabName {
+		// The tabs changed: look the tab up by name
+		index = -1
+		for i, section := range m.sections.Items {
+			if section.Name == state.ActiveTabName {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return
+		}
+	}
+
+	m.sections.ActiveIndex = index
+	section := m.sections.Items[index]
+	m.currentSection = &section
+	m.inGroupView = m.sections.IsProjectsView() || m.sections.IsTagsView()
+	m.applyTabViewMode(section)
+	m.activeFilter = m.tabFilter(section)
+}
+
+// saveState records the active tab and the filter of each tab for the next session
+func (m Model) saveState(path string) {
+	state := &config.State{
+		ActiveTab:         m.sections.ActiveIndex,
+		TabFilters:        make(map[string]string),
+		ConfiguredFilters: make(map[string]string),
+	}
+	if m.currentSection != nil {
+		state.ActiveTabName = m.currentSection.Name
+	}
+	for _, section := range m.sections.Items {
+		if filter, ok := m.tabFilters[section.Name]; ok {
+			state.TabFilters[section.Name] = filter
+			state.ConfiguredFilters[section.Name] = section.Filter
+		}
+	}
+	if m.searchTabFilter != "" {
+		state.TabFilters["Search"] = m.searchTabFilter
+	}
+
+	if err := config.SaveState(state, path); err != nil {
+		slog.Warn("Failed to save wui state", "path", path, "error", err)
+	}
+}
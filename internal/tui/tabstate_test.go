@@ -0,0 +1,195 @@
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// applyFilter types a filter in the current tab and applies it
+func applyFilter(t *testing.T, model Model, filter string) Model {
+	t.Helper()
+	model = pressKey(t, model, "/")
+	model.filter.SetValue(filter)
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return updated.(Model)
+}
+
+// switchTab activates the tab at the given index
+func switchTab(model Model, index int) Model {
+	model.sections.ActiveIndex = index
+	updated, _ := model.Update(components.SectionChangedMsg{Section: model.sections.Items[index]})
+	return updated.(Model)
+}
+
+// writeState saves a state file for NewModel to restore
+func writeState(t *testing.T, state *config.State) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "state.yaml")
+	if err := config.SaveState(state, path); err != nil {
+		t.Fatalf("Failed to write state: %v", err)
+	}
+	return path
+}
+
+func TestTabFilterRememberedAcrossTabSwitch(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	model = applyFilter(t, model, "+work")
+	if model.activeFilter != "+work" {
+		t.Fatalf("Expected the filter to apply, got %q", model.activeFilter)
+	}
+
+	model = switchTab(model, 2)
+	if model.activeFilter != "status:waiting" {
+		t.Errorf("Expected the Waiting tab to keep its own filter, got %q", model.activeFilter)
+	}
+
+	model = switchTab(model, 1)
+	if model.activeFilter != "+work" {
+		t.Errorf("Expected the Next tab to show its last filter again, got %q", model.activeFilter)
+	}
+
+	// Applying the configured filter again forgets the entry
+	model = applyFilter(t, model, model.currentSection.Filter)
+	if _, ok := model.tabFilters["Next"]; ok {
+		t.Errorf("Expected no entry for a tab back on its configured filter, got %v", model.tabFilters)
+	}
+}
+
+func TestRestoreState(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.StatePath = writeState(t, &config.State{
+		ActiveTab:     2,
+		ActiveTabName: "Waiting",
+		TabFilters: map[string]string{
+			"Waiting": "status:waiting +home",
+			"Next":    "+work",
+			"Search":  "rent",
+		},
+		ConfiguredFilters: map[string]string{
+			"Waiting": "status:waiting",
+			"Next":    "( status:pending or status:active ) -WAITING",
+		},
+	})
+
+	model := NewModel(&core.MockTaskService{}, cfg)
+
+	if model.currentSection == nil || model.currentSection.Name != "Waiting" || model.sections.ActiveIndex != 2 {
+		t.Fatalf("Expected the Waiting tab to be restored, got %v", model.currentSection)
+	}
+	if model.activeFilter != "status:waiting +home" {
+		t.Errorf("Expected the Waiting filter to be restored, got %q", model.activeFilter)
+	}
+	if model.searchTabFilter != "rent" {
+		t.Errorf("Expected the Search filter to be restored, got %q", model.searchTabFilter)
+	}
+
+	model = switchTab(model, 1)
+	if model.activeFilter != "+work" {
+		t.Errorf("Expected the Next filter to be restored, got %q", model.activeFilter)
+	}
+}
+
+func TestRestoreStateMovedTab(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.StatePath = writeState(t, &config.State{ActiveTab: 5, ActiveTabName: "Waiting"})
+
+	model := NewModel(&core.MockTaskService{}, cfg)
+
+	if model.currentSection == nil || model.currentSection.Name != "Waiting" || model.sections.ActiveIndex != 2 {
+		t.Errorf("Expected the Waiting tab to be found by name, got %v", model.currentSection)
+	}
+}
+
+func TestRestoreStateIgnoresStaleEntries(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.StatePath = writeState(t, &config.State{
+		ActiveTab:     3,
+		ActiveTabName: "Someday",
+		TabFilters:    map[string]string{"Someday": "+someday"},
+	})
+
+	model := NewModel(&core.MockTaskService{}, cfg)
+
+	if model.currentSection == nil || model.currentSection.Name != "Next" {
+		t.Errorf("Expected the default tab for a removed tab, got %v", model.currentSection)
+	}
+	if len(model.tabFilters) != 0 {
+		t.Errorf("Expected filters of removed tabs to be ignored, got %v", model.tabFilters)
+	}
+}
+
+func TestRestoreStateDropsFilterOfChangedTab(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.StatePath = writeState(t, &config.State{
+		ActiveTab:         2,
+		ActiveTabName:     "Waiting",
+		TabFilters:        map[string]string{"Waiting": "status:waiting +home", "Next": "+work"},
+		ConfiguredFilters: map[string]string{"Waiting": "status:waiting -someday"},
+	})
+
+	model := NewModel(&core.MockTaskService{}, cfg)
+
+	// Waiting was configured differently when its filter was saved, and Next
+	// has no configured filter recorded
+	if model.activeFilter != "status:waiting" {
+		t.Errorf("Expected the new configured filter of Waiting, got %q", model.activeFilter)
+	}
+	if len(model.tabFilters) != 0 {
+		t.Errorf("Expected the stale filters to be dropped, got %v", model.tabFilters)
+	}
+}
+
+func TestRestoreStateWithSearchFlag(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.InitialSearchFilter = "due:today"
+	cfg.StatePath = writeState(t, &config.State{
+		ActiveTab:     2,
+		ActiveTabName: "Waiting",
+		TabFilters: map[string]string{
+			"Search": "rent",
+			"Next":   "+work",
+		},
+		ConfiguredFilters: map[string]string{"Next": "( status:pending or status:active ) -WAITING"},
+	})
+
+	model := NewModel(&core.MockTaskService{}, cfg)
+
+	if model.currentSection == nil || model.currentSection.Name != "Search" {
+		t.Errorf("Expected --search to open the Search tab, got %v", model.currentSection)
+	}
+	if model.searchTabFilter != "due:today" {
+		t.Errorf("Expected the --search filter to win over the saved one, got %q", model.searchTabFilter)
+	}
+	if model.tabFilters["Next"] != "+work" {
+		t.Errorf("Expected the other tab filters to be restored, got %v", model.tabFilters)
+	}
+}
+
+func TestSaveState(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model = applyFilter(t, model, "+work")
+	model = switchTab(model, 2)
+
+	path := filepath.Join(t.TempDir(), "state.yaml")
+	model.saveState(path)
+
+	state, err := config.LoadState(path)
+	if err != nil {
+		t.Fatalf("Failed to load saved state: %v", err)
+	}
+	if state.ActiveTab != 2 || state.ActiveTabName != "Waiting" {
+		t.Errorf("Expected the Waiting tab to be saved, got %d (%s)", state.ActiveTab, state.ActiveTabName)
+	}
+	if len(state.TabFilters) != 1 || state.TabFilters["Next"] != "+work" {
+		t.Errorf("Expected only the changed Next filter to be saved, got %v", state.TabFilters)
+	}
+	if len(state.ConfiguredFilters) != 1 || state.ConfiguredFilters["Next"] != model.sections.Items[1].Filter {
+		t.Errorf("Expected the configured Next filter to be saved with it, got %v", state.ConfiguredFilters)
+	}
+}
@@ -0,0 +1,35 @@
+package tui
+
+import "github.com/clobrano/wui/internal/core"
+
+// tabViewModes maps the values accepted in tabs[].view_mode to view modes
+var tabViewModes = map[string]ViewMode{
+	"list":    ViewModeList,
+	"sidebar": ViewModeListWithSidebar,
+}
+
+// applyTabViewMode switches to the view mode configured for section. Sections without
+// one get back the view mode that was active before a section changed it. Small screen,
+// detail and two-pane views are left alone.
+func (m *Model) applyTabViewMode(section core.Section) {
+	if m.viewMode != ViewModeList && m.viewMode != ViewModeListWithSidebar {
+		return
+	}
+
+	mode, ok := tabViewModes[section.ViewMode]
+	if !ok {
+		if m.tabViewModeSet {
+			m.tabViewModeSet = false
+			m.viewMode = m.globalViewMode
+			m.sidebarPinned = m.viewMode == ViewModeListWithSidebar
+		}
+		return
+	}
+
+	if !m.tabViewModeSet {
+		m.tabViewModeSet = true
+		m.globalViewMode = m.viewMode
+	}
+	m.viewMode = mode
+	m.sidebarPinned = mode == ViewModeListWithSidebar
+}
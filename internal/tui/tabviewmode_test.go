@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// createTabViewModeModel returns a model with a "Review" tab that opens the sidebar
+// and a "Focus" tab that closes it
+func createTabViewModeModel() Model {
+	cfg := config.DefaultConfig()
+	cfg.TUI.Tabs = []config.Tab{
+		{Name: "Next", Filter: "status:pending"},
+		{Name: "Review", Filter: "+review", ViewMode: "sidebar"},
+		{Name: "Focus", Filter: "+focus", ViewMode: "list"},
+	}
+	model := NewModel(&core.MockTaskService{}, cfg)
+	model.width = 120
+	model.height = 30
+	return model
+}
+
+// switchSection delivers a section change to the model
+func switchSection(model Model, index int) Model {
+	updated, _ := model.Update(components.SectionChangedMsg{Section: model.sections.Items[index]})
+	return updated.(Model)
+}
+
+func TestTabViewModeOnSectionSwitch(t *testing.T) {
+	model := createTabViewModeModel()
+
+	model = switchSection(model, 2)
+	if model.viewMode != ViewModeListWithSidebar {
+		t.Fatalf("Expected the Review tab to open the sidebar, got %v", model.viewMode)
+	}
+
+	model = switchSection(model, 3)
+	if model.viewMode != ViewModeList {
+		t.Errorf("Expected the Focus tab to close the sidebar, got %v", model.viewMode)
+	}
+
+	model = switchSection(model, 2)
+	model = switchSection(model, 1)
+	if model.viewMode != ViewModeList {
+		t.Errorf("Expected a tab without view_mode to restore the list view, got %v", model.viewMode)
+	}
+}
+
+func TestTabViewModeRestoresGlobalSidebar(t *testing.T) {
+	model := createTabViewModeModel()
+	model.viewMode = ViewModeListWithSidebar
+
+	model = switchSection(model, 3)
+	if model.viewMode != ViewModeList {
+		t.Fatalf("Expected the Focus tab to close the sidebar, got %v", model.viewMode)
+	}
+	model = switchSection(model, 1)
+	if model.viewMode != ViewModeListWithSidebar {
+		t.Errorf("Expected the sidebar to be restored, got %v", model.viewMode)
+	}
+}
+
+func TestTabViewModeKeepsManualChoice(t *testing.T) {
+	model := createTabViewModeModel()
+
+	model = switchSection(model, 2)
+	model = pressKey(t, model, "v")
+	if model.viewMode != ViewModeList {
+		t.Fatalf("Expected cycle_view to close the sidebar, got %v", model.viewMode)
+	}
+	model = switchSection(model, 1)
+	if model.viewMode != ViewModeList {
+		t.Errorf("Expected the manually chosen view to be kept, got %v", model.viewMode)
+	}
+}
+
+func TestTabViewModeIgnoredOnSmallScreens(t *testing.T) {
+	model := createTabViewModeModel()
+	model.width = 60
+	model.viewMode = ViewModeSmall
+
+	model = switchSection(model, 2)
+	if model.viewMode != ViewModeSmall {
+		t.Errorf("Expected the small screen view to be kept, got %v", model.viewMode)
+	}
+}
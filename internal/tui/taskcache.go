@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/clobrano/wui/internal/core"
+)
+
+// cachedExport is the result of an export and when it was loaded
+type cachedExport struct {
+	tasks    []core.Task
+	loadedAt time.Time
+}
+
+// cachedService wraps a TaskService and reuses the tasks exported for a filter
+// for a short time (tui.cache_ttl_seconds), so quickly switching between tabs does
+// not export them again. Any change made through the service drops the cache.
+type cachedService struct {
+	core.TaskService
+	ttl time.Duration
+
+	mu         sync.Mutex
+	exports    map[string]cachedExport // Keyed by filter
+	generation int                     // Incremented by Invalidate, so exports started before it are not stored
+}
+
+// newCachedService returns service with a cache of exports kept for ttl
+func newCachedService(service core.TaskService, ttl time.Duration) *cachedService {
+	return &cachedService{
+		TaskService: service,
+		ttl:         ttl,
+		exports:     make(map[string]cachedExport),
+	}
+}
+
+// Export returns the tasks exported for filter within the TTL, or exports them again
+func (s *cachedService) Export(filter string) ([]core.Task, error) {
+	s.mu.Lock()
+	entry, ok := s.exports[filter]
+	generation := s.generation
+	s.mu.Unlock()
+	if ok && core.Now().Sub(entry.loadedAt) < s.ttl {
+		return slices.Clone(entry.tasks), nil
+	}
+
+	tasks, err := s.TaskService.Export(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if s.generation == generation {
+		s.exports[filter] = cachedExport{tasks: slices.Clone(tasks), loadedAt: core.Now()}
+	}
+	s.mu.Unlock()
+	return tasks, nil
+}
+
+// cached reports whether the tasks of filter are cached and within the TTL
+func (s *cachedService) cached(filter string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.exports[filter]
+	return ok && core.Now().Sub(entry.loadedAt) < s.ttl
+}
+
+// Invalidate drops all cached exports. It is safe to call on a nil cache.
+func (s *cachedService) Invalidate() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	clear(s.exports)
+	s.generation++
+	s.mu.Unlock()
+}
+
+// Modify updates a task and drops the cache
+func (s *cachedService) Modify(uuid, modifications string) error {
+	defer s.Invalidate()
+	return s.TaskService.Modify(uuid, modifications)
+}
+
+// ModifyRecurring updates a recurring task instance and drops the cache
+func (s *cachedService) ModifyRecurring(uuid, modifications string, all bool) error {
+	defer s.Invalidate()
+	return s.TaskService.ModifyRecurring(uuid, modifications, all)
+}
+
+// Annotate annotates a task and drops the cache
+func (s *cachedService) Annotate(uuid, text string) error {
+	defer s.Invalidate()
+	return s.TaskService.Annotate(uuid, text)
+}
+
+// Denotate removes an annotation and drops the cache
+func (s *cachedService) Denotate(uuid, description string) error {
+	defer s.Invalidate()
+	return s.TaskService.Denotate(uuid, description)
+}
+
+// Done completes a task and drops the cache
+func (s *cachedService) Done(uuid string) error {
+	defer s.Invalidate()
+	return s.TaskService.Done(uuid)
+}
+
+// Delete deletes a task and drops the cache
+func (s *cachedService) Delete(uuid string) error {
+	defer s.Invalidate()
+	return s.TaskService.Delete(uuid)
+}
+
+// Add creates a task and drops the cache
+func (s *cachedService) Add(description string) (string, error) {
+	defer s.Invalidate()
+	return s.TaskService.Add(description)
+}
+
+// Duplicate copies a task and drops the cache
+func (s *cachedService) Duplicate(uuid string) (string, error) {
+	defer s.Invalidate()
+	return s.TaskService.Duplicate(uuid)
+}
+
+// Undo reverts the last operation and drops the cache
+func (s *cachedService) Undo() error {
+	defer s.Invalidate()
+	return s.TaskService.Undo()
+}
+
+// Edit edits a task in the external editor and drops the cache
+func (s *cachedService) Edit(uuid string) error {
+	defer s.Invalidate()
+	return s.TaskService.Edit(uuid)
+}
+
+// Start starts a task and drops the cache
+func (s *cachedService) Start(uuid string) error {
+	defer s.Invalidate()
+	return s.TaskService.Start(uuid)
+}
+
+// Stop stops a task and drops the cache
+func (s *cachedService) Stop(uuid string) error {
+	defer s.Invalidate()
+	return s.TaskService.Stop(uuid)
+}
+
+// Sync synchronises with the taskserver and drops the cache
+func (s *cachedService) Sync() error {
+	defer s.Invalidate()
+	return s.TaskService.Sync()
+}
+
+// TaskSync runs "task sync" and drops the cache
+func (s *cachedService) TaskSync() error {
+	defer s.Invalidate()
+	return s.TaskService.TaskSync()
+}
+
+// SetContext changes the active context, which filters every export, and drops the cache
+func (s *cachedService) SetContext(name string) error {
+	defer s.Invalidate()
+	return s.TaskService.SetContext(name)
+}
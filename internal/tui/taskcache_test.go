@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// createCachedService returns a cache over a mock service that counts exports per filter,
+// with a fixed clock moved forward by advance
+func createCachedService(t *testing.T) (*cachedService, map[string]int, func(time.Duration)) {
+	now := time.Date(2026, 5, 4, 9, 0, 0, 0, time.Local)
+	core.SetNowFunc(func() time.Time { return now })
+	t.Cleanup(func() { core.SetNowFunc(nil) })
+
+	exports := map[string]int{}
+	service := &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			exports[filter]++
+			return []core.Task{{UUID: "uuid-1", Description: filter}}, nil
+		},
+		ModifyFunc: func(uuid, modifications string) error { return nil },
+	}
+	return newCachedService(service, 5*time.Second), exports, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestCachedServiceReusesExportsWithinTTL(t *testing.T) {
+	cache, exports, advance := createCachedService(t)
+
+	for range 3 {
+		tasks, err := cache.Export("status:pending")
+		if err != nil || len(tasks) != 1 || tasks[0].Description != "status:pending" {
+			t.Fatalf("Expected the exported tasks, got %v (err %v)", tasks, err)
+		}
+	}
+	if _, err := cache.Export("status:waiting"); err != nil {
+		t.Fatal(err)
+	}
+	if exports["status:pending"] != 1 || exports["status:waiting"] != 1 {
+		t.Errorf("Expected one export per filter within the TTL, got %v", exports)
+	}
+
+	advance(5 * time.Second)
+	if _, err := cache.Export("status:pending"); err != nil {
+		t.Fatal(err)
+	}
+	if exports["status:pending"] != 2 {
+		t.Errorf("Expected a new export after the TTL, got %d", exports["status:pending"])
+	}
+}
+
+func TestCachedServiceMutationsDropCache(t *testing.T) {
+	cache, exports, _ := createCachedService(t)
+
+	cache.Export("status:pending")
+	if err := cache.Modify("uuid-1", "priority:H"); err != nil {
+		t.Fatal(err)
+	}
+	cache.Export("status:pending")
+	if exports["status:pending"] != 2 {
+		t.Errorf("Expected a modification to drop the cache, got %d exports", exports["status:pending"])
+	}
+
+	cache.Done("uuid-1")
+	cache.Export("status:pending")
+	if exports["status:pending"] != 3 {
+		t.Errorf("Expected completing a task to drop the cache, got %d exports", exports["status:pending"])
+	}
+}
+
+func TestModelTaskCache(t *testing.T) {
+	if model := createTestModel(&core.MockTaskService{}); model.taskCache != nil {
+		t.Error("Expected no cache without cache_ttl_seconds")
+	}
+
+	cache, exports, _ := createCachedService(t)
+	cfg := config.DefaultConfig()
+	cfg.TUI.CacheTTLSeconds = 30
+	model := NewModel(cache.TaskService, cfg)
+	if model.taskCache == nil || model.service != model.taskCache {
+		t.Fatal("Expected the service to be wrapped by the cache")
+	}
+
+	model.service.Export("status:pending")
+	model.service.Export("status:pending")
+	if exports["status:pending"] != 1 {
+		t.Errorf("Expected the second export to be cached, got %d exports", exports["status:pending"])
+	}
+
+	// Changes made outside the service (e.g. the task shell) also drop the cache
+	updated, _ := model.Update(TaskModifiedMsg{})
+	model = updated.(Model)
+	model.service.Export("status:pending")
+	if exports["status:pending"] != 2 {
+		t.Errorf("Expected TaskModifiedMsg to drop the cache, got %d exports", exports["status:pending"])
+	}
+}
+
+func TestCachedServiceDropsExportsStartedBeforeInvalidate(t *testing.T) {
+	var cache *cachedService
+	service := &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			// A change lands while the export runs
+			cache.Invalidate()
+			return []core.Task{}, nil
+		},
+	}
+	cache = newCachedService(service, time.Minute)
+
+	if _, err := cache.Export("status:pending"); err != nil {
+		t.Fatal(err)
+	}
+	if cache.cached("status:pending") {
+		t.Error("Expected an export started before a change not to be cached")
+	}
+}
@@ -0,0 +1,55 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// taskShellExec builds the process run by the task_shell key: the configured command,
+// or the user's shell when none is set, with TASKRC pointing at wui's taskrc
+func taskShellExec(command, taskrcPath string) (*exec.Cmd, error) {
+	if command == "" {
+		command = os.Getenv("SHELL")
+	}
+	if command == "" {
+		command = "sh"
+	}
+
+	parts, err := parseCommandLine(command)
+	if err != nil {
+		return nil, fmt.Errorf("task shell command parsing failed: %w", err)
+	}
+	if len(parts) == 0 {
+		return nil, errors.New("empty task shell command")
+	}
+
+	c := exec.Command(parts[0], parts[1:]...)
+	if taskrcPath != "" {
+		c.Env = append(os.Environ(), fmt.Sprintf("TASKRC=%s", taskrcPath))
+	}
+	return c, nil
+}
+
+// taskShellCmd suspends the TUI while the task shell runs, then refreshes the task list.
+// The exit status of an interactive shell is that of its last command, so only
+// failures to start the shell are reported.
+func taskShellCmd(command, taskrcPath string) tea.Cmd {
+	c, err := taskShellExec(command, taskrcPath)
+	if err != nil {
+		return func() tea.Msg {
+			return StatusMsg{Message: err.Error(), IsError: true}
+		}
+	}
+
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			err = nil
+		}
+		return TaskModifiedMsg{Err: err}
+	})
+}
@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestTaskShellExec(t *testing.T) {
+	t.Setenv("SHELL", "/bin/zsh")
+
+	tests := []struct {
+		name       string
+		command    string
+		taskrcPath string
+		args       []string
+		env        string // Expected TASKRC entry, empty when the environment is inherited
+	}{
+		{"default shell", "", "/home/user/.taskrc", []string{"/bin/zsh"}, "TASKRC=/home/user/.taskrc"},
+		{"configured command", `tasksh --rc "my rc"`, "", []string{"tasksh", "--rc", "my rc"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := taskShellExec(tt.command, tt.taskrcPath)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(c.Args, tt.args) {
+				t.Errorf("Expected args %v, got %v", tt.args, c.Args)
+			}
+			if tt.env == "" && c.Env != nil {
+				t.Errorf("Expected the inherited environment, got %v", c.Env)
+			}
+			if tt.env != "" && !slices.Contains(c.Env, tt.env) {
+				t.Errorf("Expected %s in the environment", tt.env)
+			}
+		})
+	}
+}
+
+func TestTaskShellExecFallback(t *testing.T) {
+	t.Setenv("SHELL", "")
+
+	c, err := taskShellExec("", "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if c.Args[0] != "sh" {
+		t.Errorf("Expected sh without $SHELL, got %v", c.Args)
+	}
+}
@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestTaskSyncKey(t *testing.T) {
+	synced := 0
+	model := createTestModel(&core.MockTaskService{
+		TaskSyncFunc: func() error {
+			synced++
+			return nil
+		},
+	})
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	model = updated.(Model)
+	if !model.isLoading || model.statusMessage != "Syncing tasks..." {
+		t.Errorf("Expected the sync to show progress, got loading=%v status %q", model.isLoading, model.statusMessage)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a sync command")
+	}
+	msg := cmd()
+	if synced != 1 {
+		t.Errorf("Expected TaskSync to run once, ran %d times", synced)
+	}
+
+	updated, cmd = model.Update(msg)
+	model = updated.(Model)
+	if model.statusMessage != "Tasks synced" || model.errorMessage != "" {
+		t.Errorf("Expected a success message, got status %q error %q", model.statusMessage, model.errorMessage)
+	}
+	if !model.isLoading || cmd == nil {
+		t.Error("Expected the task list to be refreshed after the sync")
+	}
+}
+
+func TestTaskSyncFailure(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{
+		TaskSyncFunc: func() error { return errors.New("no server configured") },
+	})
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	model = updated.(Model)
+	updated, cmd = model.Update(cmd())
+	model = updated.(Model)
+	if model.isLoading || cmd != nil {
+		t.Error("Expected no refresh after a failed sync")
+	}
+	if model.errorMessage != "Task sync failed: no server configured" {
+		t.Errorf("Expected the sync error, got %q", model.errorMessage)
+	}
+}
@@ -0,0 +1,105 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/tui/components"
+)
+
+// blankTaskItem is the template picker entry that opens an empty new task input
+const blankTaskItem = "Blank task"
+
+// expandTemplate returns the new task input value for a template.
+// A due date starting with "+" is made relative to today (e.g. "+3d" becomes "today+3d").
+func expandTemplate(tmpl config.TaskTemplate) string {
+	var args []string
+	if description := strings.TrimSpace(tmpl.Description); description != "" {
+		args = append(args, description)
+	}
+	if tmpl.Project != "" {
+		args = append(args, "project:"+tmpl.Project)
+	}
+	for _, tag := range tmpl.Tags {
+		if tag = strings.TrimPrefix(strings.TrimSpace(tag), "+"); tag != "" {
+			args = append(args, "+"+tag)
+		}
+	}
+	if tmpl.Priority != "" {
+		args = append(args, "priority:"+tmpl.Priority)
+	}
+	if due := strings.TrimSpace(tmpl.Due); due != "" {
+		if strings.HasPrefix(due, "+") {
+			due = "today" + due
+		}
+		args = append(args, "due:"+due)
+	}
+	// Trailing space so the description can be continued or more attributes typed
+	return strings.Join(args, " ") + " "
+}
+
+// templatePickerItems returns the blank task entry followed by the template names
+func templatePickerItems(templates []config.TaskTemplate) []string {
+	items := []string{blankTaskItem}
+	for _, tmpl := range templates {
+		items = append(items, tmpl.Name)
+	}
+	return items
+}
+
+// startNewTask opens the template picker when templates are configured,
+// otherwise the empty new task input
+func (m Model) startNewTask() (tea.Model, tea.Cmd) {
+	if len(m.config.TUI.Templates) == 0 {
+		return m.openNewTaskInput("")
+	}
+
+	m.templatePicker = components.NewListPicker("New task from template", templatePickerItems(m.config.TUI.Templates), "")
+	m.templatePickerActive = true
+	m.state = StateTemplatePicker
+	return m, nil
+}
+
+// openNewTaskInput shows the new task input prefilled with value
+func (m Model) openNewTaskInput(value string) (tea.Model, tea.Cmd) {
+	m.state = StateNewTaskInput
+	m.newTaskInput.SetValue(value)
+	m.updateComponentSizes()
+	return m, m.newTaskInput.Focus()
+}
+
+// deactivateTemplatePicker closes the template picker
+func (m *Model) deactivateTemplatePicker() {
+	m.templatePickerActive = false
+	m.state = StateNormal
+}
+
+// handleTemplatePickerKeys handles input while the template picker is shown.
+// Choosing a template opens the new task input prefilled from it, ready to be edited.
+func (m Model) handleTemplatePickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "enter":
+		selected := m.templatePicker.SelectedItem()
+		m.deactivateTemplatePicker()
+		if selected == blankTaskItem {
+			return m.openNewTaskInput("")
+		}
+		for _, tmpl := range m.config.TUI.Templates {
+			if tmpl.Name == selected {
+				return m.openNewTaskInput(expandTemplate(tmpl))
+			}
+		}
+		return m, nil
+
+	case "esc":
+		m.deactivateTemplatePicker()
+		return m, nil
+
+	default:
+		m.templatePicker, cmd = m.templatePicker.Update(msg)
+		return m, cmd
+	}
+}
@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template config.TaskTemplate
+		expected string
+	}{
+		{
+			name:     "full template",
+			template: config.TaskTemplate{Name: "Review", Description: "Review PR #", Project: "Work.code", Tags: []string{"review", "+code"}, Priority: "M", Due: "+3d"},
+			expected: "Review PR # project:Work.code +review +code priority:M due:today+3d ",
+		},
+		{
+			name:     "absolute due date kept as is",
+			template: config.TaskTemplate{Name: "Report", Description: "Monthly report", Due: "eom"},
+			expected: "Monthly report due:eom ",
+		},
+		{
+			name:     "no description",
+			template: config.TaskTemplate{Name: "Errand", Project: "Home", Tags: []string{"errand"}},
+			expected: "project:Home +errand ",
+		},
+		{
+			name:     "description only",
+			template: config.TaskTemplate{Name: "Call", Description: "Call "},
+			expected: "Call ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expandTemplate(tt.template); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// createTemplatesModel returns a model with two new task templates configured
+func createTemplatesModel() Model {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.Templates = []config.TaskTemplate{
+		{Name: "Bug", Description: "Fix", Project: "Work", Tags: []string{"bug"}},
+		{Name: "Review", Description: "Review PR #", Due: "+3d"},
+	}
+	return model
+}
+
+func TestNewTaskFromTemplate(t *testing.T) {
+	model := createTemplatesModel()
+
+	model = pressKey(t, model, "n")
+	if model.state != StateTemplatePicker || !model.templatePickerActive {
+		t.Fatalf("Expected template picker to be open, got state %v", model.state)
+	}
+
+	// Search narrows the list to the Review template
+	model = pressKey(t, model, "rev")
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+
+	if model.state != StateNewTaskInput || model.templatePickerActive {
+		t.Fatalf("Expected new task input, got state %v", model.state)
+	}
+	if got := model.newTaskInput.Value(); got != "Review PR # due:today+3d " {
+		t.Errorf("Expected input prefilled from the template, got %q", got)
+	}
+}
+
+func TestNewTaskBlankTemplate(t *testing.T) {
+	model := createTemplatesModel()
+
+	model = pressKey(t, model, "n")
+	if got := model.templatePicker.SelectedItem(); got != blankTaskItem {
+		t.Fatalf("Expected blank task entry first, got %q", got)
+	}
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+
+	if model.state != StateNewTaskInput || model.newTaskInput.Value() != "" {
+		t.Errorf("Expected an empty new task input, got state %v value %q", model.state, model.newTaskInput.Value())
+	}
+}
+
+func TestNewTaskWithoutTemplates(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+
+	model = pressKey(t, model, "n")
+	if model.state != StateNewTaskInput || model.templatePickerActive {
+		t.Errorf("Expected the new task input without a picker, got state %v", model.state)
+	}
+}
+
+func TestTemplatePickerEscCancels(t *testing.T) {
+	model := createTemplatesModel()
+
+	model = pressKey(t, model, "n")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if model.state != StateNormal || model.templatePickerActive {
+		t.Errorf("Expected picker to close on esc, got state %v", model.state)
+	}
+	if cmd != nil {
+		t.Error("Expected no command on esc")
+	}
+}
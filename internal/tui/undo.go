@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// confirmUndo is the confirm action that reverts the last operation
+const confirmUndo = "undo"
+
+// startUndo looks up what undo would revert so it can be confirmed, or undoes
+// directly when confirmations are disabled
+func (m Model) startUndo() (tea.Model, tea.Cmd) {
+	if m.noConfirm {
+		return m, undoCmd(m.service)
+	}
+	return m, undoPreviewCmd(m.service)
+}
+
+// undoPreviewCmd creates a command to describe what undo would revert
+func undoPreviewCmd(service core.TaskService) tea.Cmd {
+	return func() tea.Msg {
+		summary, err := service.UndoPreview()
+		return UndoPreviewMsg{Summary: summary, Err: err}
+	}
+}
+
+// showUndoConfirm asks to confirm the undo. When the preview failed, the
+// prompt does not say what will be reverted.
+func (m Model) showUndoConfirm(msg UndoPreviewMsg) (tea.Model, tea.Cmd) {
+	if m.state != StateNormal {
+		// Something else was started while the preview was computed
+		return m, nil
+	}
+	m.undoPreview = ""
+	if msg.Err == nil {
+		m.undoPreview = msg.Summary
+	}
+	m.state = StateConfirm
+	m.confirmAction = confirmUndo
+	return m, nil
+}
+
+// expandUndoPreview replaces {{.change}} in a confirm prompt with what undo reverts
+func (m Model) expandUndoPreview(template string) string {
+	return strings.ReplaceAll(template, "{{.change}}", m.undoPreview)
+}
@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestUndoAsksConfirmationWithPreview(t *testing.T) {
+	undone := false
+	service := &core.MockTaskService{
+		UndoPreviewFunc: func() (string, error) {
+			return `modify "Pay rent": priority H→M`, nil
+		},
+		UndoFunc: func() error {
+			undone = true
+			return nil
+		},
+	}
+	model := createTestModel(service)
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected undo preview command")
+	}
+	updated, _ = model.Update(cmd())
+	model = updated.(Model)
+	if model.state != StateConfirm || model.confirmAction != confirmUndo {
+		t.Fatalf("Expected the undo confirmation, got state %v action %q", model.state, model.confirmAction)
+	}
+	if expected := `Undo: modify "Pay rent": priority H→M? (y/N)`; model.confirmMessage() != expected {
+		t.Errorf("Expected prompt %q, got %q", expected, model.confirmMessage())
+	}
+	if undone {
+		t.Fatal("Expected undo to wait for the confirmation")
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	model = updated.(Model)
+	if model.state != StateNormal || cmd == nil {
+		t.Fatalf("Expected undo command after y, got state %v", model.state)
+	}
+	cmd()
+	if !undone {
+		t.Error("Expected undo after the confirmation")
+	}
+}
+
+func TestUndoPreviewFailureFallsBackToGenericPrompt(t *testing.T) {
+	undone := false
+	service := &core.MockTaskService{
+		UndoPreviewFunc: func() (string, error) {
+			return "", errors.New("cannot parse")
+		},
+		UndoFunc: func() error {
+			undone = true
+			return nil
+		},
+	}
+	model := createTestModel(service)
+
+	updated, _ := model.Update(undoPreviewCmd(service)())
+	model = updated.(Model)
+	if model.state != StateConfirm {
+		t.Fatalf("Expected the undo confirmation, got state %v", model.state)
+	}
+	if expected := "Undo the last change? (y/N)"; model.confirmMessage() != expected {
+		t.Errorf("Expected prompt %q, got %q", expected, model.confirmMessage())
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	model = updated.(Model)
+	if model.state != StateNormal || cmd != nil || undone {
+		t.Errorf("Expected n to cancel the undo, got state %v", model.state)
+	}
+}
+
+func TestUndoWithoutConfirmations(t *testing.T) {
+	previewed, undone := false, false
+	service := &core.MockTaskService{
+		UndoPreviewFunc: func() (string, error) {
+			previewed = true
+			return "", nil
+		},
+		UndoFunc: func() error {
+			undone = true
+			return nil
+		},
+	}
+	model := createTestModel(service)
+	model.noConfirm = true
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	if cmd == nil {
+		t.Fatal("Expected undo command")
+	}
+	if _, ok := cmd().(TaskModifiedMsg); !ok || !undone || previewed {
+		t.Errorf("Expected a direct undo without preview (undone %v, previewed %v)", undone, previewed)
+	}
+}
+
+func TestUndoPreviewIgnoredAfterStateChange(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.state = StateHelp
+
+	updated, _ := model.Update(UndoPreviewMsg{Summary: `add "Task"`})
+	if updated.(Model).state != StateHelp {
+		t.Errorf("Expected a late preview not to open the confirmation, got state %v", updated.(Model).state)
+	}
+}
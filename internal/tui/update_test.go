@@ -2,6 +2,7 @@ package tui
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -56,7 +57,7 @@ func TestHandleEditKey(t *testing.T) {
 func TestEditTaskCmd(t *testing.T) {
 	// Test the editTaskCmd function
 	cfg := config.DefaultConfig()
-	cmd := editTaskCmd(cfg.TaskBin, cfg.TaskrcPath, "test-uuid")
+	cmd := editTaskCmd(cfg.TaskBin, cfg.TaskrcPath, "test-uuid", false)
 
 	if cmd == nil {
 		t.Error("Expected editTaskCmd to return a command")
@@ -382,6 +383,52 @@ func TestHandleDeleteConfirmYes(t *testing.T) {
 	}
 }
 
+func TestHandleDeleteKeyNoConfirm(t *testing.T) {
+	var deleted string
+	service := &core.MockTaskService{
+		DeleteFunc: func(uuid string) error {
+			deleted = uuid
+			return nil
+		},
+	}
+	cfg := config.DefaultConfig()
+	cfg.TUI.NoConfirm = true
+	model := NewModel(service, cfg)
+	model.tasks = []core.Task{{UUID: "test-uuid-1", Description: "Test task 1"}}
+	model.taskList.SetTasks(model.tasks)
+
+	updatedModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	m := updatedModel.(Model)
+	if m.state != StateNormal {
+		t.Errorf("Expected delete to skip the confirm state, got %v", m.state)
+	}
+	if cmd == nil {
+		t.Fatal("Expected delete command to be returned immediately")
+	}
+	cmd()
+	if deleted != "test-uuid-1" {
+		t.Errorf("Expected task 'test-uuid-1' to be deleted, got %q", deleted)
+	}
+	if !strings.Contains(m.renderFooter(), "NO CONFIRM") {
+		t.Error("Expected the footer to flag disabled confirmations")
+	}
+
+	// Toggling at runtime restores the confirmation prompt
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	m = updatedModel.(Model)
+	if m.noConfirm {
+		t.Fatal("Expected confirmations to be re-enabled after toggle")
+	}
+	if strings.Contains(m.renderFooter(), "NO CONFIRM") {
+		t.Error("Expected no footer flag with confirmations enabled")
+	}
+	updatedModel, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	m = updatedModel.(Model)
+	if m.state != StateConfirm || cmd != nil {
+		t.Errorf("Expected delete to ask for confirmation again, got state %v", m.state)
+	}
+}
+
 func TestHandleDeleteConfirmNo(t *testing.T) {
 	service := &core.MockTaskService{}
 	model := createTestModel(service)
@@ -869,3 +916,65 @@ func TestEmptyInputHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestDrillIntoProjectlessGroup(t *testing.T) {
+	projectless := core.Task{ID: 4, UUID: "none-1", Description: "Buy milk", Status: "pending"}
+
+	var exportFilter, doneUUID string
+	service := &core.MockTaskService{
+		ExportFunc: func(filter string) ([]core.Task, error) {
+			exportFilter = filter
+			return []core.Task{projectless}, nil
+		},
+		DoneFunc: func(uuid string) error {
+			doneUUID = uuid
+			return nil
+		},
+	}
+	model := createProjectsTabModel(service)
+
+	summaries := []core.ProjectSummary{
+		{Name: "Home", Percentage: 50},
+		{Name: "Work", Percentage: 20},
+		{Name: "Work.reports", Percentage: 0},
+	}
+	updated, _ := model.Update(ProjectSummaryLoadedMsg{Summaries: summaries})
+	model = updated.(Model)
+
+	last := model.groups[len(model.groups)-1]
+	if last.Name != core.NoGroupName || last.Count != 1 {
+		t.Fatalf("Expected trailing (none) group with 1 task, got %q (%d)", last.Name, last.Count)
+	}
+
+	// Drill into "(none)"
+	model.taskList.SetCursor(len(model.groups) - 1)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(Model)
+	if model.activeFilter != "project:" {
+		t.Errorf("Expected empty project filter, got %q", model.activeFilter)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a load command when drilling into (none)")
+	}
+	msg := cmd()
+	if exportFilter != "status.any: project:" {
+		t.Errorf("Expected export with empty project filter, got %q", exportFilter)
+	}
+
+	updated, _ = model.Update(msg)
+	model = updated.(Model)
+	selected := model.taskList.SelectedTask()
+	if selected == nil || selected.UUID != projectless.UUID {
+		t.Fatalf("Expected projectless task selected, got %v", selected)
+	}
+
+	// Actions apply to the drilled-down task
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	if cmd == nil {
+		t.Fatal("Expected done command")
+	}
+	cmd()
+	if doneUUID != projectless.UUID {
+		t.Errorf("Expected done on %q, got %q", projectless.UUID, doneUUID)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/clobrano/wui/internal/config"
 )
 
 // View renders the TUI to a string
@@ -81,68 +82,69 @@ func (m Model) View() string {
 		baseView = m.renderFloatingInput(baseView)
 	}
 
+	// Overlay the peek popup on the task list
+	if m.peekActive {
+		baseView = m.renderPeek(baseView)
+	}
+
 	// If calendar is active, overlay it on top of everything
 	if m.calendarActive {
-		calendarView := m.calendar.View()
-
-		// Place calendar in the center of the screen as an overlay
-		baseView = lipgloss.Place(
-			m.width,
-			m.height,
-			lipgloss.Center,
-			lipgloss.Center,
-			calendarView,
-			lipgloss.WithWhitespaceChars(" "),
-			lipgloss.WithWhitespaceForeground(lipgloss.Color("0")),
-		)
+		baseView = m.overlay(m.calendar.View())
 	}
 
 	// If time picker is active, overlay it on top of everything
 	if m.timePickerActive {
-		timePickerView := m.timePicker.View()
-
-		// Place time picker in the center of the screen as an overlay
-		baseView = lipgloss.Place(
-			m.width,
-			m.height,
-			lipgloss.Center,
-			lipgloss.Center,
-			timePickerView,
-			lipgloss.WithWhitespaceChars(" "),
-			lipgloss.WithWhitespaceForeground(lipgloss.Color("0")),
-		)
+		baseView = m.overlay(m.timePicker.View())
 	}
 
 	// If list picker is active, overlay it on top of everything
 	if m.listPickerActive {
-		listPickerView := m.listPicker.View()
+		baseView = m.overlay(m.listPicker.View())
+	}
+
+	// If due presets menu is active, overlay it on top of everything
+	if m.duePresetPickerActive {
+		baseView = m.overlay(m.duePresetPicker.View())
+	}
+
+	// If recurrence scope menu is active, overlay it on top of everything
+	if m.recurrenceScopePickerActive {
+		baseView = m.overlay(m.recurrenceScopePicker.View())
+	}
+
+	// If assign-to-project picker is active, overlay it on top of everything
+	if m.projectPickerActive {
+		baseView = m.overlay(m.projectPicker.View())
+	}
+
+	// If new task template picker is active, overlay it on top of everything
+	if m.templatePickerActive {
+		baseView = m.overlay(m.templatePicker.View())
+	}
+
+	// If recurrence period picker is active, overlay it on top of everything
+	if m.recurrencePickerActive {
+		baseView = m.overlay(m.recurrencePicker.View())
+	}
+
+	// If hidden tabs picker is active, overlay it on top of everything
+	if m.tabPickerActive {
+		baseView = m.overlay(m.tabPicker.View())
+	}
 
-		// Place list picker in the center of the screen as an overlay
-		baseView = lipgloss.Place(
-			m.width,
-			m.height,
-			lipgloss.Center,
-			lipgloss.Center,
-			listPickerView,
-			lipgloss.WithWhitespaceChars(" "),
-			lipgloss.WithWhitespaceForeground(lipgloss.Color("0")),
-		)
+	// If context picker is active, overlay it on top of everything
+	if m.contextPickerActive {
+		baseView = m.overlay(m.contextPicker.View())
+	}
+
+	// If search results are shown, overlay them on top of everything
+	if m.globalSearchActive {
+		baseView = m.overlay(m.globalSearchPicker.View())
 	}
 
 	// If resource picker is active, overlay it on top of everything
 	if m.resourcePickerActive {
-		resourcePickerView := m.resourcePicker.View()
-
-		// Place resource picker in the center of the screen as an overlay
-		baseView = lipgloss.Place(
-			m.width,
-			m.height,
-			lipgloss.Center,
-			lipgloss.Center,
-			resourcePickerView,
-			lipgloss.WithWhitespaceChars(" "),
-			lipgloss.WithWhitespaceForeground(lipgloss.Color("0")),
-		)
+		baseView = m.overlay(m.resourcePicker.View())
 	}
 
 	// If in task validation state, overlay the popup
@@ -163,6 +165,19 @@ func (m Model) View() string {
 	return baseView
 }
 
+// overlay places view in the center of the screen, on top of everything
+func (m Model) overlay(view string) string {
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		view,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("0")),
+	)
+}
+
 // renderHeader renders the header section
 func (m Model) renderHeader() string {
 	title := "wui - Warrior UI"
@@ -172,6 +187,10 @@ func (m Model) renderHeader() string {
 		title += fmt.Sprintf(" | Filter: %s", m.activeFilter)
 	}
 
+	if task := m.focusedTask(); task != nil {
+		title += fmt.Sprintf(" | Focus: %s", task.Description)
+	}
+
 	return m.styles.Header.Width(m.width).Render(title)
 }
 
@@ -185,6 +204,8 @@ func (m Model) renderContent() string {
 	switch m.state {
 	case StateHelp:
 		return m.renderHelp()
+	case StateMessages:
+		return m.renderMessages()
 	case StateConfirm:
 		return m.renderConfirm()
 	default:
@@ -197,9 +218,19 @@ func (m Model) renderContent() string {
 func (m Model) renderTaskListWithComponents() string {
 	var content string
 
-	if m.viewMode == ViewModeSmallTaskDetail || m.viewMode == ViewModeTaskDetail {
+	if m.isHomeView() {
+		// The Home tab shows its widgets instead of tasks
+		content = m.renderHome()
+	} else if m.viewMode == ViewModeSmallTaskDetail || m.viewMode == ViewModeTaskDetail {
 		// Render full-screen task detail view
 		content = m.sidebar.View()
+	} else if m.viewMode == ViewModeProjectPanes {
+		content = m.renderProjectPanes()
+	} else if m.viewMode == ViewModeListWithSidebar {
+		// The sidebar's detail layout can exceed a narrow pane, so clip it to the list's height and the screen width
+		list := m.taskList.View()
+		content = lipgloss.NewStyle().MaxWidth(m.width).MaxHeight(lipgloss.Height(list)).
+			Render(lipgloss.JoinHorizontal(lipgloss.Top, list, m.sidebar.View()))
 	} else {
 		// Render just the task list (ViewModeList or ViewModeSmall)
 		content = m.taskList.View()
@@ -223,6 +254,10 @@ func (m Model) renderInputPrompt() string {
 	case StateFilterInput:
 		prompt = "Filter: "
 		hint = "(Enter to apply, Esc to cancel)"
+		if m.globalSearchInput {
+			prompt = "Search all: "
+			hint = "(Enter to search, Esc to cancel)"
+		}
 		inputView = m.filter.View()
 	case StateModifyInput:
 		prompt = "Modify: "
@@ -231,10 +266,20 @@ func (m Model) renderInputPrompt() string {
 	case StateAnnotateInput:
 		prompt = "Annotate: "
 		hint = "(Enter to apply, Esc to cancel)"
+		if m.doneNoteTasks != nil {
+			prompt = "Completion Note: "
+			hint = "(Enter to complete, empty for no note, Esc to cancel)"
+		}
 		inputView = m.annotateInput.View()
 	case StateNewTaskInput:
 		prompt = "New Task: "
 		hint = "(Enter to create, Esc to cancel)"
+		if m.recurringTask != nil {
+			prompt = "New Recurring Task: "
+			hint = "(Enter to choose the period, Esc to cancel)"
+		} else if m.subtaskParent != nil {
+			prompt = "New Subtask: "
+		}
 		inputView = m.newTaskInput.View()
 	default:
 		return ""
@@ -285,6 +330,10 @@ func (m Model) renderFloatingInput(baseView string) string {
 	case StateFilterInput:
 		title = "Filter Tasks"
 		hint = "Enter: Apply  •  Esc: Cancel  •  ↑↓: History"
+		if m.globalSearchInput {
+			title = "Search All Tasks"
+			hint = "Enter: Search  •  Esc: Cancel  •  ↑↓: History"
+		}
 		inputView = m.filter.View()
 	case StateModifyInput:
 		title = "Modify Tasks"
@@ -293,10 +342,20 @@ func (m Model) renderFloatingInput(baseView string) string {
 	case StateAnnotateInput:
 		title = "Add Annotation"
 		hint = "Enter: Apply  •  Esc: Cancel"
+		if m.doneNoteTasks != nil {
+			title = "Completion Note"
+			hint = "Enter: Complete (empty for no note)  •  Esc: Cancel"
+		}
 		inputView = m.annotateInput.View()
 	case StateNewTaskInput:
 		title = "New Task"
 		hint = "Enter: Create  •  Esc: Cancel"
+		if m.recurringTask != nil {
+			title = "New Recurring Task"
+			hint = "Enter: Choose period  •  Esc: Cancel"
+		} else if m.subtaskParent != nil {
+			title = "New Subtask of '" + m.subtaskParent.Description + "'"
+		}
 		inputView = m.newTaskInput.View()
 	default:
 		return baseView
@@ -344,18 +403,55 @@ func (m Model) renderFloatingInput(baseView string) string {
 
 // renderConfirm renders the confirmation prompt
 func (m Model) renderConfirm() string {
-	message := "Confirm? (y/N)"
+	return lipgloss.NewStyle().
+		Padding(2, 4).
+		Render(m.confirmMessage())
+}
+
+// confirmMessage returns the prompt for the pending confirm action, using the
+// configured message for that action when available, then the catalog prompt
+// for the action, then the generic prompt
+func (m Model) confirmMessage() string {
+	message := m.text(msgConfirmGeneric)
+	if m.confirmAction == confirmUndo && m.undoPreview == "" {
+		return m.text(msgConfirmUndoLast)
+	}
 
-	if m.confirmAction == "delete" {
-		selectedTask := m.taskList.SelectedTask()
-		if selectedTask != nil {
-			message = fmt.Sprintf("Delete task '%s'? (y/N)", selectedTask.Description)
+	var template string
+	if m.config.TUI != nil {
+		template = m.config.TUI.ConfirmMessages[m.confirmAction]
+	}
+	// A default prompt is the English one, which the catalog translates
+	if template == config.DefaultConfirmMessages()[m.confirmAction] {
+		template = ""
+	}
+	if template == "" {
+		var ok bool
+		template, ok = lookupMessage(m.language(), messageID("confirm."+m.confirmAction))
+		if !ok {
+			return message
 		}
 	}
 
-	return lipgloss.NewStyle().
-		Padding(2, 4).
-		Render(message)
+	if m.confirmAction == confirmGroupDone || m.confirmAction == confirmGroupDelete {
+		return m.expandGroupPlaceholders(template)
+	}
+	if m.confirmAction == confirmStartStop {
+		return m.expandSelectionCount(template)
+	}
+	if m.confirmAction == confirmArchive {
+		return m.expandArchivePlaceholders(template)
+	}
+	if m.confirmAction == confirmUndo {
+		return m.expandUndoPreview(template)
+	}
+	if !strings.Contains(template, "{{.") {
+		return template
+	}
+	if expanded, err := expandCommandTemplate(template, m.taskList.SelectedTask()); err == nil {
+		return expanded
+	}
+	return message
 }
 
 // renderTaskValidationPopup renders the task validation popup as an overlay
@@ -644,9 +740,19 @@ func min(a, b int) int {
 func (m Model) renderFooter() string {
 	var parts []string
 
+	// Flag that destructive actions run without confirmation
+	if m.noConfirm {
+		parts = append(parts, m.styles.Error.Render(m.text(msgFooterNoConfirm)))
+	}
+
+	// Every task list is filtered by the active Taskwarrior context
+	if m.activeContext != "" {
+		parts = append(parts, m.text(msgFooterContext, m.activeContext))
+	}
+
 	// Show loading indicator if loading
 	if m.isLoading {
-		parts = append(parts, m.styles.LoadingIndicator.Render("⣾ Loading..."))
+		parts = append(parts, m.styles.LoadingIndicator.Render("⣾ "+m.text(msgFooterLoading)))
 	} else if m.errorMessage != "" {
 		// Show error message if present
 		parts = append(parts, m.styles.Error.Render("✗ "+m.errorMessage))
@@ -657,40 +763,83 @@ func (m Model) renderFooter() string {
 	// Show keybindings based on state
 	keybindings := ""
 	if m.resourcePickerActive {
-		keybindings = "↑↓: navigate | enter: open | esc: cancel"
+		keybindings = m.text(msgHintResourcePicker)
+	} else if m.duePresetPickerActive {
+		keybindings = m.text(msgHintDuePresetPicker)
+	} else if m.recurrenceScopePickerActive {
+		keybindings = m.text(msgHintRecurrenceScope)
+	} else if m.projectPickerActive {
+		keybindings = m.text(msgHintProjectPicker)
+	} else if m.templatePickerActive {
+		keybindings = m.text(msgHintTemplatePicker)
+	} else if m.recurrencePickerActive {
+		keybindings = m.text(msgHintRecurrencePicker)
+	} else if m.tabPickerActive {
+		keybindings = m.text(msgHintTabPicker)
+	} else if m.contextPickerActive {
+		keybindings = m.text(msgHintContextPicker)
+	} else if m.globalSearchActive {
+		keybindings = m.text(msgHintGlobalSearch)
 	} else if m.listPickerActive {
-		keybindings = "↑↓: navigate | enter: select | esc: cancel"
+		keybindings = m.text(msgHintListPicker)
 	} else if m.timePickerActive {
-		keybindings = "↑↓: change value | Tab/←→: switch field | N: now | enter: select | esc: cancel"
+		keybindings = m.text(msgHintTimePicker)
 	} else if m.calendarActive {
-		keybindings = "B/N: prev/next month | T: today | E: edit date | arrows/hjkl: navigate | enter: select | esc: cancel"
+		keybindings = m.text(msgHintCalendar)
 	} else {
 		switch m.state {
 		case StateNormal:
-			if m.inGroupView {
-				keybindings = "enter: open in Search | j/k: navigate | tab: next section"
+			if m.isHomeView() {
+				keybindings = m.text(msgHintHome,
+					m.actionKey("refresh", "r"), m.actionKey("global_search", "ctrl+g"))
+			} else if m.inGroupView {
+				keybindings = m.text(msgHintGroups)
+			} else if m.viewMode == ViewModeProjectPanes && m.projectPaneFocused {
+				keybindings = m.text(msgHintProjectsPane)
+			} else if m.viewMode == ViewModeProjectPanes {
+				keybindings = m.text(msgHintTasksPane)
 			} else if m.viewMode == ViewModeTaskDetail {
-				keybindings = "k/j: scroll | K/J: prev/next task | esc: back | d: done | s: start/stop | e: edit | m: modify | a: annotate"
+				keybindings = m.text(msgHintTaskDetail)
 			} else {
-				keybindings = "d: done | s: start/stop | x: delete | e: edit | n: new | m: modify | a: annotate | u: undo"
+				keybindings = m.text(msgHintTaskList)
 			}
 		case StateHelp:
-			keybindings = "?: close help"
+			keybindings = m.text(msgHintHelp)
+		case StateMessages:
+			keybindings = m.text(msgHintMessages)
 		case StateFilterInput:
-			keybindings = "enter: apply | esc: cancel"
+			keybindings = m.text(msgHintInput)
 		case StateConfirm:
-			keybindings = "y: confirm | n: cancel"
+			keybindings = m.text(msgHintConfirm)
+		case StateFuzzyFind:
+			parts = append(parts, m.fuzzyFindPrompt())
+			keybindings = m.text(msgHintFuzzyFind)
 		case StateTaskValidation:
-			keybindings = "y: complete anyway | n/esc: cancel"
+			keybindings = m.text(msgHintTaskValidation)
 		case StateModifyInput:
-			keybindings = "enter: apply | esc: cancel | tab: date+time picker"
+			keybindings = m.text(msgHintModifyInput)
 		case StateAnnotateInput:
-			keybindings = "enter: apply | esc: cancel"
+			keybindings = m.text(msgHintInput)
+			if m.doneNoteTasks != nil {
+				keybindings = m.text(msgHintDoneNote)
+			}
 		case StateNewTaskInput:
-			keybindings = "enter: create | esc: cancel | tab: date+time picker"
+			keybindings = m.text(msgHintNewTaskInput)
 		}
 	}
 
+	// Show the list position while browsing a list
+	if m.state == StateNormal && !m.isLoading && m.viewMode != ViewModeTaskDetail && m.viewMode != ViewModeSmallTaskDetail {
+		if position := m.taskList.PositionIndicator(); position != "" {
+			parts = append(parts, position)
+		}
+	}
+
+	// Break the loaded tasks down by status, e.g. in the Search tab mixing them
+	if m.state == StateNormal && !m.isLoading && !m.isHomeView() && len(m.tasks) > 0 {
+		parts = append(parts, statusBreakdown(m.tasks))
+	}
+
 	if keybindings != "" {
 		parts = append(parts, keybindings)
 	}
@@ -87,16 +87,18 @@ func TestViewWithSidebar(t *testing.T) {
 
 	model.width = 120
 	model.height = 30
-	model.viewMode = ViewModeListWithSidebar
+	// The detail pane is rendered full screen in the task detail view
+	model.viewMode = ViewModeTaskDetail
 	model.tasks = []core.Task{
 		{ID: 1, UUID: "task-1", Description: "Test task", Project: "Project1", Status: "pending"},
 	}
 	model.taskList.SetTasks(model.tasks)
+	model.updateComponentSizes()
 	model.updateSidebar()
 
 	view := model.View()
 	// The sidebar should show task details
-	if !strings.Contains(view, "Task #") {
+	if !strings.Contains(view, "#1  Test task") {
 		t.Error("Expected view to contain sidebar task title")
 	}
 }
@@ -128,3 +130,67 @@ func TestRenderFooterWithStatus(t *testing.T) {
 		t.Error("Expected footer to contain status message")
 	}
 }
+
+func TestRenderConfirmDefaultMessage(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.state = StateConfirm
+	model.confirmAction = "delete"
+
+	view := model.renderConfirm()
+	if !strings.Contains(view, "Delete task 'Test task 1'? (y/N)") {
+		t.Errorf("Expected default delete prompt, got %q", view)
+	}
+}
+
+func TestRenderConfirmConfiguredMessage(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.ConfirmMessages = map[string]string{
+		"delete": "Permanently destroy {{.description}}? This cannot be undone (y/N)",
+	}
+	model.state = StateConfirm
+	model.confirmAction = "delete"
+
+	view := model.renderConfirm()
+	if !strings.Contains(view, "Permanently destroy Test task 1? This cannot be undone (y/N)") {
+		t.Errorf("Expected configured delete prompt, got %q", view)
+	}
+}
+
+func TestRenderConfirmFallbacks(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.config.TUI.ConfirmMessages = map[string]string{
+		"delete": "Delete {{.nosuchfield}}?",
+		"plain":  "Proceed? (y/N)",
+	}
+	model.state = StateConfirm
+
+	model.confirmAction = "plain"
+	if got := model.confirmMessage(); got != "Proceed? (y/N)" {
+		t.Errorf("Expected plain configured message, got %q", got)
+	}
+
+	// Unknown placeholders and unknown actions fall back to the generic prompt
+	model.confirmAction = "delete"
+	if got := model.confirmMessage(); got != "Confirm? (y/N)" {
+		t.Errorf("Expected generic prompt for a bad template, got %q", got)
+	}
+	model.confirmAction = "unknown"
+	if got := model.confirmMessage(); got != "Confirm? (y/N)" {
+		t.Errorf("Expected generic prompt for an unknown action, got %q", got)
+	}
+}
+
+func TestRenderFooterShowsListPosition(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.taskList.MoveCursorDown()
+
+	if footer := model.renderFooter(); !strings.Contains(footer, "2/3 All") {
+		t.Errorf("Expected footer to show list position, got %q", footer)
+	}
+
+	// Hidden while a prompt is open
+	model.state = StateFilterInput
+	if footer := model.renderFooter(); strings.Contains(footer, "2/3") {
+		t.Errorf("Expected no list position during filter input, got %q", footer)
+	}
+}
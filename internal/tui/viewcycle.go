@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// viewCycleModes maps the names accepted in tui.view_cycle to view modes
+var viewCycleModes = map[string]ViewMode{
+	"list":     ViewModeList,
+	"sidebar":  ViewModeListWithSidebar,
+	"detail":   ViewModeTaskDetail,
+	"projects": ViewModeProjectPanes,
+}
+
+// defaultViewCycle is used when tui.view_cycle has no valid entries
+var defaultViewCycle = []ViewMode{ViewModeList, ViewModeListWithSidebar}
+
+// resolveViewCycle returns the view modes named in the configured cycle, in order.
+// Unknown and repeated names are skipped.
+func resolveViewCycle(names []string) []ViewMode {
+	var cycle []ViewMode
+	seen := make(map[ViewMode]bool)
+	for _, name := range names {
+		mode, ok := viewCycleModes[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			slog.Warn("Ignoring unknown view_cycle entry", "name", name)
+			continue
+		}
+		if !seen[mode] {
+			seen[mode] = true
+			cycle = append(cycle, mode)
+		}
+	}
+	if len(cycle) == 0 {
+		return defaultViewCycle
+	}
+	return cycle
+}
+
+// nextViewMode returns the mode that follows current in the cycle, skipping modes
+// that are not available. Small screen modes count as their full-size equivalents.
+// Returns current when no other mode is available.
+func nextViewMode(cycle []ViewMode, current ViewMode, available func(ViewMode) bool) ViewMode {
+	switch current {
+	case ViewModeSmall:
+		current = ViewModeList
+	case ViewModeSmallTaskDetail:
+		current = ViewModeTaskDetail
+	}
+
+	start := -1
+	for i, mode := range cycle {
+		if mode == current {
+			start = i
+			break
+		}
+	}
+
+	for step := 1; step <= len(cycle); step++ {
+		mode := cycle[(start+step+len(cycle))%len(cycle)]
+		if mode != current && available(mode) {
+			return mode
+		}
+	}
+	return current
+}
+
+// viewModeAvailable reports whether a view mode can be entered from the current view
+func (m Model) viewModeAvailable(mode ViewMode) bool {
+	switch mode {
+	case ViewModeProjectPanes:
+		return m.sections.IsProjectsView()
+	case ViewModeListWithSidebar:
+		// Small screens have no room for the split view
+		if m.viewMode == ViewModeSmall || m.viewMode == ViewModeSmallTaskDetail {
+			return false
+		}
+		// The Projects and Tags lists show the highlighted group in the sidebar
+		return m.viewMode != ViewModeProjectPanes
+	case ViewModeTaskDetail:
+		// Shows the selected task; the group lists have none
+		return !m.inGroupView && m.viewMode != ViewModeProjectPanes
+	}
+	return true
+}
+
+// cycleViewMode switches to the next view mode in tui.view_cycle
+func (m Model) cycleViewMode() (tea.Model, tea.Cmd) {
+	next := nextViewMode(m.viewCycle, m.viewMode, m.viewModeAvailable)
+	if next == m.viewMode {
+		return m, nil
+	}
+
+	// Entering and leaving the two-pane view reloads the Projects tab
+	if next == ViewModeProjectPanes || m.viewMode == ViewModeProjectPanes {
+		return m.toggleProjectPanes()
+	}
+
+	// A view chosen by hand is kept when leaving a tab with its own view_mode
+	m.tabViewModeSet = false
+
+	// A sidebar opened by hand stays open, one closed by hand stays closed for the current task
+	m.sidebarPinned = next == ViewModeListWithSidebar
+	if m.viewMode == ViewModeListWithSidebar {
+		m.dismissSidebar()
+	}
+
+	m.viewMode = next
+	m.updateComponentSizes()
+	m.updateSidebar()
+	return m, nil
+}
@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestResolveViewCycle(t *testing.T) {
+	tests := []struct {
+		name     string
+		names    []string
+		expected []ViewMode
+	}{
+		{"default when empty", nil, []ViewMode{ViewModeList, ViewModeListWithSidebar}},
+		{"configured order", []string{"detail", "list", "projects"}, []ViewMode{ViewModeTaskDetail, ViewModeList, ViewModeProjectPanes}},
+		{"case and spaces", []string{" List ", "SIDEBAR"}, []ViewMode{ViewModeList, ViewModeListWithSidebar}},
+		{"unknown and repeated names skipped", []string{"list", "bogus", "list", "detail"}, []ViewMode{ViewModeList, ViewModeTaskDetail}},
+		{"default when all invalid", []string{"bogus"}, []ViewMode{ViewModeList, ViewModeListWithSidebar}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveViewCycle(tt.names); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("resolveViewCycle(%v) = %v, expected %v", tt.names, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNextViewMode(t *testing.T) {
+	cycle := []ViewMode{ViewModeList, ViewModeListWithSidebar, ViewModeTaskDetail, ViewModeProjectPanes}
+	all := func(ViewMode) bool { return true }
+	noPanes := func(mode ViewMode) bool { return mode != ViewModeProjectPanes }
+
+	tests := []struct {
+		name      string
+		current   ViewMode
+		available func(ViewMode) bool
+		expected  ViewMode
+	}{
+		{"list to sidebar", ViewModeList, all, ViewModeListWithSidebar},
+		{"sidebar to detail", ViewModeListWithSidebar, all, ViewModeTaskDetail},
+		{"wraps around", ViewModeProjectPanes, all, ViewModeList},
+		{"skips unavailable", ViewModeTaskDetail, noPanes, ViewModeList},
+		{"small screen counts as list", ViewModeSmall, all, ViewModeListWithSidebar},
+		{"small detail counts as detail", ViewModeSmallTaskDetail, noPanes, ViewModeList},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextViewMode(cycle, tt.current, tt.available); got != tt.expected {
+				t.Errorf("nextViewMode(%v) = %v, expected %v", tt.current, got, tt.expected)
+			}
+		})
+	}
+
+	// A mode outside the cycle starts from the first entry
+	if got := nextViewMode([]ViewMode{ViewModeTaskDetail, ViewModeList}, ViewModeListWithSidebar, all); got != ViewModeTaskDetail {
+		t.Errorf("Expected to start at the first cycle entry, got %v", got)
+	}
+	// Nothing else available keeps the current mode
+	if got := nextViewMode([]ViewMode{ViewModeList}, ViewModeList, all); got != ViewModeList {
+		t.Errorf("Expected the current mode to be kept, got %v", got)
+	}
+}
+
+func TestCycleViewKey(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.viewMode = ViewModeList
+	key := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}}
+
+	updated, _ := model.Update(key)
+	model = updated.(Model)
+	if model.viewMode != ViewModeListWithSidebar {
+		t.Fatalf("Expected the sidebar view after cycling, got %v", model.viewMode)
+	}
+
+	updated, _ = model.Update(key)
+	model = updated.(Model)
+	if model.viewMode != ViewModeList {
+		t.Errorf("Expected the default cycle to return to the list, got %v", model.viewMode)
+	}
+}
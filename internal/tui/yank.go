@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+// taskDescriptions returns the descriptions of tasks, one per line
+func taskDescriptions(tasks []core.Task) string {
+	descriptions := make([]string, len(tasks))
+	for i, task := range tasks {
+		descriptions[i] = task.Description
+	}
+	return strings.Join(descriptions, "\n")
+}
+
+// yankDescriptionCmd copies the descriptions of tasks to the clipboard
+func yankDescriptionCmd(language string, cb Clipboard, tasks []core.Task) tea.Cmd {
+	return func() tea.Msg {
+		text := taskDescriptions(tasks)
+		if err := cb.WriteText(text); err != nil {
+			return StatusMsg{
+				Message: translate(language, msgErrCopyClipboard, text),
+				IsError: true,
+			}
+		}
+
+		message := translate(language, msgCopied, text)
+		if len(tasks) > 1 {
+			message = translate(language, msgCopiedDescriptions, len(tasks))
+		}
+		return StatusMsg{
+			Message: message,
+			IsError: false,
+		}
+	}
+}
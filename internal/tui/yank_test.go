@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clobrano/wui/internal/core"
+)
+
+func TestTaskDescriptions(t *testing.T) {
+	tasks := []core.Task{
+		{UUID: "1", Description: "Fix login crash"},
+		{UUID: "2", Description: "Write report"},
+		{UUID: "3", Description: "Buy milk"},
+	}
+
+	if got := taskDescriptions(tasks[:1]); got != "Fix login crash" {
+		t.Errorf("Expected a single description, got %q", got)
+	}
+	if got, expected := taskDescriptions(tasks), "Fix login crash\nWrite report\nBuy milk"; got != expected {
+		t.Errorf("Expected newline-joined descriptions %q, got %q", expected, got)
+	}
+}
+
+func TestYankDescriptionKey(t *testing.T) {
+	model := createTestModel(&core.MockTaskService{})
+	model.taskList.ToggleSelection()
+	model.taskList.MoveCursorDown()
+	model.taskList.ToggleSelection()
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a clipboard command")
+	}
+	if len(model.taskList.GetSelectedTasks()) != 1 {
+		t.Error("Expected the selection to be cleared after yanking")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/clobrano/wui/internal/api"
 	"github.com/clobrano/wui/internal/calendar"
 	"github.com/clobrano/wui/internal/config"
+	"github.com/clobrano/wui/internal/core"
 	"github.com/clobrano/wui/internal/gui"
 	"github.com/clobrano/wui/internal/taskwarrior"
 	"github.com/clobrano/wui/internal/tui"
@@ -33,6 +34,8 @@ var (
 	logLevel     string
 	logFormat    string
 	searchFilter string
+	tasksFile    string
+	saveOnExit   bool
 )
 
 var rootCmd = &cobra.Command{
@@ -59,8 +62,64 @@ var versionCmd = &cobra.Command{
 var (
 	syncCalendarName string
 	syncTaskFilter   string
+	syncDirection    string
+	syncPrune        bool
 )
 
+var (
+	exportFormat  string
+	exportFilter  string
+	exportOut     string
+	exportColumns []string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export tasks to a file",
+	Long: `Export the tasks matching a Taskwarrior filter, for use in a spreadsheet.
+
+The CSV has a header row and one row per task. Dates are ISO 8601 and tags are
+joined with ";".
+
+Examples:
+  wui export --filter "status:pending" --out tasks.csv
+  wui export --filter "project:Work" --columns id,description,due
+  wui export > tasks.csv                      # All tasks, to stdout`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runExport(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var (
+	icalFilter string
+	icalOut    string
+)
+
+var icalCmd = &cobra.Command{
+	Use:   "ical",
+	Short: "Export tasks to an iCalendar (.ics) file",
+	Long: `Export tasks as iCalendar events, to import in any calendar app without
+connecting to Google.
+
+Each task with a due or scheduled date becomes an event, built the same way as
+by wui sync: tasks with the allDay UDA are all-day events and the 'dur' UDA sets
+the length of the others. The task UUID is the event UID, so importing the file
+again updates the events instead of duplicating them.
+
+Examples:
+  wui ical --out tasks.ics                    # calendar_sync.task_filter, or pending tasks
+  wui ical --filter "+work due.before:eom" --out work.ics`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runICal(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
 var serveAddr string
 var serveTLSCert string
 var serveTLSKey string
@@ -113,6 +172,7 @@ Endpoints:
   POST   /api/v1/tasks/{uuid}/done    mark done
   POST   /api/v1/tasks/{uuid}/start   start
   POST   /api/v1/tasks/{uuid}/stop    stop
+  POST   /api/v1/tasks/{uuid}/duplicate duplicate
   POST   /api/v1/tasks/{uuid}/annotate add annotation  {"text":"..."}
   POST   /api/v1/undo                undo last operation
   GET    /api/v1/projects            list project summaries
@@ -149,7 +209,9 @@ Examples:
   wui sync                                    # Use config.yaml settings
   wui sync --calendar "Work"                  # Override calendar
   wui sync --filter "+urgent"                 # Override filter
-  wui sync --calendar "Tasks" --filter "due:today"  # Override both`,
+  wui sync --calendar "Tasks" --filter "due:today"  # Override both
+  wui sync --direction both                   # Also apply events deleted or moved in Calendar
+  wui sync --direction pull --prune           # Only pull, deleting events of removed tasks`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := runSync(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -164,6 +226,8 @@ func init() {
 	rootCmd.AddCommand(syncCmd)
 	rootCmd.AddCommand(serveCmd)
 	rootCmd.AddCommand(guiCmd)
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(icalCmd)
 
 	// GUI command flags
 	guiCmd.Flags().IntVar(&guiPort, "port", 7008, "port for the GUI HTTP server")
@@ -174,9 +238,21 @@ func init() {
 	serveCmd.Flags().StringVar(&serveTLSCert, "tls-cert", "", "path to TLS certificate file (enables HTTPS)")
 	serveCmd.Flags().StringVar(&serveTLSKey, "tls-key", "", "path to TLS private key file (enables HTTPS)")
 
+	// Export command flags
+	exportCmd.Flags().StringVar(&exportFormat, "format", "csv", "export format (only csv is supported)")
+	exportCmd.Flags().StringVar(&exportFilter, "filter", "", "Taskwarrior filter for tasks to export (default: all tasks)")
+	exportCmd.Flags().StringVar(&exportOut, "out", "-", "output file (\"-\" for stdout)")
+	exportCmd.Flags().StringSliceVar(&exportColumns, "columns", core.DefaultCSVColumns, "columns to export")
+
+	// iCal command flags
+	icalCmd.Flags().StringVar(&icalFilter, "filter", "", "Taskwarrior filter for tasks to export (default: calendar_sync.task_filter, or status:pending)")
+	icalCmd.Flags().StringVar(&icalOut, "out", "-", "output file (\"-\" for stdout)")
+
 	// Sync command flags (optional - override config file values)
 	syncCmd.Flags().StringVar(&syncCalendarName, "calendar", "", "Google Calendar name (overrides config)")
 	syncCmd.Flags().StringVar(&syncTaskFilter, "filter", "", "Taskwarrior filter for tasks to sync (overrides config)")
+	syncCmd.Flags().StringVar(&syncDirection, "direction", calendar.DirectionPush, "sync direction: push (tasks to calendar), pull (calendar changes to tasks) or both")
+	syncCmd.Flags().BoolVar(&syncPrune, "prune", false, "when pulling, delete wui events whose task no longer exists")
 
 	// Persistent flags available to all commands
 	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "config file path (default: ~/.config/wui/config.yaml)")
@@ -185,6 +261,10 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "error", "log level (debug, info, warn, error)")
 	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "text", "log format (text, json)")
 	rootCmd.PersistentFlags().StringVar(&searchFilter, "search", "", "open in Search tab with the specified filter")
+
+	// TUI flags
+	rootCmd.Flags().StringVar(&tasksFile, "tasks-file", "", "read tasks from a Taskwarrior export JSON file (\"-\" for stdin) instead of running task")
+	rootCmd.Flags().BoolVar(&saveOnExit, "save-on-exit", false, "write changes made in --tasks-file mode back to the file on exit")
 }
 
 func main() {
@@ -232,6 +312,23 @@ func runTUI() error {
 		slog.Debug("Setting initial search filter", "filter", searchFilter)
 		cfg.InitialSearchFilter = searchFilter
 	}
+	cfg.StatePath = config.StatePath(cfgPath)
+
+	// Offline mode: serve tasks from an export file, without Taskwarrior
+	if tasksFile != "" {
+		service, err := taskwarrior.NewFileTaskService(tasksFile, cfg.StrictJSON)
+		if err != nil {
+			slog.Error("Failed to load tasks file", "error", err, "path", tasksFile)
+			return err
+		}
+		if err := tui.Run(service, cfg); err != nil {
+			return err
+		}
+		if saveOnExit {
+			return service.Save()
+		}
+		return nil
+	}
 
 	slog.Info("Configuration loaded",
 		"task_bin", cfg.TaskBin,
@@ -255,6 +352,7 @@ func runTUI() error {
 		slog.Error("Failed to create taskwarrior client", "error", err)
 		return fmt.Errorf("failed to create taskwarrior client: %w", err)
 	}
+	client.SetStrictJSON(cfg.StrictJSON)
 
 	slog.Debug("Taskwarrior client created successfully")
 
@@ -389,6 +487,7 @@ func runServe() error {
 		return fmt.Errorf("failed to create taskwarrior client: %w", err)
 	}
 	client.SetWuiConfigPath(cfgPath)
+	client.SetStrictJSON(cfg.StrictJSON)
 
 	srv := api.NewServer(client, serveAddr, serveTLSCert, serveTLSKey)
 
@@ -607,8 +706,140 @@ func (pw *prefixWriter) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
+// newExportClient loads the configuration and creates the Taskwarrior client used by
+// the commands that write tasks to a file
+func newExportClient() (*taskwarrior.Client, *config.Config, error) {
+	// Resolve config path
+	cfgPath := config.ResolveConfigPath(configPath)
+
+	// If the user explicitly passed --config, the file must exist
+	if err := config.ValidateExplicitConfigPath(configPath, cfgPath); err != nil {
+		return nil, nil, err
+	}
+
+	// Load configuration
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		// Use basic logging before config is loaded
+		initLogging(nil)
+		slog.Error("Failed to load config", "error", err, "path", cfgPath)
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	// Initialize logging with config (priority: flag > env > config)
+	initLogging(cfg)
+
+	// Override with CLI flags if provided
+	if taskBinPath != "" {
+		cfg.TaskBin = taskBinPath
+	}
+	if taskrcPath != "" {
+		cfg.TaskrcPath = taskrcPath
+	}
+
+	// Check if task binary exists
+	if err := checkTaskBinary(cfg.TaskBin); err != nil {
+		return nil, nil, err
+	}
+
+	// Check if taskrc file exists
+	if err := config.ValidateTaskrcPath(cfg.TaskrcPath); err != nil {
+		slog.Error("Taskrc file not found", "error", err, "path", cfg.TaskrcPath)
+		return nil, nil, err
+	}
+
+	// Create Taskwarrior client
+	client, err := taskwarrior.NewClient(cfg.TaskBin, cfg.TaskrcPath)
+	if err != nil {
+		slog.Error("Failed to create taskwarrior client", "error", err)
+		return nil, nil, fmt.Errorf("failed to create taskwarrior client: %w", err)
+	}
+	client.SetStrictJSON(cfg.StrictJSON)
+
+	return client, cfg, nil
+}
+
+// runExport writes the tasks matching the export filter to a file
+func runExport() error {
+	if exportFormat != "csv" {
+		return fmt.Errorf("invalid --format %q: use csv", exportFormat)
+	}
+
+	client, _, err := newExportClient()
+	if err != nil {
+		return err
+	}
+
+	tasks, err := client.Export(exportFilter)
+	if err != nil {
+		return fmt.Errorf("failed to export tasks: %w", err)
+	}
+
+	data, err := core.TasksToCSV(tasks, exportColumns)
+	if err != nil {
+		return err
+	}
+
+	if err := writeExport(exportOut, data); err != nil {
+		return err
+	}
+	slog.Info("Exported tasks", "count", len(tasks), "path", exportOut)
+	return nil
+}
+
+// runICal writes the tasks matching the filter as an iCalendar file
+func runICal() error {
+	client, cfg, err := newExportClient()
+	if err != nil {
+		return err
+	}
+
+	// Default to the Google sync filter, so that both export the same events
+	filter := icalFilter
+	if filter == "" {
+		filter = cfg.CalendarSync.TaskFilter
+	}
+	if filter == "" {
+		filter = "status:pending"
+	}
+
+	tasks, err := client.Export(filter)
+	if err != nil {
+		return fmt.Errorf("failed to export tasks: %w", err)
+	}
+
+	data, err := calendar.TasksToICal(tasks)
+	if err != nil {
+		return err
+	}
+
+	if err := writeExport(icalOut, data); err != nil {
+		return err
+	}
+	slog.Info("Exported tasks to iCalendar", "count", len(tasks), "path", icalOut)
+	return nil
+}
+
+// writeExport writes exported data to a file, or to stdout when path is "-"
+func writeExport(path string, data []byte) error {
+	if path == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
 // runSync performs the Google Calendar sync operation
 func runSync() error {
+	switch syncDirection {
+	case calendar.DirectionPush, calendar.DirectionPull, calendar.DirectionBoth:
+	default:
+		return fmt.Errorf("invalid --direction %q: use push, pull or both", syncDirection)
+	}
+
 	// Resolve config path
 	cfgPath := config.ResolveConfigPath(configPath)
 
@@ -658,6 +889,10 @@ func runSync() error {
 	if taskFilter == "" {
 		return fmt.Errorf("task filter is required (set in config.yaml or use --filter flag)")
 	}
+	eventDuration, err := cfg.CalendarSync.EventDuration()
+	if err != nil {
+		return err
+	}
 
 	slog.Info("Sync configuration",
 		"calendar", calendarName,
@@ -682,6 +917,7 @@ func runSync() error {
 		slog.Error("Failed to create taskwarrior client", "error", err)
 		return fmt.Errorf("failed to create taskwarrior client: %w", err)
 	}
+	taskClient.SetStrictJSON(cfg.StrictJSON)
 
 	// Get credentials and token paths from config
 	credentialsPath := cfg.CalendarSync.CredentialsPath
@@ -698,13 +934,24 @@ func runSync() error {
 	}
 
 	// Perform sync
-	result, err := syncClient.Sync(ctx)
+	syncClient.SetPrune(syncPrune)
+	syncClient.SetDefaultDuration(eventDuration)
+	var result *calendar.SyncResult
+	switch syncDirection {
+	case calendar.DirectionPull:
+		result, err = syncClient.Pull(ctx)
+	case calendar.DirectionBoth:
+		result, err = syncClient.SyncBidirectional(ctx)
+	default:
+		result, err = syncClient.Sync(ctx)
+	}
 	if err != nil {
 		slog.Error("Sync failed", "error", err)
 		return fmt.Errorf("sync failed: %w", err)
 	}
 
-	slog.Info("Sync completed successfully", "created", result.Created, "updated", result.Updated)
+	slog.Info("Sync completed successfully", "direction", syncDirection, "created", result.Created, "updated", result.Updated,
+		"tasks_deleted", result.TasksDeleted, "tasks_rescheduled", result.TasksRescheduled)
 
 	// Print warnings if any (for TUI mode when output might be lost)
 	if len(result.Warnings) > 0 {